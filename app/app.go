@@ -26,6 +26,10 @@ import (
 	clerkTypes "github.com/maticnetwork/heimdall/clerk/types"
 	"github.com/maticnetwork/heimdall/common"
 	"github.com/maticnetwork/heimdall/helper"
+	"github.com/maticnetwork/heimdall/sidetx"
+	sidetxTypes "github.com/maticnetwork/heimdall/sidetx/types"
+	"github.com/maticnetwork/heimdall/slashing"
+	slashingTypes "github.com/maticnetwork/heimdall/slashing/types"
 	"github.com/maticnetwork/heimdall/staking"
 	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
 	"github.com/maticnetwork/heimdall/supply"
@@ -56,6 +60,8 @@ var (
 		checkpoint.AppModuleBasic{},
 		bor.AppModuleBasic{},
 		clerk.AppModuleBasic{},
+		sidetx.AppModuleBasic{},
+		slashing.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -89,6 +95,8 @@ type HeimdallApp struct {
 	StakingKeeper    staking.Keeper
 	BorKeeper        bor.Keeper
 	ClerkKeeper      clerk.Keeper
+	SideTxKeeper     sidetx.Keeper
+	SlashingKeeper   slashing.Keeper
 
 	// param keeper
 	ParamsKeeper params.Keeper
@@ -101,6 +109,12 @@ type HeimdallApp struct {
 
 	// the module manager
 	mm *module.Manager
+
+	// side router
+	sideRouter types.SideRouter
+
+	// invariants registered by modules
+	invariants *types.InvariantRegistry
 }
 
 var logger = helper.Logger.With("module", "app")
@@ -119,6 +133,16 @@ func (d CrossCommunicator) GetACKCount(ctx sdk.Context) uint64 {
 	return d.App.CheckpointKeeper.GetACKCount(ctx)
 }
 
+// GetConfirmationBlocks returns confirmations required for root chain txs
+func (d CrossCommunicator) GetConfirmationBlocks(ctx sdk.Context) uint64 {
+	return d.App.CheckpointKeeper.GetConfirmationBlocks(ctx)
+}
+
+// GetLastCheckpoint returns last checkpoint of bor chain
+func (d CrossCommunicator) GetLastCheckpoint(ctx sdk.Context, borChainID string) (types.CheckpointBlockHeader, error) {
+	return d.App.CheckpointKeeper.GetLastCheckpoint(ctx, borChainID)
+}
+
 // IsCurrentValidatorByAddress check if validator is current validator
 func (d CrossCommunicator) IsCurrentValidatorByAddress(ctx sdk.Context, address []byte) bool {
 	return d.App.StakingKeeper.IsCurrentValidatorByAddress(ctx, address)
@@ -156,17 +180,21 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		checkpointTypes.StoreKey,
 		borTypes.StoreKey,
 		clerkTypes.StoreKey,
+		sidetxTypes.StoreKey,
+		slashingTypes.StoreKey,
 		params.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(params.TStoreKey)
 
 	// create heimdall app
 	var app = &HeimdallApp{
-		cdc:       cdc,
-		BaseApp:   bApp,
-		keys:      keys,
-		tkeys:     tkeys,
-		subspaces: make(map[string]params.Subspace),
+		cdc:        cdc,
+		BaseApp:    bApp,
+		keys:       keys,
+		tkeys:      tkeys,
+		subspaces:  make(map[string]params.Subspace),
+		sideRouter: types.NewSideRouter(),
+		invariants: types.NewInvariantRegistry(),
 	}
 
 	// init params keeper and subspaces
@@ -179,6 +207,8 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 	app.subspaces[checkpointTypes.ModuleName] = app.ParamsKeeper.Subspace(checkpointTypes.DefaultParamspace)
 	app.subspaces[borTypes.ModuleName] = app.ParamsKeeper.Subspace(borTypes.DefaultParamspace)
 	app.subspaces[clerkTypes.ModuleName] = app.ParamsKeeper.Subspace(clerkTypes.DefaultParamspace)
+	app.subspaces[sidetxTypes.ModuleName] = app.ParamsKeeper.Subspace(sidetxTypes.DefaultParamspace)
+	app.subspaces[slashingTypes.ModuleName] = app.ParamsKeeper.Subspace(slashingTypes.DefaultParamspace)
 
 	//
 	// Contract caller
@@ -237,6 +267,9 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		app.BankKeeper,
 	)
 
+	// bank mints and burns coins on topup and fee withdrawal
+	app.BankKeeper.SetSupplyKeeper(app.SupplyKeeper)
+
 	// app.GovKeeper = gov.NewKeeper(
 	// 	app.cdc,
 	// 	keys[govTypes.StoreKey],
@@ -262,6 +295,7 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		common.DefaultCodespace,
 		app.StakingKeeper,
 		app.caller,
+		crossCommunicator,
 	)
 
 	app.ClerkKeeper = clerk.NewKeeper(
@@ -269,6 +303,24 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		keys[clerkTypes.StoreKey], // target store
 		app.subspaces[clerkTypes.ModuleName],
 		common.DefaultCodespace,
+		crossCommunicator,
+	)
+
+	app.SideTxKeeper = sidetx.NewKeeper(
+		app.cdc,
+		keys[sidetxTypes.StoreKey], // target store
+		app.subspaces[sidetxTypes.ModuleName],
+		common.DefaultCodespace,
+		app.StakingKeeper,
+		app.sideRouter,
+	)
+
+	app.SlashingKeeper = slashing.NewKeeper(
+		app.cdc,
+		keys[slashingTypes.StoreKey], // target store
+		app.subspaces[slashingTypes.ModuleName],
+		common.DefaultCodespace,
+		app.StakingKeeper,
 	)
 
 	// NOTE: Any module instantiated in the module manager that is later modified
@@ -281,6 +333,8 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		checkpoint.NewAppModule(app.CheckpointKeeper, &app.caller),
 		bor.NewAppModule(app.BorKeeper, &app.caller),
 		clerk.NewAppModule(app.ClerkKeeper, &app.caller),
+		sidetx.NewAppModule(app.SideTxKeeper),
+		slashing.NewAppModule(app.SlashingKeeper),
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -293,10 +347,15 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		checkpointTypes.ModuleName,
 		borTypes.ModuleName,
 		clerkTypes.ModuleName,
+		sidetxTypes.ModuleName,
+		slashingTypes.ModuleName,
 	)
 
+	// register module invariants
+	app.mm.RegisterInvariants(app.invariants)
+
 	// register message routes and query routes
-	app.mm.RegisterRoutes(app.Router(), app.QueryRouter())
+	app.registerRoutes()
 
 	// register message routes
 	// app.Router().
@@ -327,7 +386,6 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		auth.NewAnteHandler(
 			app.AccountKeeper,
 			app.SupplyKeeper,
-			&app.caller,
 			auth.DefaultSigVerificationGasConsumer,
 		),
 	)
@@ -356,6 +414,8 @@ func MakeCodec() *codec.Codec {
 	stakingTypes.RegisterCodec(cdc)
 	borTypes.RegisterCodec(cdc)
 	clerkTypes.RegisterCodec(cdc)
+	sidetxTypes.RegisterCodec(cdc)
+	slashingTypes.RegisterCodec(cdc)
 
 	cdc.Seal()
 	return cdc
@@ -371,10 +431,37 @@ func MakePulp() *authTypes.Pulp {
 	checkpointTypes.RegisterPulp(pulp)
 	borTypes.RegisterPulp(pulp)
 	clerkTypes.RegisterPulp(pulp)
+	sidetxTypes.RegisterPulp(pulp)
+	slashingTypes.RegisterPulp(pulp)
 
 	return pulp
 }
 
+// registerRoutes registers message and query routes of all modules.
+// Handlers of modules with side txs are wrapped so that side tx messages are
+// stored as pending side txs and applied once validators approve them.
+func (app *HeimdallApp) registerRoutes() {
+	for _, m := range app.mm.Modules {
+		if m.Route() != "" {
+			handler := m.NewHandler()
+			if sideModule, ok := m.(types.SideModule); ok {
+				app.sideRouter.AddRoute(m.Route(), &types.SideHandlers{
+					SideTxHandler: sideModule.NewSideTxHandler(),
+					PostTxHandler: sideModule.NewPostTxHandler(),
+				})
+				handler = sidetx.NewSideTxMsgHandler(app.SideTxKeeper, handler)
+			}
+			app.Router().AddRoute(m.Route(), handler)
+		}
+
+		if m.QuerierRoute() != "" {
+			app.QueryRouter().AddRoute(m.QuerierRoute(), m.NewQuerierHandler())
+		}
+	}
+
+	app.sideRouter.Seal()
+}
+
 // Name returns the name of the App
 func (app *HeimdallApp) Name() string { return app.BaseApp.Name() }
 
@@ -390,13 +477,14 @@ func (app *HeimdallApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain)
 	app.mm.InitGenesis(ctx, genesisState)
 
 	stakingState := stakingTypes.GetGenesisStateFromAppState(genesisState)
-	checkpointState := checkpointTypes.GetGenesisStateFromAppState(genesisState)
+	// validator epochs follow ack count of primary bor chain
+	ackCount := app.CheckpointKeeper.GetACKCount(ctx)
 
 	// check if validator is current validator
 	// add to val updates else skip
 	var valUpdates []abci.ValidatorUpdate
 	for _, validator := range stakingState.Validators {
-		if validator.IsCurrentValidator(checkpointState.AckCount) {
+		if validator.IsCurrentValidator(ackCount) {
 			// convert to Validator Update
 			updateVal := abci.ValidatorUpdate{
 				Power:  int64(validator.VotingPower),
@@ -419,7 +507,7 @@ func (app *HeimdallApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain)
 				MaxBytes: maxBytesPerBlock,
 				MaxGas:   maxGasPerBlock,
 			},
-			Evidence:  &abci.EvidenceParams{},
+			Evidence:  &abci.EvidenceParams{MaxAge: slashingTypes.DefaultMaxEvidenceAge},
 			Validator: &abci.ValidatorParams{PubKeyTypes: []string{ABCIPubKeyTypeSecp256k1}},
 		},
 	}
@@ -449,46 +537,29 @@ func (app *HeimdallApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) ab
 		app.AccountKeeper.RemoveBlockProposer(ctx)
 	}
 
-	var tmValUpdates []abci.ValidatorUpdate
-	if ctx.BlockHeader().NumTxs > 0 {
-		// --- Start update to new validators
-		currentValidatorSet := app.StakingKeeper.GetValidatorSet(ctx)
-		allValidators := app.StakingKeeper.GetAllValidators(ctx)
-		ackCount := app.CheckpointKeeper.GetACKCount(ctx)
-
-		// get validator updates
-		setUpdates := helper.GetUpdatedValidators(
-			&currentValidatorSet, // pointer to current validator set -- UpdateValidators will modify it
-			allValidators,        // All validators
-			ackCount,             // ack count
-		)
-
-		// create new validator set
-		if err := currentValidatorSet.UpdateWithChangeSet(setUpdates); err != nil {
-			// return with nothing
-			logger.Error("Unable to update current validator set", "Error", err)
-			return abci.ResponseEndBlock{}
-		}
+	// expire side txs which are not approved within vote window
+	sidetx.EndBlocker(ctx, app.SideTxKeeper)
 
-		// save set in store
-		if err := app.StakingKeeper.UpdateValidatorSetInStore(ctx, currentValidatorSet); err != nil {
-			// return with nothing
-			logger.Error("Unable to update current validator set in state", "Error", err)
-			return abci.ResponseEndBlock{}
-		}
+	// flush expired checkpoint buffer and rotate proposer
+	checkpoint.EndBlocker(ctx, app.CheckpointKeeper)
 
-		// convert updates from map to array
-		for _, v := range setUpdates {
-			tmValUpdates = append(tmValUpdates, abci.ValidatorUpdate{
-				Power:  int64(v.VotingPower),
-				PubKey: v.PubKey.ABCIPubKey(),
-			})
-		}
+	// update validators on ack count or validator change, empty blocks included
+	tmValUpdates := staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// snapshot validator set once per epoch
+	if err := app.StakingKeeper.TrackHistoricalValidatorSet(ctx, app.CheckpointKeeper.GetACKCount(ctx)); err != nil {
+		logger.Error("Unable to store historical validator set", "Error", err)
+	}
+
+	// halt on broken invariant
+	if period := helper.GetConfig().InvCheckPeriod; period != 0 && uint64(ctx.BlockHeight())%period == 0 {
+		app.invariants.AssertInvariants(ctx)
 	}
 
 	// send validator updates to peppermint
 	return abci.ResponseEndBlock{
 		ValidatorUpdates: tmValUpdates,
+		Events:           ctx.EventManager().ABCIEvents(),
 	}
 }
 
@@ -546,6 +617,17 @@ func (app *HeimdallApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) ab
 // 	return valUpdates
 // }
 
+// CheckInvariants runs all registered invariants on latest committed state and returns broken ones
+func (app *HeimdallApp) CheckInvariants() []string {
+	ctx := app.NewContext(true, abci.Header{Height: app.LastBlockHeight()})
+	return app.invariants.CheckInvariants(ctx)
+}
+
+// InvariantRoutes returns all registered invariant routes
+func (app *HeimdallApp) InvariantRoutes() []types.InvarRoute {
+	return app.invariants.Routes()
+}
+
 // LoadHeight loads a particular height
 func (app *HeimdallApp) LoadHeight(height int64) error {
 	return app.LoadVersion(height, app.keys[bam.MainStoreKey])
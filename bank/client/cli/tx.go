@@ -13,6 +13,7 @@ import (
 
 	authTypes "github.com/maticnetwork/heimdall/auth/types"
 	bankTypes "github.com/maticnetwork/heimdall/bank/types"
+	checkpointCli "github.com/maticnetwork/heimdall/checkpoint/client/cli"
 	hmClient "github.com/maticnetwork/heimdall/client"
 	"github.com/maticnetwork/heimdall/helper"
 	"github.com/maticnetwork/heimdall/types"
@@ -112,12 +113,45 @@ func TopupTxCmd(cdc *codec.Codec) *cobra.Command {
 				return fmt.Errorf("transaction hash has to be supplied")
 			}
 
+			contractCallerObj, err := helper.NewContractCaller()
+			if err != nil {
+				return err
+			}
+
+			// confirmations required on main chain are checkpoint param
+			confirmationBlocks, err := checkpointCli.GetConfirmationBlocks(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			// get main tx receipt
+			receipt, err := contractCallerObj.GetConfirmedTxReceipt(types.HexToHeimdallHash(txhash).EthHash(), confirmationBlocks)
+			if err != nil || receipt == nil {
+				return errors.New("Transaction is not confirmed yet. Please for sometime and try again")
+			}
+
+			// get event log for topup
+			logIndex := uint64(viper.GetInt64(FlagLogIndex))
+			eventLog, err := contractCallerObj.DecodeValidatorTopupFeesEvent(receipt, logIndex)
+			if err != nil {
+				return err
+			}
+
+			// fetch validator signer from mainchain
+			validator, err := contractCallerObj.GetValidatorInfo(types.NewValidatorID(uint64(validatorID)))
+			if err != nil {
+				return err
+			}
+
 			// build and sign the transaction, then broadcast to Tendermint
 			msg := bankTypes.NewMsgTopup(
 				proposer,
 				uint64(validatorID),
+				validator.Signer,
+				types.NewIntFromBigInt(eventLog.Fee),
 				types.HexToHeimdallHash(txhash),
-				uint64(viper.GetInt64(FlagLogIndex)),
+				logIndex,
+				receipt.BlockNumber.Uint64(),
 			)
 
 			// broadcast msg with cli
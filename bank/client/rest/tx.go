@@ -63,9 +63,12 @@ func SendRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 type TopupReq struct {
 	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
 
-	ID       uint64 `json:"id" yaml:"id"`
-	TxHash   string `json:"tx_hash" yaml:"tx_hash"`
-	LogIndex uint64 `json:"log_index" yaml:"log_index"`
+	ID          uint64 `json:"id" yaml:"id"`
+	Signer      string `json:"signer" yaml:"signer"`
+	Fee         string `json:"fee" yaml:"fee"`
+	TxHash      string `json:"tx_hash" yaml:"tx_hash"`
+	LogIndex    uint64 `json:"log_index" yaml:"log_index"`
+	BlockNumber uint64 `json:"block_number" yaml:"block_number"`
 }
 
 // TopupHandlerFn - http request handler to topup coins to a address.
@@ -84,12 +87,22 @@ func TopupHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		// get from address
 		fromAddr := types.HexToHeimdallAddress(req.BaseReq.From)
 
+		// get topup fee
+		fee, ok := types.NewIntFromString(req.Fee)
+		if !ok {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "invalid fee")
+			return
+		}
+
 		// get msg
 		msg := bankTypes.NewMsgTopup(
 			fromAddr,
 			req.ID,
+			types.HexToHeimdallAddress(req.Signer),
+			fee,
 			types.HexToHeimdallHash(req.TxHash),
 			req.LogIndex,
+			req.BlockNumber,
 		)
 		restClient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
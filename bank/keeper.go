@@ -40,6 +40,8 @@ type Keeper struct {
 	ak auth.AccountKeeper
 	// staking keeper
 	sk staking.Keeper
+	// supply keeper
+	supplyKeeper types.SupplyKeeper
 }
 
 // NewKeeper returns a new Keeper
@@ -72,6 +74,40 @@ func (keeper Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", types.ModuleName)
 }
 
+// SetSupplyKeeper sets supply keeper, which is created after bank keeper
+func (keeper *Keeper) SetSupplyKeeper(supplyKeeper types.SupplyKeeper) {
+	keeper.supplyKeeper = supplyKeeper
+}
+
+// InflateSupply adds minted coins to total supply
+func (keeper Keeper) InflateSupply(ctx sdk.Context, amt hmTypes.Coins) {
+	if keeper.supplyKeeper == nil {
+		return
+	}
+
+	supply := keeper.supplyKeeper.GetSupply(ctx)
+	supply.Inflate(amt)
+	keeper.supplyKeeper.SetSupply(ctx, supply)
+}
+
+// DeflateSupply removes burnt coins from total supply
+func (keeper Keeper) DeflateSupply(ctx sdk.Context, amt hmTypes.Coins) {
+	if keeper.supplyKeeper == nil {
+		return
+	}
+
+	supply := keeper.supplyKeeper.GetSupply(ctx)
+	total, isNegative := supply.Total.SafeSub(amt)
+	if isNegative {
+		// supply is out of sync, leave it for total supply invariant to report
+		keeper.Logger(ctx).Error("Total supply is less than burnt coins", "supply", supply.Total, "amount", amt)
+		return
+	}
+
+	supply.Total = total
+	keeper.supplyKeeper.SetSupply(ctx, supply)
+}
+
 // SetCoins sets the coins at the addr.
 func (keeper Keeper) SetCoins(
 	ctx sdk.Context, addr hmTypes.HeimdallAddress, amt hmTypes.Coins,
@@ -0,0 +1,13 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	supplyTypes "github.com/maticnetwork/heimdall/supply/types"
+)
+
+// SupplyKeeper defines the supply keeper used by bank to keep total supply in sync
+type SupplyKeeper interface {
+	GetSupply(ctx sdk.Context) supplyTypes.Supply
+	SetSupply(ctx sdk.Context, supply supplyTypes.Supply)
+}
@@ -4,5 +4,9 @@ const (
 	FlagProposerAddress = "proposer"
 	FlagBorChainId      = "bor-chain-id"
 	FlagStartBlock      = "start-block"
+	FlagEndBlock        = "end-block"
+	FlagProducerId      = "producer-id"
+	FlagEffectiveBlock  = "effective-block"
+	FlagBlock           = "block"
 	FlagSpanId          = "span-id"
 )
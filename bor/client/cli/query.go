@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
@@ -13,6 +14,8 @@ import (
 
 	"github.com/maticnetwork/heimdall/bor/types"
 	hmClient "github.com/maticnetwork/heimdall/client"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
 // GetQueryCmd returns the cli query commands for this module
@@ -30,7 +33,13 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 	queryCmds.AddCommand(
 		client.GetCommands(
 			GetSpan(cdc),
+			GetSpanSeed(cdc),
+			GetSpanStatus(cdc),
+			GetSpanVersions(cdc),
+			GetSpanProducers(cdc),
 			GetLatestSpan(cdc),
+			GetNextSpan(cdc),
+			GetValidateSpan(cdc),
 		)...,
 	)
 
@@ -82,6 +91,156 @@ func GetSpan(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetSpanSeed get seed used to select producers of span
+func GetSpanSeed(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "span-seed",
+		Short: "show seed used to select producers of span",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			spanID := viper.GetUint64(FlagSpanId)
+
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQuerySpanParams(spanID))
+			if err != nil {
+				return err
+			}
+
+			// fetch span seed
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySpanSeed), queryParams)
+			if err != nil {
+				return err
+			}
+
+			if len(res) == 0 {
+				return errors.New("Span seed not found")
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagSpanId, 0, "--span-id=<span ID here>")
+	cmd.MarkFlagRequired(FlagSpanId)
+
+	return cmd
+}
+
+// GetSpanStatus get commit status of span on bor
+func GetSpanStatus(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "span-status",
+		Short: "show commit status of span on bor",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			spanID := viper.GetUint64(FlagSpanId)
+
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQuerySpanParams(spanID))
+			if err != nil {
+				return err
+			}
+
+			// fetch span status
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySpanStatus), queryParams)
+			if err != nil {
+				return err
+			}
+
+			if len(res) == 0 {
+				return errors.New("Span status not found")
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagSpanId, 0, "--span-id=<span ID here>")
+	cmd.MarkFlagRequired(FlagSpanId)
+
+	return cmd
+}
+
+// GetSpanVersions get producer sets of span after producer replacement
+func GetSpanVersions(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "span-versions",
+		Short: "show producer sets of span after producer replacement",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			spanID := viper.GetUint64(FlagSpanId)
+
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQuerySpanParams(spanID))
+			if err != nil {
+				return err
+			}
+
+			// fetch span versions
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySpanVersions), queryParams)
+			if err != nil {
+				return err
+			}
+
+			if len(res) == 0 {
+				return errors.New("Span versions not found")
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagSpanId, 0, "--span-id=<span ID here>")
+	cmd.MarkFlagRequired(FlagSpanId)
+
+	return cmd
+}
+
+// GetSpanProducers get producers of span at bor block
+func GetSpanProducers(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "span-producers",
+		Short: "show producers of span at bor block, latest producers if block is not given",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			spanID := viper.GetUint64(FlagSpanId)
+			block := viper.GetUint64(FlagBlock)
+
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQuerySpanProducersParams(spanID, block))
+			if err != nil {
+				return err
+			}
+
+			// fetch span producers
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySpanProducers), queryParams)
+			if err != nil {
+				return err
+			}
+
+			if len(res) == 0 {
+				return errors.New("Span producers not found")
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagSpanId, 0, "--span-id=<span ID here>")
+	cmd.Flags().Uint64(FlagBlock, 0, "--block=<bor block number>")
+	cmd.MarkFlagRequired(FlagSpanId)
+
+	return cmd
+}
+
 // GetLatestSpan get state record
 func GetLatestSpan(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -109,3 +268,103 @@ func GetLatestSpan(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// GetNextSpan simulated producers of next span
+func GetNextSpan(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "next-span",
+		Short: "show simulated producers of span following latest span",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// simulate next span
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryNextSpan), nil)
+			if err != nil {
+				return err
+			}
+
+			if len(res) == 0 {
+				return errors.New("Next span not found")
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// GetValidateSpan dry runs propose span and shows failed check
+func GetValidateSpan(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate-span",
+		Short: "dry run propose span and show which check fails",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// get proposer
+			proposer := hmTypes.HexToHeimdallAddress(viper.GetString(FlagProposerAddress))
+			if proposer.Empty() {
+				proposer = helper.GetFromAddress(cliCtx)
+			}
+
+			spanID := viper.GetUint64(FlagSpanId)
+			startBlock := viper.GetUint64(FlagStartBlock)
+
+			// end block defaults to end of span with current span duration
+			endBlock := viper.GetUint64(FlagEndBlock)
+			if endBlock == 0 {
+				res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryParams, types.ParamSpan), nil)
+				if err != nil {
+					return err
+				}
+				if len(res) == 0 {
+					return errors.New("span duration not found")
+				}
+
+				var spanDuration uint64
+				if err := json.Unmarshal(res, &spanDuration); err != nil {
+					return err
+				}
+
+				endBlock = startBlock
+				if spanDuration > 0 {
+					endBlock = startBlock + spanDuration - 1
+				}
+			}
+
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewMsgProposeSpan(
+				spanID,
+				proposer,
+				startBlock,
+				endBlock,
+				viper.GetString(FlagBorChainId),
+			))
+			if err != nil {
+				return err
+			}
+
+			// dry run span
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidateSpan), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringP(FlagProposerAddress, "p", "", "--proposer=<proposer-address>")
+	cmd.Flags().Uint64(FlagSpanId, 0, "--span-id=<span-id>")
+	cmd.Flags().String(FlagBorChainId, "", "--bor-chain-id=<bor-chain-id>")
+	cmd.Flags().Uint64(FlagStartBlock, 0, "--start-block=<start-block-number>")
+	cmd.Flags().Uint64(FlagEndBlock, 0, "--end-block=<end-block-number>")
+	cmd.MarkFlagRequired(FlagSpanId)
+	cmd.MarkFlagRequired(FlagBorChainId)
+	cmd.MarkFlagRequired(FlagStartBlock)
+
+	return cmd
+}
@@ -32,6 +32,7 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	txCmd.AddCommand(
 		client.PostCommands(
 			PostSendProposeSpanTx(cdc),
+			PostSendReplaceProducerTx(cdc),
 		)...,
 	)
 	return txCmd
@@ -101,7 +102,7 @@ func PostSendProposeSpanTx(cdc *codec.Codec) *cobra.Command {
 				spanID,
 				proposer,
 				startBlock,
-				startBlock+spanDuration,
+				startBlock+spanDuration-1,
 				chainID,
 			)
 
@@ -118,3 +119,37 @@ func PostSendProposeSpanTx(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// PostSendReplaceProducerTx send replace producer transaction
+func PostSendReplaceProducerTx(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replace-producer",
+		Short: "vote to replace producer of span from effective bor block",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			producerID := viper.GetUint64(FlagProducerId)
+			if producerID == 0 {
+				return fmt.Errorf("Producer id cannot be zero")
+			}
+
+			msg := types.NewMsgReplaceProducer(
+				helper.GetFromAddress(cliCtx),
+				viper.GetUint64(FlagSpanId),
+				hmTypes.NewValidatorID(producerID),
+				viper.GetUint64(FlagEffectiveBlock),
+			)
+
+			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Uint64(FlagSpanId, 0, "--span-id=<span-id>")
+	cmd.Flags().Uint64(FlagProducerId, 0, "--producer-id=<producer-validator-id>")
+	cmd.Flags().Uint64(FlagEffectiveBlock, 0, "--effective-block=<bor-block-number>")
+	cmd.MarkFlagRequired(FlagSpanId)
+	cmd.MarkFlagRequired(FlagProducerId)
+	cmd.MarkFlagRequired(FlagEffectiveBlock)
+
+	return cmd
+}
@@ -21,6 +21,10 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		"/bor/propose-span",
 		postProposeSpanHandlerFn(cliCtx),
 	).Methods("POST")
+	r.HandleFunc(
+		"/bor/replace-producer",
+		postReplaceProducerHandlerFn(cliCtx),
+	).Methods("POST")
 }
 
 // ProposeSpanReq struct for proposing new span
@@ -72,7 +76,7 @@ func postProposeSpanHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			req.ID,
 			hmTypes.HexToHeimdallAddress(req.BaseReq.From),
 			req.StartBlock,
-			req.StartBlock+spanDuration,
+			req.StartBlock+spanDuration-1,
 			req.BorChainID,
 		)
 
@@ -80,3 +84,38 @@ func postProposeSpanHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		restClient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+// ReplaceProducerReq struct for voting to replace producer of span
+type ReplaceProducerReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+
+	SpanID         uint64 `json:"span_id"`
+	ProducerID     uint64 `json:"producer_id"`
+	EffectiveBlock uint64 `json:"effective_block"`
+}
+
+func postReplaceProducerHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// read req from request
+		var req ReplaceProducerReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// draft a replace producer message
+		msg := types.NewMsgReplaceProducer(
+			hmTypes.HexToHeimdallAddress(req.BaseReq.From),
+			req.SpanID,
+			hmTypes.NewValidatorID(req.ProducerID),
+			req.EffectiveBlock,
+		)
+
+		// send response
+		restClient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
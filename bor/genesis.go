@@ -12,17 +12,43 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 	keeper.SetSprintDuration(ctx, data.SprintDuration)
 	keeper.SetSpanDuration(ctx, data.SpanDuration)
 	keeper.SetProducerCount(ctx, data.ProducerCount)
+
+	// genesis without bor chain id follows chain id of its spans
+	chainID := data.ChainID
+	if chainID == "" && len(data.Spans) > 0 {
+		chainID = data.Spans[len(data.Spans)-1].ChainID
+	}
+	keeper.SetChainID(ctx, chainID)
+	keeper.SetProducerSelection(ctx, data.ProducerSelection)
+	keeper.SetSeedSource(ctx, data.SeedSource)
+
 	if len(data.Spans) > 0 {
 		// sort data spans before inserting to ensure lastspanId fetched is correct
 		hmTypes.SortSpanByID(data.Spans)
 		// add new span
 		for _, span := range data.Spans {
 			keeper.AddNewRawSpan(ctx, *span)
+			keeper.SetSpanStatus(ctx, types.NewSpanStatus(span.ID, types.SpanStatusProposed))
 		}
 
 		// update last span
 		keeper.UpdateLastSpan(ctx, data.Spans[len(data.Spans)-1].ID)
 	}
+
+	// add seed history
+	for _, spanSeed := range data.SpanSeeds {
+		keeper.SetSpanSeed(ctx, spanSeed)
+	}
+
+	// add span statuses reported before export
+	for _, spanStatus := range data.SpanStatuses {
+		keeper.SetSpanStatus(ctx, spanStatus)
+	}
+
+	// add producer sets of spans after producer replacement
+	for _, spanVersion := range data.SpanVersions {
+		keeper.AddSpanVersion(ctx, spanVersion)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -36,5 +62,11 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 		producerCount,
 		// TODO think better way to export all spans
 		allSpans,
+		keeper.GetChainID(ctx),
+		keeper.GetProducerSelection(ctx),
+		keeper.GetSeedSource(ctx),
+		keeper.GetAllSpanSeeds(ctx),
+		keeper.GetAllSpanStatuses(ctx),
+		keeper.GetAllSpanVersions(ctx),
 	)
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/maticnetwork/heimdall/bor/types"
 	"github.com/maticnetwork/heimdall/common"
+	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
 // NewHandler returns a handler for "bor" type messages.
@@ -17,6 +18,10 @@ func NewHandler(k Keeper) sdk.Handler {
 		switch msg := msg.(type) {
 		case types.MsgProposeSpan:
 			return HandleMsgProposeSpan(ctx, msg, k)
+		case types.MsgSpanStatus:
+			return handleMsgSpanStatus(ctx, msg, k)
+		case types.MsgReplaceProducer:
+			return HandleMsgReplaceProducer(ctx, msg, k)
 		default:
 			return sdk.ErrTxDecode("Invalid message in bor module").Result()
 		}
@@ -27,38 +32,19 @@ func NewHandler(k Keeper) sdk.Handler {
 func HandleMsgProposeSpan(ctx sdk.Context, msg types.MsgProposeSpan, k Keeper) sdk.Result {
 	k.Logger(ctx).Debug("Proposing span", "TxData", msg)
 
-	// check if last span is up or if greater diff than threshold is found between validator set
-	lastSpan, err := k.GetLastSpan(ctx)
-	if err != nil {
-		k.Logger(ctx).Error("Unable to fetch last span", "Error", err)
-		return common.ErrSpanNotFound(k.Codespace()).Result()
-	}
-
-	// check all conditions
-	if lastSpan.ID+1 != msg.ID || msg.StartBlock < lastSpan.StartBlock || msg.EndBlock < msg.StartBlock {
-		k.Logger(ctx).Error("Blocks not in countinuity",
-			"lastSpanId", lastSpan.ID,
-			"lastSpanStartBlock", lastSpan.StartBlock,
-			"spanId", msg.ID,
-			"spanStartBlock", msg.StartBlock,
-		)
-		return common.ErrSpanNotInCountinuity(k.Codespace()).Result()
+	// validate span against last span, params and proposer
+	check, producers, spanSeed, sdkErr := validateProposeSpan(ctx, msg, k)
+	if sdkErr != nil {
+		k.Logger(ctx).Error("Invalid propose span", "check", check, "spanId", msg.ID, "Error", sdkErr)
+		return sdkErr.Result()
 	}
 
 	// freeze for new span
-	err = k.FreezeSet(ctx, msg.ID, msg.StartBlock, msg.ChainID)
-	if err != nil {
+	if err := k.FreezeSet(ctx, msg.ID, msg.StartBlock, msg.ChainID, producers, spanSeed); err != nil {
 		k.Logger(ctx).Error("Unable to freeze validator set for span", "Error", err)
 		return common.ErrUnableToFreezeValSet(k.Codespace()).Result()
 	}
 
-	// get last span
-	lastSpan, err = k.GetLastSpan(ctx)
-	if err != nil {
-		k.Logger(ctx).Error("Unable to fetch last span", "Error", err)
-		return common.ErrSpanNotFound(k.Codespace()).Result()
-	}
-
 	// add events
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -76,3 +62,181 @@ func HandleMsgProposeSpan(ctx sdk.Context, msg types.MsgProposeSpan, k Keeper) s
 		Events: ctx.EventManager().Events(),
 	}
 }
+
+// handleMsgSpanStatus only checks state, status is saved once side tx is approved
+func handleMsgSpanStatus(ctx sdk.Context, msg types.MsgSpanStatus, k Keeper) sdk.Result {
+	if _, err := k.GetSpan(ctx, msg.SpanID); err != nil {
+		return common.ErrSpanNotFound(k.Codespace()).Result()
+	}
+
+	spanStatus, err := k.GetSpanStatus(ctx, msg.SpanID)
+	if err != nil || !types.CanChangeSpanStatus(spanStatus.Status, msg.Status) {
+		return common.ErrInvalidSpanStatus(k.Codespace()).Result()
+	}
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// HandleMsgReplaceProducer records vote to replace producer and appends span version
+// once validators with more than 2/3 of voting power agree on the same replacement
+func HandleMsgReplaceProducer(ctx sdk.Context, msg types.MsgReplaceProducer, k Keeper) sdk.Result {
+	k.Logger(ctx).Debug("Voting to replace producer", "TxData", msg)
+
+	span, err := k.GetSpan(ctx, msg.SpanID)
+	if err != nil {
+		return common.ErrSpanNotFound(k.Codespace()).Result()
+	}
+
+	// spans before the one preceding last span are over and their votes expired
+	if lastSpan, err := k.GetLastSpan(ctx); err == nil && msg.SpanID+1 < lastSpan.ID {
+		k.Logger(ctx).Error("Span is over, producer can not be replaced", "spanId", msg.SpanID, "lastSpanId", lastSpan.ID)
+		return common.ErrInvalidProducerReplacement(k.Codespace()).Result()
+	}
+
+	// only validators in current set can vote
+	valSet := k.sk.GetValidatorSet(ctx)
+	_, voter := valSet.GetByAddress(msg.From.Bytes())
+	if voter == nil {
+		k.Logger(ctx).Error("Voter is not current validator", "from", msg.From)
+		return common.ErrInvalidProducerReplacement(k.Codespace()).Result()
+	}
+
+	// producer must be in latest producer set of span
+	latestVersion, producers := k.GetSpanProducersAt(ctx, *span, 0)
+	isProducer := false
+	for _, producer := range producers {
+		if producer.ID == msg.ProducerID {
+			isProducer = true
+			break
+		}
+	}
+	if !isProducer {
+		k.Logger(ctx).Error("Validator is not producer of span", "spanId", msg.SpanID, "producerId", msg.ProducerID)
+		return common.ErrInvalidProducerReplacement(k.Codespace()).Result()
+	}
+
+	// replacement takes effect at sprint start within span, after latest version
+	effectiveFrom := span.StartBlock
+	if latestVersion > 0 {
+		versions := k.GetSpanVersions(ctx, span.ID)
+		effectiveFrom = versions[len(versions)-1].EffectiveBlock + 1
+	}
+	sprint := k.GetSprintDuration(ctx)
+	if msg.EffectiveBlock < effectiveFrom || msg.EffectiveBlock > span.EndBlock ||
+		(sprint > 0 && (msg.EffectiveBlock-span.StartBlock)%sprint != 0) {
+		k.Logger(ctx).Error("Invalid effective block for producer replacement",
+			"spanId", msg.SpanID,
+			"effectiveBlock", msg.EffectiveBlock,
+			"effectiveFrom", effectiveFrom,
+			"spanEndBlock", span.EndBlock,
+		)
+		return common.ErrInvalidProducerReplacement(k.Codespace()).Result()
+	}
+
+	// record vote
+	votes := k.GetReplaceVotes(ctx, msg.SpanID, msg.ProducerID, msg.EffectiveBlock)
+	if votes.HasVoted(voter.ID) {
+		return common.ErrProducerReplacementVoted(k.Codespace()).Result()
+	}
+	votes.Voters = append(votes.Voters, voter.ID)
+
+	var votedPower int64
+	for _, voterID := range votes.Voters {
+		for _, val := range valSet.Validators {
+			if val.ID == voterID {
+				votedPower += val.VotingPower
+			}
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReplaceProducer,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeySpanID, strconv.FormatUint(msg.SpanID, 10)),
+			sdk.NewAttribute(types.AttributeKeyProducerID, strconv.FormatUint(msg.ProducerID.Uint64(), 10)),
+			sdk.NewAttribute(types.AttributeKeyEffectiveBlock, strconv.FormatUint(msg.EffectiveBlock, 10)),
+		),
+	)
+
+	// wait for more than 2/3 of voting power
+	if votedPower*3 <= valSet.TotalVotingPower()*2 {
+		if err := k.SetReplaceVotes(ctx, votes); err != nil {
+			return common.ErrInvalidProducerReplacement(k.Codespace()).Result()
+		}
+
+		return sdk.Result{
+			Events: ctx.EventManager().Events(),
+		}
+	}
+
+	spanVersion, err := k.ReplaceProducer(ctx, *span, msg.ProducerID, msg.EffectiveBlock)
+	if err != nil {
+		k.Logger(ctx).Error("Unable to replace producer", "spanId", msg.SpanID, "producerId", msg.ProducerID, "error", err)
+		return common.ErrInvalidProducerReplacement(k.Codespace()).Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProducerReplaced,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeySpanID, strconv.FormatUint(spanVersion.SpanID, 10)),
+			sdk.NewAttribute(types.AttributeKeySpanVersion, strconv.FormatUint(spanVersion.Version, 10)),
+			sdk.NewAttribute(types.AttributeKeyProducerID, strconv.FormatUint(msg.ProducerID.Uint64(), 10)),
+			sdk.NewAttribute(types.AttributeKeyEffectiveBlock, strconv.FormatUint(spanVersion.EffectiveBlock, 10)),
+		),
+	)
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// validateProposeSpan runs all checks on propose span msg and returns failed check with error.
+// Producers selected for the span and seed used for selection are returned when all checks pass.
+func validateProposeSpan(ctx sdk.Context, msg types.MsgProposeSpan, k Keeper) (string, []hmTypes.Validator, types.SpanSeed, sdk.Error) {
+	var spanSeed types.SpanSeed
+
+	lastSpan, err := k.GetLastSpan(ctx)
+	if err != nil {
+		return types.SpanCheckID, nil, spanSeed, common.ErrSpanNotFound(k.Codespace())
+	}
+
+	// span must follow last span without gap or overlap
+	if lastSpan.ID+1 != msg.ID {
+		return types.SpanCheckID, nil, spanSeed, common.ErrSpanNotInCountinuity(k.Codespace())
+	}
+
+	if msg.StartBlock != lastSpan.EndBlock+1 {
+		return types.SpanCheckStartBlock, nil, spanSeed, common.ErrSpanNotInCountinuity(k.Codespace())
+	}
+
+	if msg.EndBlock != k.GetSpanEndBlock(ctx, msg.StartBlock) {
+		return types.SpanCheckEndBlock, nil, spanSeed, common.ErrSpanNotInCountinuity(k.Codespace())
+	}
+
+	if msg.ChainID != k.GetChainID(ctx) {
+		return types.SpanCheckChainID, nil, spanSeed, common.ErrInvalidBorChainID(k.Codespace())
+	}
+
+	// proposer must be current validator
+	if !k.sk.IsCurrentValidatorByAddress(ctx, msg.Proposer.Bytes()) {
+		return types.SpanCheckProposer, nil, spanSeed, common.ErrInvalidSpanProposer(k.Codespace())
+	}
+
+	// proposer must be one of the producers selected for the span
+	producers, spanSeed, err := k.SelectNextProducersWithSeed(ctx)
+	if err != nil {
+		return types.SpanCheckProducers, nil, spanSeed, common.ErrUnableToFreezeValSet(k.Codespace())
+	}
+
+	for _, producer := range producers {
+		if producer.Signer.Equals(msg.Proposer) {
+			return "", producers, spanSeed, nil
+		}
+	}
+
+	return types.SpanCheckProducers, nil, spanSeed, common.ErrInvalidSpanProposer(k.Codespace())
+}
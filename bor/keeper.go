@@ -3,11 +3,14 @@ package bor
 import (
 	"errors"
 	"math/big"
+	"sort"
 	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/crypto"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/maticnetwork/heimdall/bor/types"
@@ -25,8 +28,17 @@ var (
 	SpanPrefixKey         = []byte{0x36} // prefix key to store span
 	SpanCacheKey          = []byte{0x37} // key to store Cache for span
 	LastProcessedEthBlock = []byte{0x38} // key to store last processed eth block for seed
+	SpanSeedPrefixKey     = []byte{0x39} // prefix key to store seed used to select producers of span
+	SpanStatusPrefixKey   = []byte{0x3A} // prefix key to store commit status of span on bor
+	SpanVersionPrefixKey  = []byte{0x3B} // prefix key to store producer set versions of span
+	ReplaceVotesPrefixKey = []byte{0x3C} // prefix key to store votes to replace producer of span
 )
 
+// CheckpointRetriever retrieves last checkpoint of bor chain
+type CheckpointRetriever interface {
+	GetLastCheckpoint(ctx sdk.Context, borChainID string) (hmTypes.CheckpointBlockHeader, error)
+}
+
 // Keeper stores all related data
 type Keeper struct {
 	cdc *codec.Codec
@@ -39,6 +51,8 @@ type Keeper struct {
 	paramSpace params.Subspace
 	// contract caller
 	contractCaller helper.ContractCaller
+	// checkpoint retriever
+	checkpointRetriever CheckpointRetriever
 }
 
 // NewKeeper create new keeper
@@ -49,15 +63,17 @@ func NewKeeper(
 	codespace sdk.CodespaceType,
 	stakingKeeper staking.Keeper,
 	caller helper.ContractCaller,
+	checkpointRetriever CheckpointRetriever,
 ) Keeper {
 	// create keeper
 	keeper := Keeper{
-		cdc:            cdc,
-		storeKey:       storeKey,
-		paramSpace:     paramSpace.WithKeyTable(types.ParamKeyTable()),
-		codespace:      codespace,
-		sk:             stakingKeeper,
-		contractCaller: caller,
+		cdc:                 cdc,
+		storeKey:            storeKey,
+		paramSpace:          paramSpace.WithKeyTable(types.ParamKeyTable()),
+		codespace:           codespace,
+		sk:                  stakingKeeper,
+		contractCaller:      caller,
+		checkpointRetriever: checkpointRetriever,
 	}
 	return keeper
 }
@@ -179,17 +195,341 @@ func (k *Keeper) GetLastSpan(ctx sdk.Context) (*hmTypes.Span, error) {
 	return k.GetSpan(ctx, lastSpanID)
 }
 
-// FreezeSet freezes validator set for next span
-func (k *Keeper) FreezeSet(ctx sdk.Context, id uint64, startBlock uint64, borChainID string) error {
-	duration := k.GetSpanDuration(ctx)
+// GetSpanSeedKey appends prefix to span id
+func GetSpanSeedKey(id uint64) []byte {
+	return append(SpanSeedPrefixKey, []byte(strconv.FormatUint(id, 10))...)
+}
+
+// SetSpanSeed stores seed used to select producers of span
+func (k *Keeper) SetSpanSeed(ctx sdk.Context, spanSeed types.SpanSeed) error {
+	store := ctx.KVStore(k.storeKey)
+
+	out, err := k.cdc.MarshalBinaryBare(spanSeed)
+	if err != nil {
+		k.Logger(ctx).Error("Error marshalling span seed", "error", err)
+		return err
+	}
+
+	store.Set(GetSpanSeedKey(spanSeed.SpanID), out)
+	return nil
+}
+
+// GetSpanSeed fetches seed used to select producers of span
+func (k *Keeper) GetSpanSeed(ctx sdk.Context, id uint64) (*types.SpanSeed, error) {
+	store := ctx.KVStore(k.storeKey)
+	seedKey := GetSpanSeedKey(id)
+
+	// spans from genesis or before seed history have no seed
+	if !store.Has(seedKey) {
+		return nil, errors.New("seed not found for span id")
+	}
+
+	var spanSeed types.SpanSeed
+	if err := k.cdc.UnmarshalBinaryBare(store.Get(seedKey), &spanSeed); err != nil {
+		return nil, err
+	}
+
+	return &spanSeed, nil
+}
+
+// GetAllSpanSeeds fetches all stored span seeds sorted by span id
+func (k *Keeper) GetAllSpanSeeds(ctx sdk.Context) (spanSeeds []types.SpanSeed) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, SpanSeedPrefixKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var spanSeed types.SpanSeed
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &spanSeed); err == nil {
+			spanSeeds = append(spanSeeds, spanSeed)
+		}
+	}
+
+	// keys are not ordered by numeric span id
+	sort.Slice(spanSeeds, func(i, j int) bool {
+		return spanSeeds[i].SpanID < spanSeeds[j].SpanID
+	})
+
+	return spanSeeds
+}
+
+// GetSpanStatusKey appends prefix to span id
+func GetSpanStatusKey(id uint64) []byte {
+	return append(SpanStatusPrefixKey, []byte(strconv.FormatUint(id, 10))...)
+}
+
+// SetSpanStatus stores commit status of span on bor
+func (k *Keeper) SetSpanStatus(ctx sdk.Context, spanStatus types.SpanStatus) error {
+	store := ctx.KVStore(k.storeKey)
+
+	out, err := k.cdc.MarshalBinaryBare(spanStatus)
+	if err != nil {
+		k.Logger(ctx).Error("Error marshalling span status", "error", err)
+		return err
+	}
+
+	store.Set(GetSpanStatusKey(spanStatus.SpanID), out)
+	return nil
+}
+
+// GetSpanStatus fetches commit status of span on bor
+func (k *Keeper) GetSpanStatus(ctx sdk.Context, id uint64) (*types.SpanStatus, error) {
+	store := ctx.KVStore(k.storeKey)
+	statusKey := GetSpanStatusKey(id)
+
+	if !store.Has(statusKey) {
+		return nil, errors.New("status not found for span id")
+	}
+
+	var spanStatus types.SpanStatus
+	if err := k.cdc.UnmarshalBinaryBare(store.Get(statusKey), &spanStatus); err != nil {
+		return nil, err
+	}
+
+	return &spanStatus, nil
+}
+
+// GetAllSpanStatuses fetches all stored span statuses sorted by span id
+func (k *Keeper) GetAllSpanStatuses(ctx sdk.Context) (spanStatuses []types.SpanStatus) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, SpanStatusPrefixKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var spanStatus types.SpanStatus
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &spanStatus); err == nil {
+			spanStatuses = append(spanStatuses, spanStatus)
+		}
+	}
+
+	// keys are not ordered by numeric span id
+	sort.Slice(spanStatuses, func(i, j int) bool {
+		return spanStatuses[i].SpanID < spanStatuses[j].SpanID
+	})
+
+	return spanStatuses
+}
+
+// GetSpanVersionsPrefixKey returns prefix of all versions of span
+func GetSpanVersionsPrefixKey(spanID uint64) []byte {
+	return append(SpanVersionPrefixKey, []byte(strconv.FormatUint(spanID, 10)+":")...)
+}
+
+// GetSpanVersionKey appends span id and version to prefix
+func GetSpanVersionKey(spanID uint64, version uint64) []byte {
+	return append(GetSpanVersionsPrefixKey(spanID), []byte(strconv.FormatUint(version, 10))...)
+}
+
+// AddSpanVersion stores producer set version of span
+func (k *Keeper) AddSpanVersion(ctx sdk.Context, spanVersion types.SpanVersion) error {
+	store := ctx.KVStore(k.storeKey)
+
+	out, err := k.cdc.MarshalBinaryBare(spanVersion)
+	if err != nil {
+		k.Logger(ctx).Error("Error marshalling span version", "error", err)
+		return err
+	}
+
+	store.Set(GetSpanVersionKey(spanVersion.SpanID, spanVersion.Version), out)
+	return nil
+}
+
+// GetSpanVersions fetches producer set versions of span sorted by version
+func (k *Keeper) GetSpanVersions(ctx sdk.Context, spanID uint64) (versions []types.SpanVersion) {
+	k.iterateSpanVersions(ctx, GetSpanVersionsPrefixKey(spanID), func(version types.SpanVersion) {
+		versions = append(versions, version)
+	})
+	return versions
+}
+
+// GetAllSpanVersions fetches producer set versions of all spans sorted by span id and version
+func (k *Keeper) GetAllSpanVersions(ctx sdk.Context) (versions []types.SpanVersion) {
+	k.iterateSpanVersions(ctx, SpanVersionPrefixKey, func(version types.SpanVersion) {
+		versions = append(versions, version)
+	})
+	return versions
+}
+
+func (k *Keeper) iterateSpanVersions(ctx sdk.Context, prefix []byte, f func(version types.SpanVersion)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	var versions []types.SpanVersion
+	for ; iterator.Valid(); iterator.Next() {
+		var version types.SpanVersion
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &version); err == nil {
+			versions = append(versions, version)
+		}
+	}
+
+	// keys are not ordered by numeric span id and version
+	sort.Slice(versions, func(i, j int) bool {
+		if versions[i].SpanID != versions[j].SpanID {
+			return versions[i].SpanID < versions[j].SpanID
+		}
+		return versions[i].Version < versions[j].Version
+	})
+
+	for _, version := range versions {
+		f(version)
+	}
+}
+
+// GetSpanProducersAt returns producers of span effective at bor block, latest producers if block is zero
+func (k *Keeper) GetSpanProducersAt(ctx sdk.Context, span hmTypes.Span, block uint64) (uint64, []hmTypes.Validator) {
+	version, producers := uint64(0), span.SelectedProducers
+	for _, spanVersion := range k.GetSpanVersions(ctx, span.ID) {
+		if block != 0 && spanVersion.EffectiveBlock > block {
+			break
+		}
+		version, producers = spanVersion.Version, spanVersion.SelectedProducers
+	}
+	return version, producers
+}
+
+// GetEffectiveSpan returns span with producers effective at bor block, latest producers if block is zero.
+// Span queries serve effective span, so that bor reads producers after replacement.
+func (k *Keeper) GetEffectiveSpan(ctx sdk.Context, span hmTypes.Span, block uint64) hmTypes.Span {
+	_, span.SelectedProducers = k.GetSpanProducersAt(ctx, span, block)
+	return span
+}
+
+// GetReplaceVotesKey appends span id, producer id and effective block to prefix
+func GetReplaceVotesKey(spanID uint64, producerID hmTypes.ValidatorID, effectiveBlock uint64) []byte {
+	return append(ReplaceVotesPrefixKey, []byte(strconv.FormatUint(spanID, 10)+":"+strconv.FormatUint(producerID.Uint64(), 10)+":"+strconv.FormatUint(effectiveBlock, 10))...)
+}
+
+// SetReplaceVotes stores votes to replace producer of span
+func (k *Keeper) SetReplaceVotes(ctx sdk.Context, votes types.ProducerReplacementVotes) error {
+	store := ctx.KVStore(k.storeKey)
+
+	out, err := k.cdc.MarshalBinaryBare(votes)
+	if err != nil {
+		k.Logger(ctx).Error("Error marshalling replacement votes", "error", err)
+		return err
+	}
+
+	store.Set(GetReplaceVotesKey(votes.SpanID, votes.ProducerID, votes.EffectiveBlock), out)
+	return nil
+}
+
+// GetReplaceVotes fetches votes to replace producer of span, empty votes if nobody voted yet
+func (k *Keeper) GetReplaceVotes(ctx sdk.Context, spanID uint64, producerID hmTypes.ValidatorID, effectiveBlock uint64) types.ProducerReplacementVotes {
+	store := ctx.KVStore(k.storeKey)
+
+	votes := types.ProducerReplacementVotes{
+		SpanID:         spanID,
+		ProducerID:     producerID,
+		EffectiveBlock: effectiveBlock,
+	}
+
+	key := GetReplaceVotesKey(spanID, producerID, effectiveBlock)
+	if store.Has(key) {
+		k.cdc.MustUnmarshalBinaryBare(store.Get(key), &votes)
+	}
+
+	return votes
+}
+
+// ClearReplaceVotes removes all votes to replace producers of span
+func (k *Keeper) ClearReplaceVotes(ctx sdk.Context, spanID uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, append(ReplaceVotesPrefixKey, []byte(strconv.FormatUint(spanID, 10)+":")...))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// ClearExpiredReplaceVotes removes votes to replace producers of spans before given span, those spans are over
+func (k *Keeper) ClearExpiredReplaceVotes(ctx sdk.Context, spanID uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, ReplaceVotesPrefixKey)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		var votes types.ProducerReplacementVotes
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &votes); err == nil && votes.SpanID < spanID {
+			keys = append(keys, iterator.Key())
+		}
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// ReplaceProducer appends span version with producers reselected among eligible validators except replaced producers
+func (k *Keeper) ReplaceProducer(ctx sdk.Context, span hmTypes.Span, producerID hmTypes.ValidatorID, effectiveBlock uint64) (types.SpanVersion, error) {
+	// producers replaced by earlier versions are never selected again for span
+	replaced := map[hmTypes.ValidatorID]bool{producerID: true}
+	for _, spanVersion := range k.GetSpanVersions(ctx, span.ID) {
+		replaced[spanVersion.ReplacedProducer] = true
+	}
+
+	var remaining []hmTypes.Validator
+	for _, val := range k.sk.GetSpanEligibleValidators(ctx) {
+		if !replaced[val.ID] {
+			remaining = append(remaining, val)
+		}
+	}
+
+	if len(remaining) == 0 {
+		return types.SpanVersion{}, errors.New("No eligible validator left to replace producer")
+	}
+
+	producers, spanSeed, err := k.selectProducers(ctx, span.ID, &span, remaining)
+	if err != nil {
+		return types.SpanVersion{}, err
+	}
+
+	latestVersion, _ := k.GetSpanProducersAt(ctx, span, 0)
+	spanVersion := types.SpanVersion{
+		SpanID:            span.ID,
+		Version:           latestVersion + 1,
+		EffectiveBlock:    effectiveBlock,
+		ReplacedProducer:  producerID,
+		SelectedProducers: producers,
+		Seed:              spanSeed,
+	}
+
+	if err := k.AddSpanVersion(ctx, spanVersion); err != nil {
+		return spanVersion, err
+	}
+
+	// votes for older producer set are stale
+	k.ClearReplaceVotes(ctx, span.ID)
+
+	return spanVersion, nil
+}
+
+// GetSpanEndBlock returns end block of span starting at start block
+func (k *Keeper) GetSpanEndBlock(ctx sdk.Context, startBlock uint64) uint64 {
 	endBlock := startBlock
-	if duration > 0 {
+	if duration := k.GetSpanDuration(ctx); duration > 0 {
 		endBlock = endBlock + duration - 1
 	}
+	return endBlock
+}
 
-	// select next producers
-	newProducers, err := k.SelectNextProducers(ctx)
-	if err != nil {
+// FreezeSet freezes validator set and selected producers for next span along with seed used for selection
+func (k *Keeper) FreezeSet(ctx sdk.Context, id uint64, startBlock uint64, borChainID string, newProducers []hmTypes.Validator, spanSeed types.SpanSeed) error {
+	endBlock := k.GetSpanEndBlock(ctx, startBlock)
+
+	// store seed history to re-verify selection
+	spanSeed.SpanID = id
+	if err := k.SetSpanSeed(ctx, spanSeed); err != nil {
 		return err
 	}
 
@@ -206,55 +546,147 @@ func (k *Keeper) FreezeSet(ctx sdk.Context, id uint64, startBlock uint64, borCha
 		borChainID,
 	)
 
-	return k.AddNewSpan(ctx, newSpan)
+	if err := k.AddNewSpan(ctx, newSpan); err != nil {
+		return err
+	}
+
+	// only last span can still be running, votes for spans before it expire
+	if id > 0 {
+		k.ClearExpiredReplaceVotes(ctx, id-1)
+	}
+
+	// span waits for bridge to report commit on bor
+	return k.SetSpanStatus(ctx, types.NewSpanStatus(id, types.SpanStatusProposed))
 }
 
-// SelectNextProducers selects producers for next span
+// SelectNextProducers selects producers for next span with producer selection strategy
 func (k *Keeper) SelectNextProducers(ctx sdk.Context) (vals []hmTypes.Validator, err error) {
-	// fetch last block used for seed
-	lastEthBlock := k.GetLastEthBlock(ctx)
+	vals, _, err = k.SelectNextProducersWithSeed(ctx)
+	return vals, err
+}
+
+// SelectNextProducersWithSeed selects producers for next span and returns seed used for selection
+func (k *Keeper) SelectNextProducersWithSeed(ctx sdk.Context) (vals []hmTypes.Validator, spanSeed types.SpanSeed, err error) {
+	var spanID uint64
+	lastSpan, err := k.GetLastSpan(ctx)
+	if err == nil {
+		spanID = lastSpan.ID + 1
+	}
 
 	// spanEligibleVals are current validators who are not getting deactivated in between next span
-	spanEligibleVals := k.sk.GetSpanEligibleValidators(ctx)
+	return k.selectProducers(ctx, spanID, lastSpan, k.sk.GetSpanEligibleValidators(ctx))
+}
+
+// selectProducers selects producers for span among given validators, seed span is base of heimdall seed
+func (k *Keeper) selectProducers(ctx sdk.Context, spanID uint64, seedSpan *hmTypes.Span, validators []hmTypes.Validator) (vals []hmTypes.Validator, spanSeed types.SpanSeed, err error) {
+	selection := k.GetProducerSelection(ctx)
+	selector, err := GetProducerSelector(selection)
+	if err != nil {
+		return vals, spanSeed, err
+	}
+
 	producerCount, err := k.GetProducerCount(ctx)
 	if err != nil {
-		return vals, err
+		return vals, spanSeed, err
+	}
+
+	input := SelectionInput{
+		SpanID:        spanID,
+		Validators:    validators,
+		ProducerCount: producerCount,
+	}
+
+	if genesisSpan, err := k.GetSpan(ctx, 0); err == nil && genesisSpan != nil {
+		input.GenesisProducers = genesisSpan.SelectedProducers
+	}
+
+	spanSeed = types.SpanSeed{
+		SpanID:            spanID,
+		Source:            k.GetSeedSource(ctx),
+		ProducerSelection: selection,
+	}
+
+	// seed is only needed if there is something to select from
+	if selector.SeedRequired() && len(input.Validators) > int(producerCount) {
+		switch spanSeed.Source {
+		case types.SeedSourceHeimdall:
+			if seedSpan == nil {
+				return vals, spanSeed, errors.New("Last span is required for heimdall seed")
+			}
+			spanSeed.CheckpointRoot = k.getLastCheckpointRoot(ctx)
+			input.Seed, err = k.getHeimdallSeed(*seedSpan, spanSeed.CheckpointRoot)
+			if err != nil {
+				return vals, spanSeed, err
+			}
+		default:
+			// increment last processed header block number
+			lastEthBlock := k.GetLastEthBlock(ctx)
+			newEthBlock := lastEthBlock.Add(lastEthBlock, big.NewInt(1))
+
+			// fetch block header from mainchain
+			blockHeader, err := k.contractCaller.GetMainChainBlock(newEthBlock)
+			if err != nil {
+				return vals, spanSeed, err
+			}
+
+			input.Seed = blockHeader.Hash()
+			spanSeed.EthBlock = newEthBlock.Uint64()
+		}
+
+		spanSeed.Seed = hmTypes.HeimdallHash(input.Seed)
+	}
+
+	vals, err = selector.SelectProducers(input)
+	if err != nil {
+		return vals, spanSeed, err
 	}
 
-	// if producers to be selected is more than current validators no need to select/shuffle
-	if len(spanEligibleVals) <= int(producerCount) {
-		return spanEligibleVals, nil
+	// sort by address
+	return hmTypes.SortValidatorByAddress(vals), spanSeed, nil
+}
+
+// getHeimdallSeed derives seed from last span and last checkpoint root hash.
+// Both are committed state, so queries and txs of next block derive same seed.
+func (k *Keeper) getHeimdallSeed(lastSpan hmTypes.Span, checkpointRoot hmTypes.HeimdallHash) (common.Hash, error) {
+	spanBytes, err := k.cdc.MarshalBinaryBare(lastSpan)
+	if err != nil {
+		return common.Hash{}, err
 	}
 
-	// increment last processed header block number
-	newEthBlock := lastEthBlock.Add(lastEthBlock, big.NewInt(1))
+	return crypto.Keccak256Hash(spanBytes, checkpointRoot.Bytes()), nil
+}
+
+// getLastCheckpointRoot returns root hash of last checkpoint of bor chain, empty if there is none yet
+func (k *Keeper) getLastCheckpointRoot(ctx sdk.Context) hmTypes.HeimdallHash {
+	if k.checkpointRetriever == nil {
+		return hmTypes.HeimdallHash{}
+	}
 
-	// fetch block header from mainchain
-	blockHeader, err := k.contractCaller.GetMainChainBlock(newEthBlock)
+	checkpoint, err := k.checkpointRetriever.GetLastCheckpoint(ctx, k.GetChainID(ctx))
 	if err != nil {
-		return vals, err
+		return hmTypes.HeimdallHash{}
 	}
+	return checkpoint.RootHash
+}
 
-	// select next producers using seed as blockheader hash
-	newProducersIds, err := SelectNextProducers(blockHeader.Hash(), spanEligibleVals, producerCount)
+// GetNextSpanSchedule simulates producer selection for span following last span without storing it
+func (k *Keeper) GetNextSpanSchedule(ctx sdk.Context) (schedule types.NextSpanSchedule, err error) {
+	lastSpan, err := k.GetLastSpan(ctx)
 	if err != nil {
-		return vals, err
+		return schedule, err
 	}
 
-	IDToPower := make(map[uint64]uint64)
-	for _, ID := range newProducersIds {
-		IDToPower[ID] = IDToPower[ID] + 1
+	producers, err := k.SelectNextProducers(ctx)
+	if err != nil {
+		return schedule, err
 	}
 
-	for key, value := range IDToPower {
-		if val, ok := k.sk.GetValidatorFromValID(ctx, hmTypes.NewValidatorID(key)); ok {
-			val.VotingPower = int64(value)
-			vals = append(vals, val)
-		}
-	} // sort by address
-	vals = hmTypes.SortValidatorByAddress(vals)
+	schedule.SpanID = lastSpan.ID + 1
+	schedule.StartBlock = lastSpan.EndBlock + 1
+	schedule.EndBlock = k.GetSpanEndBlock(ctx, schedule.StartBlock)
+	schedule.SelectedProducers = producers
 
-	return vals, nil
+	return schedule, nil
 }
 
 // UpdateLastSpan updates the last span start block
@@ -337,6 +769,42 @@ func (k *Keeper) SetProducerCount(ctx sdk.Context, count uint64) {
 // Utils
 //
 
+// GetChainID returns bor chain id spans are proposed for
+func (k *Keeper) GetChainID(ctx sdk.Context) string {
+	var chainID string
+	k.paramSpace.Get(ctx, types.ParamStoreKeyChainID, &chainID)
+	return chainID
+}
+
+// SetChainID sets bor chain id spans are proposed for
+func (k *Keeper) SetChainID(ctx sdk.Context, chainID string) {
+	k.paramSpace.Set(ctx, types.ParamStoreKeyChainID, chainID)
+}
+
+// GetProducerSelection returns strategy used to select producers for span
+func (k *Keeper) GetProducerSelection(ctx sdk.Context) string {
+	var selection string
+	k.paramSpace.Get(ctx, types.ParamStoreKeyProducerSelection, &selection)
+	return selection
+}
+
+// SetProducerSelection sets strategy used to select producers for span
+func (k *Keeper) SetProducerSelection(ctx sdk.Context, selection string) {
+	k.paramSpace.Set(ctx, types.ParamStoreKeyProducerSelection, selection)
+}
+
+// GetSeedSource returns source of seed used to select producers for span
+func (k *Keeper) GetSeedSource(ctx sdk.Context) string {
+	var source string
+	k.paramSpace.Get(ctx, types.ParamStoreKeySeedSource, &source)
+	return source
+}
+
+// SetSeedSource sets source of seed used to select producers for span
+func (k *Keeper) SetSeedSource(ctx sdk.Context, source string) {
+	k.paramSpace.Set(ctx, types.ParamStoreKeySeedSource, source)
+}
+
 // IterateSpansAndApplyFn interate spans and apply the given function.
 func (k *Keeper) IterateSpansAndApplyFn(ctx sdk.Context, f func(span hmTypes.Span) error) {
 	store := ctx.KVStore(k.storeKey)
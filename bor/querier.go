@@ -25,6 +25,18 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return handleQueryLatestSpan(ctx, req, keeper)
 		case types.QueryNextProducers:
 			return handleQueryNextProducers(ctx, req, keeper)
+		case types.QueryNextSpan:
+			return handleQueryNextSpan(ctx, req, keeper)
+		case types.QuerySpanVersions:
+			return handleQuerySpanVersions(ctx, req, keeper)
+		case types.QuerySpanProducers:
+			return handleQuerySpanProducers(ctx, req, keeper)
+		case types.QuerySpanStatus:
+			return handleQuerySpanStatus(ctx, req, keeper)
+		case types.QuerySpanSeed:
+			return handleQuerySpanSeed(ctx, req, keeper)
+		case types.QueryValidateSpan:
+			return handleQueryValidateSpan(ctx, req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown auth query endpoint")
 		}
@@ -56,6 +68,18 @@ func queryParams(ctx sdk.Context, path []string, req abci.RequestQuery, keeper K
 			return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
 		}
 		return bz, nil
+	case types.ParamProducerSelection:
+		bz, err := json.Marshal(keeper.GetProducerSelection(ctx))
+		if err != nil {
+			return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+		}
+		return bz, nil
+	case types.ParamSeedSource:
+		bz, err := json.Marshal(keeper.GetSeedSource(ctx))
+		if err != nil {
+			return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+		}
+		return bz, nil
 	case types.ParamLastEthBlock:
 		bz, err := json.Marshal(keeper.GetLastEthBlock(ctx))
 		if err != nil {
@@ -83,8 +107,89 @@ func handleQuerySpan(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]b
 		return nil, sdk.ErrInternal(fmt.Sprintf("span %v does not exist", params.RecordID))
 	}
 
+	// json record with producers after replacements
+	bz, err := json.Marshal(keeper.GetEffectiveSpan(ctx, *span, params.Block))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQuerySpanVersions(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QuerySpanParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if _, err := keeper.GetSpan(ctx, params.RecordID); err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not get span", err.Error()))
+	}
+
 	// json record
-	bz, err := json.Marshal(span)
+	versions := keeper.GetSpanVersions(ctx, params.RecordID)
+	if versions == nil {
+		versions = []types.SpanVersion{}
+	}
+
+	bz, err := json.Marshal(versions)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQuerySpanProducers(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QuerySpanProducersParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	span, err := keeper.GetSpan(ctx, params.SpanID)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not get span", err.Error()))
+	}
+
+	// json record
+	_, producers := keeper.GetSpanProducersAt(ctx, *span, params.Block)
+	bz, err := json.Marshal(producers)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQuerySpanStatus(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QuerySpanParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	spanStatus, err := keeper.GetSpanStatus(ctx, params.RecordID)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not get span status", err.Error()))
+	}
+
+	// json record
+	bz, err := json.Marshal(spanStatus)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQuerySpanSeed(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QuerySpanParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	spanSeed, err := keeper.GetSpanSeed(ctx, params.RecordID)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not get span seed", err.Error()))
+	}
+
+	// json record
+	bz, err := json.Marshal(spanSeed)
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
 	}
@@ -102,6 +207,11 @@ func handleQuerySpanList(ctx sdk.Context, req abci.RequestQuery, keeper Keeper)
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not fetch span list with page %v and limit %v", params.Page, params.Limit), err.Error()))
 	}
 
+	// latest producers after replacements
+	for i := range res {
+		res[i] = keeper.GetEffectiveSpan(ctx, res[i], 0)
+	}
+
 	bz, err := json.Marshal(res)
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
@@ -133,8 +243,8 @@ func handleQueryLatestSpan(ctx sdk.Context, req abci.RequestQuery, keeper Keeper
 		return nil, sdk.ErrInternal(fmt.Sprintf("latest span does not exist"))
 	}
 
-	// json record
-	bz, err := json.Marshal(span)
+	// json record with latest producers after replacements
+	bz, err := json.Marshal(keeper.GetEffectiveSpan(ctx, *span, 0))
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
 	}
@@ -153,3 +263,37 @@ func handleQueryNextProducers(ctx sdk.Context, req abci.RequestQuery, keeper Kee
 	}
 	return bz, nil
 }
+
+func handleQueryNextSpan(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	schedule, err := keeper.GetNextSpanSchedule(ctx)
+	if err != nil {
+		return nil, sdk.ErrInternal((sdk.AppendMsgToErr("cannot simulate next span from keeper", err.Error())))
+	}
+
+	bz, err := json.Marshal(schedule)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryValidateSpan(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var msg types.MsgProposeSpan
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &msg); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	result := types.SpanValidation{Valid: true}
+	if check, _, _, err := validateProposeSpan(ctx, msg, keeper); err != nil {
+		result = types.SpanValidation{
+			FailedCheck: check,
+			Error:       err.Error(),
+		}
+	}
+
+	bz, err := json.Marshal(result)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
@@ -1,13 +1,89 @@
 package bor
 
 import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+
 	"github.com/maticnetwork/bor/common"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
 
 	"github.com/maticnetwork/heimdall/bor/types"
 	"github.com/maticnetwork/heimdall/helper"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
+// SelectionInput is data available to producer selector for span
+type SelectionInput struct {
+	SpanID           uint64              // id of span producers are selected for
+	Seed             common.Hash         // mainchain block hash, empty if selector does not require seed
+	Validators       []hmTypes.Validator // span eligible validators
+	ProducerCount    uint64              // number of producers to be selected
+	GenesisProducers []hmTypes.Validator // producers of genesis span
+}
+
+// ProducerSelector selects producers for span
+type ProducerSelector interface {
+	// SeedRequired returns true if selector needs mainchain block hash as seed
+	SeedRequired() bool
+	// SelectProducers returns selected producers with their producer power
+	SelectProducers(input SelectionInput) ([]hmTypes.Validator, error)
+}
+
+// GetProducerSelector returns selector for producer selection strategy, empty strategy means default
+func GetProducerSelector(selection string) (ProducerSelector, error) {
+	switch selection {
+	case "", types.ProducerSelectionStakeShuffle:
+		return StakeShuffleSelector{}, nil
+	case types.ProducerSelectionRoundRobin:
+		return RoundRobinSelector{}, nil
+	case types.ProducerSelectionStakeWeighted:
+		return StakeWeightedSelector{}, nil
+	case types.ProducerSelectionFixed:
+		return FixedSelector{}, nil
+	default:
+		return nil, fmt.Errorf("unknown producer selection: %s", selection)
+	}
+}
+
+//
+// Stake shuffle
+//
+
+// StakeShuffleSelector shuffles stake weighted slots, producer power is number of slots selected
+type StakeShuffleSelector struct{}
+
+// SeedRequired returns true as slots are shuffled with seed
+func (StakeShuffleSelector) SeedRequired() bool { return true }
+
+// SelectProducers selects producers by shuffling slots
+func (StakeShuffleSelector) SelectProducers(input SelectionInput) (vals []hmTypes.Validator, err error) {
+	// if producers to be selected is more than current validators no need to select/shuffle
+	if len(input.Validators) <= int(input.ProducerCount) {
+		return input.Validators, nil
+	}
+
+	selectedIDs, err := SelectNextProducers(input.Seed, input.Validators, input.ProducerCount)
+	if err != nil {
+		return vals, err
+	}
+
+	IDToPower := make(map[uint64]int64)
+	for _, ID := range selectedIDs {
+		IDToPower[ID] = IDToPower[ID] + 1
+	}
+
+	for _, val := range input.Validators {
+		if power, ok := IDToPower[uint64(val.ID)]; ok {
+			val.VotingPower = power
+			vals = append(vals, val)
+		}
+	}
+
+	return vals, nil
+}
+
 // SelectNextProducers selects producers for next span by converting power to tickets
 func SelectNextProducers(blkHash common.Hash, spanEligibleVals []hmTypes.Validator, producerCount uint64) (selectedIDs []uint64, err error) {
 	if len(spanEligibleVals) <= int(producerCount) {
@@ -38,3 +114,121 @@ func convertToSlots(vals []hmTypes.Validator) (validatorIndices []uint64) {
 	}
 	return validatorIndices
 }
+
+//
+// Round robin
+//
+
+// RoundRobinSelector rotates through validators sorted by id, each span continues where last one stopped
+type RoundRobinSelector struct{}
+
+// SeedRequired returns false as rotation only depends on span id
+func (RoundRobinSelector) SeedRequired() bool { return false }
+
+// SelectProducers selects producer count validators starting at span id offset
+func (RoundRobinSelector) SelectProducers(input SelectionInput) (vals []hmTypes.Validator, err error) {
+	if len(input.Validators) <= int(input.ProducerCount) {
+		return input.Validators, nil
+	}
+
+	sorted := sortValidatorsByID(input.Validators)
+	total := uint64(len(sorted))
+	start := (input.SpanID * input.ProducerCount) % total
+	for i := uint64(0); i < input.ProducerCount; i++ {
+		vals = append(vals, sorted[(start+i)%total])
+	}
+
+	return vals, nil
+}
+
+//
+// Stake weighted
+//
+
+// StakeWeightedSelector draws validators proportional to stake without replacement
+type StakeWeightedSelector struct{}
+
+// SeedRequired returns true as draws are derived from seed
+func (StakeWeightedSelector) SeedRequired() bool { return true }
+
+// SelectProducers draws producer count distinct validators, validator with more power is more likely drawn
+func (StakeWeightedSelector) SelectProducers(input SelectionInput) (vals []hmTypes.Validator, err error) {
+	if len(input.Validators) <= int(input.ProducerCount) {
+		return input.Validators, nil
+	}
+
+	// validators without power can never be drawn
+	var candidates []hmTypes.Validator
+	var totalPower uint64
+	for _, val := range sortValidatorsByID(input.Validators) {
+		if val.VotingPower > 0 {
+			candidates = append(candidates, val)
+			totalPower += uint64(val.VotingPower)
+		}
+	}
+
+	if len(candidates) <= int(input.ProducerCount) {
+		return candidates, nil
+	}
+
+	buf := make([]byte, len(input.Seed)+8)
+	copy(buf, input.Seed.Bytes())
+	for round := uint64(0); round < input.ProducerCount; round++ {
+		// draw point in total power from hash of seed and round
+		binary.LittleEndian.PutUint64(buf[len(input.Seed):], round)
+		hash := hashutil.Hash(buf)
+		point := binary.LittleEndian.Uint64(hash[:8]) % totalPower
+
+		for i, val := range candidates {
+			if point < uint64(val.VotingPower) {
+				vals = append(vals, val)
+				totalPower -= uint64(val.VotingPower)
+				candidates = append(candidates[:i], candidates[i+1:]...)
+				break
+			}
+			point -= uint64(val.VotingPower)
+		}
+	}
+
+	return vals, nil
+}
+
+//
+// Fixed
+//
+
+// FixedSelector keeps producers of genesis span as long as they are eligible, meant for devnets
+type FixedSelector struct{}
+
+// SeedRequired returns false as producers never change
+func (FixedSelector) SeedRequired() bool { return false }
+
+// SelectProducers returns eligible genesis producers with their genesis producer power
+func (FixedSelector) SelectProducers(input SelectionInput) (vals []hmTypes.Validator, err error) {
+	eligible := make(map[hmTypes.ValidatorID]bool)
+	for _, val := range input.Validators {
+		eligible[val.ID] = true
+	}
+
+	for _, producer := range input.GenesisProducers {
+		if eligible[producer.ID] {
+			vals = append(vals, producer)
+		}
+	}
+
+	if len(vals) == 0 {
+		return vals, errors.New("No genesis producer is eligible for span")
+	}
+
+	return vals, nil
+}
+
+// sortValidatorsByID returns copy of validators sorted by id
+func sortValidatorsByID(vals []hmTypes.Validator) []hmTypes.Validator {
+	sorted := make([]hmTypes.Validator, len(vals))
+	copy(sorted, vals)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].ID < sorted[j].ID
+	})
+	return sorted
+}
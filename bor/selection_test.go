@@ -0,0 +1,132 @@
+package bor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/heimdall/bor/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// validators with ids 1..6 and power 10..60
+func selectionTestVals() (vals []hmTypes.Validator) {
+	for i, power := range []int64{10, 20, 30, 40, 50, 60} {
+		vals = append(vals, hmTypes.Validator{
+			ID:          hmTypes.NewValidatorID(uint64(i + 1)),
+			VotingPower: power,
+			Signer:      hmTypes.BytesToHeimdallAddress([]byte{byte(0x10 * (6 - i))}),
+		})
+	}
+	return vals
+}
+
+// returns id to producer power of selected producers
+func selectedPower(vals []hmTypes.Validator) map[uint64]int64 {
+	result := make(map[uint64]int64)
+	for _, val := range vals {
+		result[val.ID.Uint64()] = val.VotingPower
+	}
+	return result
+}
+
+func TestProducerSelectorVectors(t *testing.T) {
+	vals := selectionTestVals()
+	seed1 := common.HexToHash("0xc46afc66ad9f4b237414c23a0cf0c469aeb60f52176565990644a9ee36a17667")
+	seed2 := common.HexToHash("0x01")
+
+	tests := []struct {
+		selection string
+		spanID    uint64
+		seed      common.Hash
+		expected  map[uint64]int64
+	}{
+		// slots are shuffled, power is number of slots selected
+		{types.ProducerSelectionStakeShuffle, 1, seed1, map[uint64]int64{3: 2, 5: 2}},
+		{types.ProducerSelectionStakeShuffle, 1, seed2, map[uint64]int64{2: 1, 4: 2, 6: 1}},
+		// rotation depends only on span id
+		{types.ProducerSelectionRoundRobin, 1, seed1, map[uint64]int64{5: 50, 6: 60, 1: 10, 2: 20}},
+		{types.ProducerSelectionRoundRobin, 2, seed1, map[uint64]int64{3: 30, 4: 40, 5: 50, 6: 60}},
+		{types.ProducerSelectionRoundRobin, 2, seed2, map[uint64]int64{3: 30, 4: 40, 5: 50, 6: 60}},
+		// distinct validators keep their power
+		{types.ProducerSelectionStakeWeighted, 1, seed1, map[uint64]int64{6: 60, 4: 40, 3: 30, 5: 50}},
+		{types.ProducerSelectionStakeWeighted, 1, seed2, map[uint64]int64{3: 30, 4: 40, 5: 50, 6: 60}},
+		// genesis producers never change
+		{types.ProducerSelectionFixed, 1, seed1, map[uint64]int64{5: 50, 2: 20}},
+		{types.ProducerSelectionFixed, 2, seed2, map[uint64]int64{5: 50, 2: 20}},
+	}
+
+	for _, test := range tests {
+		selector, err := GetProducerSelector(test.selection)
+		require.NoError(t, err)
+
+		input := SelectionInput{
+			SpanID:           test.spanID,
+			Seed:             test.seed,
+			Validators:       vals,
+			ProducerCount:    4,
+			GenesisProducers: []hmTypes.Validator{vals[4], vals[1]},
+		}
+
+		// same input always selects same producers
+		for i := 0; i < 2; i++ {
+			producers, err := selector.SelectProducers(input)
+			require.NoError(t, err, test.selection)
+			require.Equal(t, test.expected, selectedPower(producers), "%s span %d", test.selection, test.spanID)
+		}
+	}
+
+	// rotation does not depend on order of validators
+	reversed := make([]hmTypes.Validator, len(vals))
+	for i, val := range vals {
+		reversed[len(vals)-1-i] = val
+	}
+	producers, err := RoundRobinSelector{}.SelectProducers(SelectionInput{SpanID: 1, Validators: reversed, ProducerCount: 4})
+	require.NoError(t, err)
+	require.Equal(t, map[uint64]int64{5: 50, 6: 60, 1: 10, 2: 20}, selectedPower(producers))
+}
+
+func TestProducerSelectorEdgeCases(t *testing.T) {
+	vals := selectionTestVals()
+
+	// default strategy is stake shuffle
+	selector, err := GetProducerSelector("")
+	require.NoError(t, err)
+	require.Equal(t, StakeShuffleSelector{}, selector)
+
+	_, err = GetProducerSelector("unknown")
+	require.Error(t, err)
+
+	// all validators are selected with their power if there are not more than producer count
+	for _, selection := range []string{types.ProducerSelectionStakeShuffle, types.ProducerSelectionRoundRobin, types.ProducerSelectionStakeWeighted} {
+		selector, err := GetProducerSelector(selection)
+		require.NoError(t, err)
+
+		producers, err := selector.SelectProducers(SelectionInput{Validators: vals[:3], ProducerCount: 4})
+		require.NoError(t, err)
+		require.Equal(t, map[uint64]int64{1: 10, 2: 20, 3: 30}, selectedPower(producers), selection)
+	}
+
+	// validators without power are never drawn
+	withoutPower := selectionTestVals()
+	withoutPower[5].VotingPower = 0
+	withoutPower[4].VotingPower = 0
+	producers, err := StakeWeightedSelector{}.SelectProducers(SelectionInput{Validators: withoutPower, ProducerCount: 4})
+	require.NoError(t, err)
+	require.Equal(t, map[uint64]int64{1: 10, 2: 20, 3: 30, 4: 40}, selectedPower(producers))
+
+	// fixed selection drops genesis producers which are not eligible anymore
+	producers, err = FixedSelector{}.SelectProducers(SelectionInput{Validators: vals[:3], GenesisProducers: []hmTypes.Validator{vals[4], vals[1]}})
+	require.NoError(t, err)
+	require.Equal(t, map[uint64]int64{2: 20}, selectedPower(producers))
+
+	_, err = FixedSelector{}.SelectProducers(SelectionInput{Validators: vals[:3], GenesisProducers: []hmTypes.Validator{vals[4]}})
+	require.Error(t, err)
+
+	// strategies are checked in genesis
+	require.NoError(t, types.ValidateGenesis(types.DefaultGenesisState()))
+	genesis := types.DefaultGenesisState()
+	genesis.ProducerSelection = "unknown"
+	require.Error(t, types.ValidateGenesis(genesis))
+}
@@ -0,0 +1,132 @@
+package bor
+
+import (
+	"math/big"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/bor/types"
+	"github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// NewSideTxHandler returns side tx handler for bor module
+func NewSideTxHandler(k Keeper, contractCaller helper.IContractCaller) hmTypes.SideTxHandler {
+	return func(ctx sdk.Context, msg sdk.Msg) hmTypes.SideTxResult {
+		switch msg := msg.(type) {
+		case types.MsgSpanStatus:
+			return sideHandleMsgSpanStatus(ctx, msg, k, contractCaller)
+		default:
+			return hmTypes.SideTxResultSkip
+		}
+	}
+}
+
+// NewPostTxHandler returns post tx handler for bor module
+func NewPostTxHandler(k Keeper) hmTypes.PostTxHandler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case types.MsgSpanStatus:
+			return postHandleMsgSpanStatus(ctx, msg, k)
+		default:
+			return sdk.ErrTxDecode("Invalid message in bor module").Result()
+		}
+	}
+}
+
+// sideHandleMsgSpanStatus verifies reported status against bor validator set contract
+func sideHandleMsgSpanStatus(ctx sdk.Context, msg types.MsgSpanStatus, k Keeper, contractCaller helper.IContractCaller) hmTypes.SideTxResult {
+	span, err := k.GetSpan(ctx, msg.SpanID)
+	if err != nil {
+		return hmTypes.SideTxResultSkip
+	}
+
+	// span is committed if contract has same span with same blocks
+	number, startBlock, endBlock, err := contractCaller.GetSpanDetails(new(big.Int).SetUint64(msg.SpanID))
+	if err != nil || number == nil || startBlock == nil || endBlock == nil {
+		return hmTypes.SideTxResultSkip
+	}
+
+	committed := number.Uint64() == span.ID &&
+		startBlock.Uint64() == span.StartBlock &&
+		endBlock.Uint64() == span.EndBlock
+
+	switch msg.Status {
+	case types.SpanStatusCommitted:
+		if !committed {
+			k.Logger(ctx).Error("Span not committed on bor", "spanId", msg.SpanID)
+			return hmTypes.SideTxResultNo
+		}
+		return hmTypes.SideTxResultYes
+	case types.SpanStatusMissed:
+		if committed {
+			k.Logger(ctx).Error("Span reported missed is committed on bor", "spanId", msg.SpanID)
+			return hmTypes.SideTxResultNo
+		}
+
+		// span is missed once bor reaches its start block without it
+		header, err := contractCaller.GetMaticChainBlock(nil)
+		if err != nil || header == nil {
+			return hmTypes.SideTxResultSkip
+		}
+
+		if header.Number.Uint64() < span.StartBlock {
+			k.Logger(ctx).Error("Span can still be committed on bor", "spanId", msg.SpanID, "borBlock", header.Number, "startBlock", span.StartBlock)
+			return hmTypes.SideTxResultNo
+		}
+		return hmTypes.SideTxResultYes
+	default:
+		return hmTypes.SideTxResultNo
+	}
+}
+
+// postHandleMsgSpanStatus saves span status once it is approved and alerts on missed span
+func postHandleMsgSpanStatus(ctx sdk.Context, msg types.MsgSpanStatus, k Keeper) sdk.Result {
+	span, err := k.GetSpan(ctx, msg.SpanID)
+	if err != nil {
+		return common.ErrSpanNotFound(k.Codespace()).Result()
+	}
+
+	// status might have changed while side tx was pending
+	spanStatus, err := k.GetSpanStatus(ctx, msg.SpanID)
+	if err != nil || !types.CanChangeSpanStatus(spanStatus.Status, msg.Status) {
+		return common.ErrInvalidSpanStatus(k.Codespace()).Result()
+	}
+
+	if err := k.SetSpanStatus(ctx, types.NewSpanStatus(msg.SpanID, msg.Status)); err != nil {
+		k.Logger(ctx).Error("Unable to update span status", "error", err, "spanId", msg.SpanID)
+		return common.ErrInvalidSpanStatus(k.Codespace()).Result()
+	}
+
+	// add events
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSpanStatus,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeySpanID, strconv.FormatUint(msg.SpanID, 10)),
+			sdk.NewAttribute(types.AttributeKeySpanStatus, msg.Status),
+		),
+	})
+
+	// alert on span which bor never committed
+	if msg.Status == types.SpanStatusMissed {
+		k.Logger(ctx).Error("Span was not committed on bor", "spanId", span.ID, "startBlock", span.StartBlock, "endBlock", span.EndBlock)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSpanMissed,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeySpanID, strconv.FormatUint(span.ID, 10)),
+				sdk.NewAttribute(types.AttributeKeySpanStartBlock, strconv.FormatUint(span.StartBlock, 10)),
+				sdk.NewAttribute(types.AttributeKeySpanEndBlock, strconv.FormatUint(span.EndBlock, 10)),
+			),
+		)
+	}
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
@@ -8,10 +8,14 @@ import (
 
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgProposeSpan{}, "bor/MsgProposeSpan", nil)
+	cdc.RegisterConcrete(MsgSpanStatus{}, "bor/MsgSpanStatus", nil)
+	cdc.RegisterConcrete(MsgReplaceProducer{}, "bor/MsgReplaceProducer", nil)
 }
 
 func RegisterPulp(pulp *authTypes.Pulp) {
 	pulp.RegisterConcrete(MsgProposeSpan{})
+	pulp.RegisterConcrete(MsgSpanStatus{})
+	pulp.RegisterConcrete(MsgReplaceProducer{})
 }
 
 // ModuleCdc generic sealed codec to be used throughout module
@@ -3,11 +3,21 @@ package types
 // staking module event types
 const (
 	EventTypeProposeSpan = "propose-span"
+	EventTypeSpanStatus  = "span-status"
+	EventTypeSpanMissed  = "span-missed"
+
+	EventTypeReplaceProducer  = "replace-producer"
+	EventTypeProducerReplaced = "producer-replaced"
 
 	AttributeKeySuccess        = "success"
 	AttributeKeyBorSyncID      = "bor-sync-id"
 	AttributeKeySpanID         = "span-id"
 	AttributeKeySpanStartBlock = "start-block"
+	AttributeKeySpanEndBlock   = "end-block"
+	AttributeKeySpanStatus     = "status"
+	AttributeKeyProducerID     = "producer-id"
+	AttributeKeyEffectiveBlock = "effective-block"
+	AttributeKeySpanVersion    = "version"
 
 	AttributeValueCategory = ModuleName
 )
@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/maticnetwork/heimdall/helper"
 	hmTypes "github.com/maticnetwork/heimdall/types"
@@ -9,30 +10,57 @@ import (
 
 // GenesisState is the bor state that must be provided at genesis.
 type GenesisState struct {
-	SprintDuration uint64          `json:"sprint_duration" yaml:"sprint_duration"` // sprint duration
-	SpanDuration   uint64          `json:"span_duration" yaml:"span_duration"`     // span duration ie number of blocks for which val set is frozen on heimdall
-	ProducerCount  uint64          `json:"producer_count" yaml:"producer_count"`   // producer count per span
-	Spans          []*hmTypes.Span `json:"spans" yaml:"spans"`                     // list of spans
+	SprintDuration    uint64          `json:"sprint_duration" yaml:"sprint_duration"`       // sprint duration
+	SpanDuration      uint64          `json:"span_duration" yaml:"span_duration"`           // span duration ie number of blocks for which val set is frozen on heimdall
+	ProducerCount     uint64          `json:"producer_count" yaml:"producer_count"`         // producer count per span
+	Spans             []*hmTypes.Span `json:"spans" yaml:"spans"`                           // list of spans
+	ChainID           string          `json:"bor_chain_id" yaml:"bor_chain_id"`             // bor chain id spans are proposed for
+	ProducerSelection string          `json:"producer_selection" yaml:"producer_selection"` // strategy used to select producers for span
+	SeedSource        string          `json:"seed_source" yaml:"seed_source"`               // source of seed used to select producers
+	SpanSeeds         []SpanSeed      `json:"span_seeds" yaml:"span_seeds"`                 // seeds used to select producers of spans
+	SpanStatuses      []SpanStatus    `json:"span_statuses" yaml:"span_statuses"`           // commit statuses of spans on bor
+	SpanVersions      []SpanVersion   `json:"span_versions" yaml:"span_versions"`           // producer sets of spans after producer replacement
 }
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(sprintDuration uint64, spanDuration uint64, producerCount uint64, spans []*hmTypes.Span) GenesisState {
+func NewGenesisState(sprintDuration uint64, spanDuration uint64, producerCount uint64, spans []*hmTypes.Span, chainID string, producerSelection string, seedSource string, spanSeeds []SpanSeed, spanStatuses []SpanStatus, spanVersions []SpanVersion) GenesisState {
 	return GenesisState{
-		SprintDuration: sprintDuration,
-		SpanDuration:   spanDuration,
-		ProducerCount:  producerCount,
-		Spans:          spans,
+		SprintDuration:    sprintDuration,
+		SpanDuration:      spanDuration,
+		ProducerCount:     producerCount,
+		Spans:             spans,
+		ChainID:           chainID,
+		ProducerSelection: producerSelection,
+		SeedSource:        seedSource,
+		SpanSeeds:         spanSeeds,
+		SpanStatuses:      spanStatuses,
+		SpanVersions:      spanVersions,
 	}
 }
 
 // DefaultGenesisState returns a default genesis state
 func DefaultGenesisState() GenesisState {
-	return NewGenesisState(DefaultSprintDuration, DefaultSpanDuration, DefaultProducerCount, nil)
+	return NewGenesisState(DefaultSprintDuration, DefaultSpanDuration, DefaultProducerCount, nil, DefaultChainID, DefaultProducerSelection, DefaultSeedSource, nil, nil, nil)
 }
 
 // ValidateGenesis performs basic validation of bor genesis data returning an
 // error for any failed validation criteria.
-func ValidateGenesis(data GenesisState) error { return nil }
+func ValidateGenesis(data GenesisState) error {
+	if !IsValidProducerSelection(data.ProducerSelection) {
+		return fmt.Errorf("invalid producer selection: %s", data.ProducerSelection)
+	}
+
+	if !IsValidSeedSource(data.SeedSource) {
+		return fmt.Errorf("invalid seed source: %s", data.SeedSource)
+	}
+
+	for _, spanStatus := range data.SpanStatuses {
+		if spanStatus.Status != SpanStatusProposed && !IsValidReportedSpanStatus(spanStatus.Status) {
+			return fmt.Errorf("invalid status %s for span %d", spanStatus.Status, spanStatus.SpanID)
+		}
+	}
+	return nil
+}
 
 // genFirstSpan generates default first valdiator producer set
 func genFirstSpan(valset hmTypes.ValidatorSet) []*hmTypes.Span {
@@ -72,6 +100,7 @@ func SetGenesisStateToAppState(appState map[string]json.RawMessage, currentValSe
 	// set state to bor state
 	borState := GetGenesisStateFromAppState(appState)
 	borState.Spans = genFirstSpan(currentValSet)
+	borState.ChainID = helper.GetConfig().BorChainID
 
 	var err error
 	appState[ModuleName], err = json.Marshal(borState)
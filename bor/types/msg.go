@@ -69,3 +69,133 @@ func (msg MsgProposeSpan) ValidateBasic() sdk.Error {
 
 	return nil
 }
+
+//
+// Span Status Msg
+//
+
+var _ sdk.Msg = &MsgSpanStatus{}
+
+// MsgSpanStatus reports commit status of span on bor validator set contract
+type MsgSpanStatus struct {
+	From   hmTypes.HeimdallAddress `json:"from"`
+	SpanID uint64                  `json:"span_id"`
+	Status string                  `json:"status"`
+}
+
+// NewMsgSpanStatus creates new span status message
+func NewMsgSpanStatus(from hmTypes.HeimdallAddress, spanID uint64, status string) MsgSpanStatus {
+	return MsgSpanStatus{
+		From:   from,
+		SpanID: spanID,
+		Status: status,
+	}
+}
+
+// Type returns message type
+func (msg MsgSpanStatus) Type() string {
+	return "span-status"
+}
+
+// Route returns route for message
+func (msg MsgSpanStatus) Route() string {
+	return RouterKey
+}
+
+// GetSigners returns address of the signer
+func (msg MsgSpanStatus) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{hmTypes.HeimdallAddressToAccAddress(msg.From)}
+}
+
+// GetSignBytes returns sign bytes for span status message type
+func (msg MsgSpanStatus) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic validates the message and returns error
+func (msg MsgSpanStatus) ValidateBasic() sdk.Error {
+	if msg.From.Empty() {
+		return sdk.ErrInvalidAddress(msg.From.String())
+	}
+
+	if !IsValidReportedSpanStatus(msg.Status) {
+		return sdk.ErrUnknownRequest("span status must be committed or missed")
+	}
+
+	return nil
+}
+
+// IsSideTxMsg marks span status as side tx
+func (msg MsgSpanStatus) IsSideTxMsg() bool {
+	return true
+}
+
+//
+// Replace Producer Msg
+//
+
+var _ sdk.Msg = &MsgReplaceProducer{}
+
+// MsgReplaceProducer votes to replace offline producer of span from effective bor block
+type MsgReplaceProducer struct {
+	From           hmTypes.HeimdallAddress `json:"from"`
+	SpanID         uint64                  `json:"span_id"`
+	ProducerID     hmTypes.ValidatorID     `json:"producer_id"`
+	EffectiveBlock uint64                  `json:"effective_block"`
+}
+
+// NewMsgReplaceProducer creates new replace producer message
+func NewMsgReplaceProducer(
+	from hmTypes.HeimdallAddress,
+	spanID uint64,
+	producerID hmTypes.ValidatorID,
+	effectiveBlock uint64,
+) MsgReplaceProducer {
+	return MsgReplaceProducer{
+		From:           from,
+		SpanID:         spanID,
+		ProducerID:     producerID,
+		EffectiveBlock: effectiveBlock,
+	}
+}
+
+// Type returns message type
+func (msg MsgReplaceProducer) Type() string {
+	return "replace-producer"
+}
+
+// Route returns route for message
+func (msg MsgReplaceProducer) Route() string {
+	return RouterKey
+}
+
+// GetSigners returns address of the signer
+func (msg MsgReplaceProducer) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{hmTypes.HeimdallAddressToAccAddress(msg.From)}
+}
+
+// GetSignBytes returns sign bytes for replace producer message type
+func (msg MsgReplaceProducer) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic validates the message and returns error
+func (msg MsgReplaceProducer) ValidateBasic() sdk.Error {
+	if msg.From.Empty() {
+		return sdk.ErrInvalidAddress(msg.From.String())
+	}
+
+	if msg.ProducerID == 0 {
+		return sdk.ErrUnknownRequest("producer id cannot be zero")
+	}
+
+	return nil
+}
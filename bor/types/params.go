@@ -19,8 +19,38 @@ const (
 
 	// Number of Producers to be selected per span
 	DefaultProducerCount uint64 = 4
+
+	// DefaultChainID bor chain id spans are proposed for
+	DefaultChainID = "15001"
+
+	// DefaultProducerSelection strategy used to select producers for span
+	DefaultProducerSelection = ProducerSelectionStakeShuffle
+
+	// DefaultSeedSource source of seed used to select producers for span
+	DefaultSeedSource = SeedSourceMainchain
+)
+
+// producer selection strategies
+const (
+	// ProducerSelectionStakeShuffle shuffles stake weighted slots with mainchain block hash as seed
+	ProducerSelectionStakeShuffle = "stake-shuffle"
+	// ProducerSelectionRoundRobin rotates through validators sorted by id, span by span
+	ProducerSelectionRoundRobin = "round-robin"
+	// ProducerSelectionStakeWeighted draws validators by stake without replacement with mainchain block hash as seed
+	ProducerSelectionStakeWeighted = "stake-weighted"
+	// ProducerSelectionFixed keeps producers of genesis span, meant for devnets
+	ProducerSelectionFixed = "fixed"
 )
 
+// IsValidProducerSelection checks if strategy is known, empty strategy means default
+func IsValidProducerSelection(selection string) bool {
+	switch selection {
+	case "", ProducerSelectionStakeShuffle, ProducerSelectionRoundRobin, ProducerSelectionStakeWeighted, ProducerSelectionFixed:
+		return true
+	}
+	return false
+}
+
 // ParamStoreKeySprintDuration is store's key for SprintDuration
 var ParamStoreKeySprintDuration = []byte("sprintduration")
 
@@ -29,11 +59,23 @@ var ParamStoreKeySpanDuration = []byte("spanduration")
 
 var ParamStoreKeyNumOfProducers = []byte("producercount")
 
+// ParamStoreKeyChainID is store's key for bor chain id
+var ParamStoreKeyChainID = []byte("chainid")
+
+// ParamStoreKeyProducerSelection is store's key for producer selection strategy
+var ParamStoreKeyProducerSelection = []byte("producerselection")
+
+// ParamStoreKeySeedSource is store's key for seed source
+var ParamStoreKeySeedSource = []byte("seedsource")
+
 // ParamKeyTable type declaration for parameters
 func ParamKeyTable() params.KeyTable {
 	return params.NewKeyTable(
 		ParamStoreKeySprintDuration, DefaultSprintDuration,
 		ParamStoreKeySpanDuration, DefaultSpanDuration,
 		ParamStoreKeyNumOfProducers, DefaultProducerCount,
+		ParamStoreKeyChainID, DefaultChainID,
+		ParamStoreKeyProducerSelection, DefaultProducerSelection,
+		ParamStoreKeySeedSource, DefaultSeedSource,
 	)
 }
@@ -8,19 +8,43 @@ const (
 	QueryLatestSpan    = "latest-span"
 	QueryNextSpan      = "next-span"
 	QueryNextProducers = "next-producers"
+	QueryValidateSpan  = "validate-span"
+	QuerySpanSeed      = "span-seed"
+	QuerySpanStatus    = "span-status"
+	QuerySpanVersions  = "span-versions"
+	QuerySpanProducers = "span-producers"
 
-	ParamSpan          = "span"
-	ParamSprint        = "sprint"
-	ParamProducerCount = "producer-count"
-	ParamLastEthBlock  = "last-eth-block"
+	ParamSpan              = "span"
+	ParamSprint            = "sprint"
+	ParamProducerCount     = "producer-count"
+	ParamLastEthBlock      = "last-eth-block"
+	ParamProducerSelection = "producer-selection"
+	ParamSeedSource        = "seed-source"
 )
 
 // QuerySpanParams defines the params for querying accounts.
 type QuerySpanParams struct {
 	RecordID uint64
+	Block    uint64 // span is served with producers effective at bor block, latest producers if zero
 }
 
 // NewQuerySpanParams creates a new instance of QuerySpanParams.
 func NewQuerySpanParams(recordID uint64) QuerySpanParams {
 	return QuerySpanParams{RecordID: recordID}
 }
+
+// NewQuerySpanAtBlockParams creates a new instance of QuerySpanParams for span effective at bor block.
+func NewQuerySpanAtBlockParams(recordID uint64, block uint64) QuerySpanParams {
+	return QuerySpanParams{RecordID: recordID, Block: block}
+}
+
+// QuerySpanProducersParams defines the params for querying producers of span at bor block.
+type QuerySpanProducersParams struct {
+	SpanID uint64
+	Block  uint64 // latest producers if zero
+}
+
+// NewQuerySpanProducersParams creates a new instance of QuerySpanProducersParams.
+func NewQuerySpanProducersParams(spanID uint64, block uint64) QuerySpanProducersParams {
+	return QuerySpanProducersParams{SpanID: spanID, Block: block}
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// NextSpanSchedule is simulated producer schedule for span following last span
+type NextSpanSchedule struct {
+	SpanID            uint64              `json:"span_id"`
+	StartBlock        uint64              `json:"start_block"`
+	EndBlock          uint64              `json:"end_block"`
+	SelectedProducers []hmTypes.Validator `json:"selected_producers"`
+}
+
+// checks performed on propose span msg, in order
+const (
+	SpanCheckID         = "span-id"
+	SpanCheckStartBlock = "start-block"
+	SpanCheckEndBlock   = "end-block"
+	SpanCheckChainID    = "chain-id"
+	SpanCheckProposer   = "proposer"
+	SpanCheckProducers  = "producers"
+)
+
+// SpanValidation is result of dry run of propose span msg
+type SpanValidation struct {
+	Valid       bool   `json:"valid"`
+	FailedCheck string `json:"failed_check,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// seed sources for producer selection
+const (
+	// SeedSourceMainchain uses hash of next mainchain block as seed
+	SeedSourceMainchain = "mainchain"
+	// SeedSourceHeimdall uses hash of last span and last checkpoint root hash as seed, it never leaves committed state
+	SeedSourceHeimdall = "heimdall"
+)
+
+// IsValidSeedSource checks if seed source is known, empty source means default
+func IsValidSeedSource(source string) bool {
+	switch source {
+	case "", SeedSourceMainchain, SeedSourceHeimdall:
+		return true
+	}
+	return false
+}
+
+// SpanSeed is seed used to select producers of span, stored to re-verify selection later
+type SpanSeed struct {
+	SpanID            uint64               `json:"span_id" yaml:"span_id"`
+	Source            string               `json:"source" yaml:"source"`
+	ProducerSelection string               `json:"producer_selection" yaml:"producer_selection"`
+	Seed              hmTypes.HeimdallHash `json:"seed" yaml:"seed"`                               // empty if selection did not need seed
+	EthBlock          uint64               `json:"eth_block,omitempty" yaml:"eth_block,omitempty"` // mainchain block used as seed
+	CheckpointRoot    hmTypes.HeimdallHash `json:"checkpoint_root" yaml:"checkpoint_root"`         // last checkpoint root hash used with last span for heimdall seed
+}
@@ -0,0 +1,43 @@
+package types
+
+// span commit statuses on bor validator set contract
+const (
+	// SpanStatusProposed span is stored on heimdall but not seen on bor yet
+	SpanStatusProposed = "proposed"
+	// SpanStatusCommitted span was committed on bor validator set contract
+	SpanStatusCommitted = "committed"
+	// SpanStatusMissed bor reached start block of span without committing it
+	SpanStatusMissed = "missed"
+)
+
+// IsValidReportedSpanStatus checks if status can be reported by bridge
+func IsValidReportedSpanStatus(status string) bool {
+	return status == SpanStatusCommitted || status == SpanStatusMissed
+}
+
+// CanChangeSpanStatus checks if span status can move from current to new status.
+// Committed is final, missed span can still be committed late.
+func CanChangeSpanStatus(current string, status string) bool {
+	switch current {
+	case SpanStatusProposed:
+		return IsValidReportedSpanStatus(status)
+	case SpanStatusMissed:
+		return status == SpanStatusCommitted
+	default:
+		return false
+	}
+}
+
+// SpanStatus is commit status of span on bor
+type SpanStatus struct {
+	SpanID uint64 `json:"span_id" yaml:"span_id"`
+	Status string `json:"status" yaml:"status"`
+}
+
+// NewSpanStatus creates new span status
+func NewSpanStatus(spanID uint64, status string) SpanStatus {
+	return SpanStatus{
+		SpanID: spanID,
+		Status: status,
+	}
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// SpanVersion is producer set of span after producer replacement, effective from bor block.
+// Version 0 is the span itself, versions are appended in order of effective block.
+// Span queries, which bor reads producers from, serve producers of latest version, or of
+// version effective at requested bor block.
+type SpanVersion struct {
+	SpanID            uint64              `json:"span_id" yaml:"span_id"`
+	Version           uint64              `json:"version" yaml:"version"`
+	EffectiveBlock    uint64              `json:"effective_block" yaml:"effective_block"`
+	ReplacedProducer  hmTypes.ValidatorID `json:"replaced_producer" yaml:"replaced_producer"`
+	SelectedProducers []hmTypes.Validator `json:"selected_producers" yaml:"selected_producers"`
+	Seed              SpanSeed            `json:"seed" yaml:"seed"`
+}
+
+// ProducerReplacementVotes are validators which voted to replace producer of span from effective block.
+// Votes expire once span is over, i.e. when the span after next one is proposed.
+type ProducerReplacementVotes struct {
+	SpanID         uint64                `json:"span_id" yaml:"span_id"`
+	ProducerID     hmTypes.ValidatorID   `json:"producer_id" yaml:"producer_id"`
+	EffectiveBlock uint64                `json:"effective_block" yaml:"effective_block"`
+	Voters         []hmTypes.ValidatorID `json:"voters" yaml:"voters"`
+}
+
+// HasVoted checks if validator already voted for replacement
+func (v ProducerReplacementVotes) HasVoted(id hmTypes.ValidatorID) bool {
+	for _, voter := range v.Voters {
+		if voter == id {
+			return true
+		}
+	}
+	return false
+}
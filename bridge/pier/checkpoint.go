@@ -3,7 +3,6 @@ package pier
 import (
 	"bytes"
 	"context"
-	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"math/big"
@@ -16,6 +15,7 @@ import (
 	ethereum "github.com/maticnetwork/bor"
 	ethCommon "github.com/maticnetwork/bor/common"
 	"github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/bor/ethclient"
 	"github.com/pkg/errors"
 	"github.com/spf13/viper"
 	"github.com/syndtr/goleveldb/leveldb"
@@ -25,17 +25,24 @@ import (
 	authTypes "github.com/maticnetwork/heimdall/auth/types"
 	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
 	"github.com/maticnetwork/heimdall/helper"
+	sidetxTypes "github.com/maticnetwork/heimdall/sidetx/types"
 	hmtypes "github.com/maticnetwork/heimdall/types"
 )
 
+// ChildChainHeader is new header of bor chain
+type ChildChainHeader struct {
+	BorChainID string
+	Header     *types.Header
+}
+
 // Checkpointer to propose
 type Checkpointer struct {
 	// Base service
 	common.BaseService
 	// storage client
 	storageClient *leveldb.DB
-	// header channel
-	HeaderChannel chan *types.Header
+	// header channel of all child chains
+	HeaderChannel chan *ChildChainHeader
 	// cancel function for poll/subscription
 	cancelSubscription context.CancelFunc
 	// header listener subscription
@@ -72,7 +79,7 @@ func NewCheckpointer(cdc *codec.Codec, queueConnector *QueueConnector, httpClien
 	// creating checkpointer object
 	checkpointer := &Checkpointer{
 		storageClient:     getBridgeDBInstance(viper.GetString(BridgeDBFlag)),
-		HeaderChannel:     make(chan *types.Header),
+		HeaderChannel:     make(chan *ChildChainHeader),
 		contractConnector: contractCaller,
 		txEncoder:         authTypes.NewTxBuilderFromCLI().WithTxEncoder(helper.GetTxEncoder()).WithChainID(helper.GetGenesisDoc().ChainID),
 
@@ -91,7 +98,7 @@ func (c *Checkpointer) startHeaderProcess(ctx context.Context) {
 		select {
 		case newHeader := <-c.HeaderChannel:
 			if isProposer(c.cliCtx) {
-				c.sendRequest(newHeader)
+				c.sendRequest(newHeader.BorChainID, newHeader.Header)
 			}
 		case <-ctx.Done():
 			return
@@ -114,18 +121,27 @@ func (c *Checkpointer) OnStart() error {
 	// start header process
 	go c.startHeaderProcess(headerCtx)
 
-	// subscribe to new head
-	subscription, err := c.contractConnector.MaticChainClient.SubscribeNewHead(ctx, c.HeaderChannel)
-	if err != nil {
-		// start go routine to poll for new header using client object
-		go c.startPolling(ctx, helper.GetConfig().CheckpointerPollInterval)
-	} else {
-		// start go routine to listen new header using subscription
-		go c.startSubscription(ctx, subscription)
-	}
+	// subscribe to new head of all child chains
+	for _, childChain := range helper.GetChildChains() {
+		client := helper.GetChildChainClient(childChain.BorChainID)
+		if client == nil {
+			c.Logger.Error("Unable to connect to child chain", "borChainID", childChain.BorChainID)
+			continue
+		}
 
-	// subscribed to new head
-	c.Logger.Debug("Subscribed to new head")
+		headers := make(chan *types.Header)
+		subscription, err := client.SubscribeNewHead(ctx, headers)
+		if err != nil {
+			// start go routine to poll for new header using client object
+			go c.startPolling(ctx, childChain.BorChainID, client, helper.GetConfig().CheckpointerPollInterval)
+		} else {
+			// start go routine to listen new header using subscription
+			go c.startSubscription(ctx, childChain.BorChainID, subscription, headers)
+		}
+
+		// subscribed to new head
+		c.Logger.Debug("Subscribed to new head", "borChainID", childChain.BorChainID)
+	}
 
 	return nil
 }
@@ -144,7 +160,7 @@ func (c *Checkpointer) OnStop() {
 	c.cancelHeaderProcess()
 }
 
-func (c *Checkpointer) startPolling(ctx context.Context, pollInterval time.Duration) {
+func (c *Checkpointer) startPolling(ctx context.Context, borChainID string, client *ethclient.Client, pollInterval time.Duration) {
 	// How often to fire the passed in function in second
 	interval := pollInterval
 	// Setup the ticket and the channel to signal
@@ -155,12 +171,12 @@ func (c *Checkpointer) startPolling(ctx context.Context, pollInterval time.Durat
 	for {
 		select {
 		case <-ticker.C:
-			header, err := c.contractConnector.MaticChainClient.HeaderByNumber(ctx, nil)
+			header, err := client.HeaderByNumber(ctx, nil)
 			if err == nil && header != nil {
 				// send data to channel
-				c.HeaderChannel <- header
+				c.HeaderChannel <- &ChildChainHeader{BorChainID: borChainID, Header: header}
 			} else if err != nil {
-				c.Logger.Error("Unable to fetch header by number", "Error", err)
+				c.Logger.Error("Unable to fetch header by number", "borChainID", borChainID, "Error", err)
 			}
 
 		case <-ctx.Done():
@@ -170,12 +186,14 @@ func (c *Checkpointer) startPolling(ctx context.Context, pollInterval time.Durat
 	}
 }
 
-func (c *Checkpointer) startSubscription(ctx context.Context, subscription ethereum.Subscription) {
+func (c *Checkpointer) startSubscription(ctx context.Context, borChainID string, subscription ethereum.Subscription, headers chan *types.Header) {
 	for {
 		select {
+		case header := <-headers:
+			c.HeaderChannel <- &ChildChainHeader{BorChainID: borChainID, Header: header}
 		case err := <-subscription.Err():
 			// stop service
-			c.Logger.Error("Error while subscribing new blocks", "error", err)
+			c.Logger.Error("Error while subscribing new blocks", "borChainID", borChainID, "error", err)
 			c.Stop()
 
 			// cancel subscription
@@ -187,8 +205,8 @@ func (c *Checkpointer) startSubscription(ctx context.Context, subscription ether
 	}
 }
 
-func (c *Checkpointer) sendRequest(newHeader *types.Header) {
-	c.Logger.Debug("New block detected", "blockNumber", newHeader.Number)
+func (c *Checkpointer) sendRequest(borChainID string, newHeader *types.Header) {
+	c.Logger.Debug("New block detected", "borChainID", borChainID, "blockNumber", newHeader.Number)
 
 	// get state
 	var expectedCheckpointState *ContractCheckpoint
@@ -202,17 +220,17 @@ func (c *Checkpointer) sendRequest(newHeader *types.Header) {
 
 	go func() {
 		defer wg.Done()
-		expectedCheckpointState, _ = c.nextExpectedCheckpoint(newHeader.Number.Uint64())
+		expectedCheckpointState, _ = c.nextExpectedCheckpoint(borChainID, newHeader.Number.Uint64())
 	}()
 
 	go func() {
 		defer wg.Done()
-		bufferedCheckpoint, _ = c.fetchBufferedCheckpoint()
+		bufferedCheckpoint, _ = c.fetchBufferedCheckpoint(borChainID)
 	}()
 
 	go func() {
 		defer wg.Done()
-		committedCheckpoint, _ = c.fetchCommittedCheckpoint()
+		committedCheckpoint, _ = c.fetchCommittedCheckpoint(borChainID)
 	}()
 
 	// wait for state collection
@@ -280,15 +298,15 @@ func (c *Checkpointer) sendRequest(newHeader *types.Header) {
 
 	start := expectedCheckpointState.newStart
 	end := expectedCheckpointState.newEnd
-	if err := c.sendCheckpointToHeimdall(start, end); err != nil {
-		c.Logger.Error("Error while sending checkpoint", "error", err)
+	if err := c.sendCheckpointToHeimdall(borChainID, start, end); err != nil {
+		c.Logger.Error("Error while sending checkpoint", "borChainID", borChainID, "error", err)
 	}
 }
 
 // fetched contract checkpoint state and returns the next probable checkpoint that needs to be sent
-func (c *Checkpointer) nextExpectedCheckpoint(latestChildBlock uint64) (*ContractCheckpoint, error) {
+func (c *Checkpointer) nextExpectedCheckpoint(borChainID string, latestChildBlock uint64) (*ContractCheckpoint, error) {
 	// fetch current header block from mainchain contract
-	_currentHeaderBlock, err := c.contractConnector.CurrentHeaderBlock()
+	_currentHeaderBlock, err := c.contractConnector.CurrentHeaderBlock(borChainID)
 	if err != nil {
 		c.Logger.Error("Error while fetching current header block number from rootchain", "error", err)
 		return nil, err
@@ -299,7 +317,7 @@ func (c *Checkpointer) nextExpectedCheckpoint(latestChildBlock uint64) (*Contrac
 
 	// get header info
 	// currentHeaderBlock = currentHeaderBlock.Sub(currentHeaderBlock, helper.GetConfig().ChildBlockInterval)
-	_, currentStart, currentEnd, lastCheckpointTime, _, err := c.contractConnector.GetHeaderInfo(currentHeaderBlockNumber.Uint64())
+	_, currentStart, currentEnd, lastCheckpointTime, _, err := c.contractConnector.GetHeaderInfo(borChainID, currentHeaderBlockNumber.Uint64())
 	if err != nil {
 		c.Logger.Error("Error while fetching current header block object from rootchain", "error", err)
 		return nil, err
@@ -317,19 +335,26 @@ func (c *Checkpointer) nextExpectedCheckpoint(latestChildBlock uint64) (*Contrac
 		start = start + 1
 	}
 
+	// fetch checkpoint params
+	checkpointParams, err := GetCheckpointParams(c.cliCtx)
+	if err != nil {
+		c.Logger.Error("Error while fetching checkpoint params", "error", err)
+		return nil, err
+	}
+
 	// get diff
 	diff := latestChildBlock - start + 1
 
 	// process if diff > 0 (positive)
 	if diff > 0 {
-		expectedDiff := diff - diff%helper.GetConfig().AvgCheckpointLength
+		expectedDiff := diff - diff%checkpointParams.AvgCheckpointLength
 		if expectedDiff > 0 {
 			expectedDiff = expectedDiff - 1
 		}
 
 		// cap with max checkpoint length
-		if expectedDiff > helper.GetConfig().MaxCheckpointLength-1 {
-			expectedDiff = helper.GetConfig().MaxCheckpointLength - 1
+		if expectedDiff > checkpointParams.MaxCheckpointLength-1 {
+			expectedDiff = checkpointParams.MaxCheckpointLength - 1
 		}
 
 		// get end result
@@ -343,11 +368,11 @@ func (c *Checkpointer) nextExpectedCheckpoint(latestChildBlock uint64) (*Contrac
 	}
 
 	// Handle when block producers go down
-	if end == 0 || end == start || (0 < diff && diff < helper.GetConfig().AvgCheckpointLength) {
+	if end == 0 || end == start || (0 < diff && diff < checkpointParams.AvgCheckpointLength) {
 		c.Logger.Debug("Fetching last header block to calculate time")
 
 		currentTime := time.Now().UTC().Unix()
-		defaultForcePushInterval := helper.GetConfig().MaxCheckpointLength * 2 // in seconds (1024 * 2 seconds)
+		defaultForcePushInterval := checkpointParams.MaxCheckpointLength * 2 // in seconds (1024 * 2 seconds)
 		if currentTime-int64(lastCheckpointTime) > int64(defaultForcePushInterval) {
 			end = latestChildBlock
 			c.Logger.Info("Force push checkpoint",
@@ -373,10 +398,10 @@ func (c *Checkpointer) nextExpectedCheckpoint(latestChildBlock uint64) (*Contrac
 }
 
 // fetch checkpoint present in buffer from heimdall
-func (c *Checkpointer) fetchBufferedCheckpoint() (*HeimdallCheckpoint, error) {
-	c.Logger.Info("Fetching checkpoint in buffer")
+func (c *Checkpointer) fetchBufferedCheckpoint(borChainID string) (*HeimdallCheckpoint, error) {
+	c.Logger.Info("Fetching checkpoint in buffer", "borChainID", borChainID)
 
-	_checkpoint, err := c.fetchCheckpoint(GetHeimdallServerEndpoint(BufferedCheckpointURL))
+	_checkpoint, err := c.fetchCheckpoint(GetHeimdallServerEndpoint(fmt.Sprintf(BufferedCheckpointURL, borChainID)))
 	if err != nil {
 		return nil, err
 	}
@@ -386,10 +411,10 @@ func (c *Checkpointer) fetchBufferedCheckpoint() (*HeimdallCheckpoint, error) {
 }
 
 // fetches latest committed checkpoint from heimdall
-func (c *Checkpointer) fetchCommittedCheckpoint() (*HeimdallCheckpoint, error) {
-	c.Logger.Info("Fetching last committed checkpoint")
+func (c *Checkpointer) fetchCommittedCheckpoint(borChainID string) (*HeimdallCheckpoint, error) {
+	c.Logger.Info("Fetching last committed checkpoint", "borChainID", borChainID)
 
-	_checkpoint, err := c.fetchCheckpoint(GetHeimdallServerEndpoint(LatestCheckpointURL))
+	_checkpoint, err := c.fetchCheckpoint(GetHeimdallServerEndpoint(fmt.Sprintf(LatestCheckpointURL, borChainID)))
 	if err != nil {
 		return nil, err
 	}
@@ -429,14 +454,14 @@ func (c *Checkpointer) fetchDividendAccountRoot() (accountroothash hmtypes.Heimd
 }
 
 // broadcast checkpoint
-func (c *Checkpointer) sendCheckpointToHeimdall(start uint64, end uint64) error {
+func (c *Checkpointer) sendCheckpointToHeimdall(borChainID string, start uint64, end uint64) error {
 	if end == 0 || start >= end {
 		c.Logger.Info("Waiting for blocks or invalid start end formation", "start", start, "end", end)
 		return errors.New("No new valid checkpoint, yet. Waiting for more blocks or time")
 	}
 
 	// Get root hash
-	root, err := checkpointTypes.GetHeaders(start, end)
+	root, err := checkpointTypes.GetHeaders(borChainID, start, end)
 	if err != nil {
 		return err
 	}
@@ -449,6 +474,7 @@ func (c *Checkpointer) sendCheckpointToHeimdall(start uint64, end uint64) error
 	}
 
 	c.Logger.Info("✅Creating and broadcasting new checkpoint",
+		"borChainID", borChainID,
 		"start", start,
 		"end", end,
 		"root", hmtypes.BytesToHeimdallHash(root),
@@ -462,6 +488,7 @@ func (c *Checkpointer) sendCheckpointToHeimdall(start uint64, end uint64) error
 		end,
 		hmtypes.BytesToHeimdallHash(root),
 		accountRootHash,
+		borChainID,
 		uint64(time.Now().UTC().Unix()),
 	)
 
@@ -471,7 +498,7 @@ func (c *Checkpointer) sendCheckpointToHeimdall(start uint64, end uint64) error
 	}
 
 	// wait for checkpoint to confirm and commit
-	go c.commitCheckpoint(start, end)
+	go c.commitCheckpoint(borChainID, start, end)
 
 	return nil
 }
@@ -484,13 +511,14 @@ func (c *Checkpointer) sendCheckpointToHeimdall(start uint64, end uint64) error
 // 	return c.queueConnector.BroadcastToHeimdall(msg)
 // }
 
-// wait for heimdall checkpoint tx to get confirmed and dispatch checkpoint
-func (c *Checkpointer) commitCheckpoint(startBlock uint64, endBlock uint64) {
-	// create tag query
+// wait for heimdall checkpoint tx to get approved by validators and dispatch checkpoint
+func (c *Checkpointer) commitCheckpoint(borChainID string, startBlock uint64, endBlock uint64) {
+	// create tag query, checkpoint event is emitted by side tx vote which approved checkpoint
 	var tags []string
+	tags = append(tags, fmt.Sprintf("checkpoint.bor-chain-id='%v'", borChainID))
 	tags = append(tags, fmt.Sprintf("checkpoint.start-block='%v'", startBlock))
 	tags = append(tags, fmt.Sprintf("checkpoint.end-block='%v'", endBlock))
-	tags = append(tags, "message.action='checkpoint'")
+	tags = append(tags, fmt.Sprintf("%v.%v='%v'", sidetxTypes.EventTypeSideTxApproved, sidetxTypes.AttributeKeyMsgRoute, checkpointTypes.RouterKey))
 
 	// handler
 	handler := func() bool {
@@ -504,11 +532,12 @@ func (c *Checkpointer) commitCheckpoint(startBlock uint64, endBlock uint64) {
 		// loop through tx
 		if searchResult.Count > 0 {
 			for _, tx := range searchResult.Txs {
-				txHash, err := hex.DecodeString(tx.TxHash)
-				if err != nil {
-					c.Logger.Error("Error while searching txs", "error", err)
+				// checkpoint tx approved by vote
+				txHash, ok := sidetxTypes.GetApprovedTxHash(tx.Events, checkpointTypes.RouterKey)
+				if !ok {
+					c.Logger.Error("Approved checkpoint tx not found in vote tx", "txHash", tx.TxHash)
 				} else {
-					if err := c.dispatchCheckpoint(tx.Height, txHash, startBlock, endBlock); err == nil {
+					if err := c.dispatchCheckpoint(borChainID, txHash.Bytes(), startBlock, endBlock); err == nil {
 						return true
 					}
 				}
@@ -545,8 +574,8 @@ func (c *Checkpointer) commitCheckpoint(startBlock uint64, endBlock uint64) {
 
 // dispatchCheckpoint prepares the data required for mainchain checkpoint submission
 // and sends a transaction to mainchain
-func (c *Checkpointer) dispatchCheckpoint(height int64, txHash []byte, start uint64, end uint64) error {
-	c.Logger.Debug("Preparing checkpoint to be pushed on chain")
+func (c *Checkpointer) dispatchCheckpoint(borChainID string, txHash []byte, start uint64, end uint64) error {
+	c.Logger.Debug("Preparing checkpoint to be pushed on chain", "borChainID", borChainID)
 
 	// proof
 	tx, err := helper.QueryTxWithProof(c.cliCtx, txHash)
@@ -554,14 +583,14 @@ func (c *Checkpointer) dispatchCheckpoint(height int64, txHash []byte, start uin
 		return err
 	}
 
-	// get votes
-	votes, sigs, chainID, err := FetchVotes(height, c.httpClient)
+	// get votes of block with checkpoint tx
+	votes, sigs, chainID, err := FetchVotes(tx.Height, c.httpClient)
 	if err != nil {
 		return err
 	}
 
 	// current child block from contract
-	currentChildBlock, err := c.contractConnector.GetLastChildBlock()
+	currentChildBlock, err := c.contractConnector.GetLastChildBlock(borChainID)
 	if err != nil {
 		return err
 	}
@@ -592,7 +621,7 @@ func (c *Checkpointer) dispatchCheckpoint(height int64, txHash []byte, start uin
 		// check if we need to send checkpoint or not
 		if ((currentChildBlock + 1) == start) || (currentChildBlock == 0 && start == 0) {
 			c.Logger.Info("Checkpoint Valid", "startBlock", start)
-			c.contractConnector.SendCheckpoint(helper.GetVoteBytes(votes, chainID), sigs, tx.Tx[authTypes.PulpHashLength:])
+			c.contractConnector.SendCheckpoint(borChainID, helper.GetVoteBytes(votes, chainID), sigs, tx.Tx[authTypes.PulpHashLength:])
 		} else if currentChildBlock > start {
 			c.Logger.Info("Start block does not match, checkpoint already sent", "commitedLastBlock", currentChildBlock, "startBlock", start)
 		} else if currentChildBlock > end {
@@ -20,6 +20,7 @@ import (
 	httpClient "github.com/tendermint/tendermint/rpc/client"
 	tmTypes "github.com/tendermint/tendermint/types"
 
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
 	"github.com/maticnetwork/heimdall/helper"
 	hmtypes "github.com/maticnetwork/heimdall/types"
 	rest "github.com/maticnetwork/heimdall/types/rest"
@@ -31,6 +32,7 @@ const (
 	NoackService         = "checkpoint-no-ack"
 	SpanServiceStr       = "span-service"
 	ClerkServiceStr      = "clerk-service"
+	SideTxServiceStr     = "sidetx-service"
 	AMQPConsumerService  = "amqp-consumer-service"
 
 	// TxsURL represents txs url
@@ -38,14 +40,21 @@ const (
 
 	AccountDetailsURL      = "/auth/accounts/%v"
 	LastNoAckURL           = "/checkpoint/last-no-ack"
+	CheckpointParamsURL    = "/checkpoint/params"
 	ProposersURL           = "/staking/proposer/%v"
-	BufferedCheckpointURL  = "/checkpoint/buffer"
-	LatestCheckpointURL    = "/checkpoint/latest-checkpoint"
+	BufferedCheckpointURL  = "/checkpoint/%v/buffer"
+	LatestCheckpointURL    = "/checkpoint/%v/latest-checkpoint"
 	CurrentProposerURL     = "/staking/current-proposer"
 	LatestSpanURL          = "/bor/latest-span"
 	NextSpanInfoURL        = "/bor/prepare-next-span"
+	SpanURL                = "/bor/span/%v"
+	SpanStatusURL          = "/bor/span/%v/status"
+	SpanVersionsURL        = "/bor/span/%v/versions"
 	DividendAccountRootURL = "/staking/dividend-account-root"
 	ValidatorURL           = "/staking/validator/%v"
+	SignerURL              = "/staking/signer/%v"
+	PendingSideTxsURL      = "/sidetx/pending"
+	SideTxResultURL        = "/sidetx/pending/%v/result"
 
 	TransactionTimeout = 1 * time.Minute
 	CommitTimeout      = 2 * time.Minute
@@ -112,6 +121,24 @@ func isEventSender(cliCtx cliContext.CLIContext, validatorID uint64) bool {
 
 }
 
+// GetCheckpointParams fetches checkpoint params
+func GetCheckpointParams(cliCtx cliContext.CLIContext) (*checkpointTypes.Params, error) {
+	response, err := FetchFromAPI(
+		cliCtx,
+		GetHeimdallServerEndpoint(CheckpointParamsURL),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var params checkpointTypes.Params
+	if err := json.Unmarshal(response.Result, &params); err != nil {
+		return nil, err
+	}
+
+	return &params, nil
+}
+
 // GetHeimdallServerEndpoint returns heimdall server endpoint
 func GetHeimdallServerEndpoint(endpoint string) string {
 	u, _ := url.Parse(helper.GetConfig().HeimdallServerURL)
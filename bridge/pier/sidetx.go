@@ -0,0 +1,191 @@
+package pier
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	cliContext "github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	ethCommon "github.com/maticnetwork/bor/common"
+	"github.com/tendermint/tendermint/libs/common"
+	httpClient "github.com/tendermint/tendermint/rpc/client"
+
+	"github.com/maticnetwork/heimdall/helper"
+	sidetxTypes "github.com/maticnetwork/heimdall/sidetx/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// SideTxService votes on pending side txs
+type SideTxService struct {
+	// Base service
+	common.BaseService
+
+	// header listener subscription
+	cancel context.CancelFunc
+
+	// cli context
+	cliCtx cliContext.CLIContext
+
+	// queue connector
+	queueConnector *QueueConnector
+
+	// http client to subscribe to
+	httpClient *httpClient.HTTP
+}
+
+// NewSideTxService returns new service object
+func NewSideTxService(cdc *codec.Codec, queueConnector *QueueConnector, httpClient *httpClient.HTTP) *SideTxService {
+	// create logger
+	logger := Logger.With("module", SideTxServiceStr)
+
+	cliCtx := cliContext.NewCLIContext().WithCodec(cdc)
+	cliCtx.BroadcastMode = client.BroadcastSync
+	cliCtx.TrustNode = true
+
+	// creating side tx service
+	sideTxService := &SideTxService{
+		cliCtx:         cliCtx,
+		queueConnector: queueConnector,
+		httpClient:     httpClient,
+	}
+
+	sideTxService.BaseService = *common.NewBaseService(logger, SideTxServiceStr, sideTxService)
+	return sideTxService
+}
+
+// OnStart starts polling for pending side txs
+func (s *SideTxService) OnStart() error {
+	s.BaseService.OnStart() // Always call the overridden method.
+
+	// create cancellable context
+	sideTxCtx, cancel := context.WithCancel(context.Background())
+
+	s.cancel = cancel
+
+	// start polling for pending side txs
+	go s.startPolling(sideTxCtx, helper.GetConfig().SideTxPollInterval)
+
+	s.Logger.Debug("Started SideTx service")
+	return nil
+}
+
+// OnStop stops all necessary go routines
+func (s *SideTxService) OnStop() {
+	s.BaseService.OnStop()
+	s.httpClient.Stop()
+
+	// cancel side tx process
+	s.cancel()
+	// close bridge db instance
+	closeBridgeDBInstance()
+}
+
+// polls heimdall for pending side txs
+func (s *SideTxService) startPolling(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	// stop ticker when everything done
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			go s.vote()
+		case <-ctx.Done():
+			ticker.Stop()
+			return
+		}
+	}
+}
+
+// vote checks all pending side txs against our node and broadcasts votes
+func (s *SideTxService) vote() {
+	validator, err := s.fetchValidator()
+	if err != nil {
+		s.Logger.Debug("Not a validator, skipping side tx votes", "error", err)
+		return
+	}
+
+	pendingTxs, err := s.fetchPendingSideTxs()
+	if err != nil {
+		s.Logger.Error("Error fetching pending side txs", "error", err)
+		return
+	}
+
+	for _, tx := range pendingTxs {
+		if tx.HasVoted(validator.ID) {
+			continue
+		}
+
+		result, err := s.fetchSideTxResult(tx.TxHash)
+		if err != nil {
+			s.Logger.Error("Error fetching side tx result", "txHash", tx.TxHash.Hex(), "error", err)
+			continue
+		}
+
+		// abstain if our node couldn't verify side tx
+		if result == hmTypes.SideTxResultSkip {
+			s.Logger.Debug("Skipping vote on side tx", "txHash", tx.TxHash.Hex())
+			continue
+		}
+
+		msg := sidetxTypes.NewMsgSideTxVote(
+			hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
+			tx.TxHash,
+			result,
+		)
+
+		if err := s.queueConnector.BroadcastToHeimdall(msg); err != nil {
+			s.Logger.Error("Error while broadcasting side tx vote", "txHash", tx.TxHash.Hex(), "error", err)
+			continue
+		}
+
+		s.Logger.Info("Voted on side tx", "txHash", tx.TxHash.Hex(), "result", result.String())
+	}
+}
+
+// fetchValidator fetches our validator from heimdall
+func (s *SideTxService) fetchValidator() (validator hmTypes.Validator, err error) {
+	response, err := FetchFromAPI(
+		s.cliCtx,
+		GetHeimdallServerEndpoint(fmt.Sprintf(SignerURL, ethCommon.BytesToAddress(helper.GetAddress()).Hex())),
+	)
+	if err != nil {
+		return validator, err
+	}
+
+	err = json.Unmarshal(response.Result, &validator)
+	return validator, err
+}
+
+// fetchPendingSideTxs fetches pending side txs from heimdall
+func (s *SideTxService) fetchPendingSideTxs() (pendingTxs []sidetxTypes.PendingSideTx, err error) {
+	response, err := FetchFromAPI(s.cliCtx, GetHeimdallServerEndpoint(PendingSideTxsURL))
+	if err != nil {
+		return nil, err
+	}
+
+	// pending side txs contain msg interface, use codec to decode
+	err = s.cliCtx.Codec.UnmarshalJSON(response.Result, &pendingTxs)
+	return pendingTxs, err
+}
+
+// fetchSideTxResult fetches result of side tx as validated by our heimdall node
+func (s *SideTxService) fetchSideTxResult(txHash hmTypes.HeimdallHash) (hmTypes.SideTxResult, error) {
+	response, err := FetchFromAPI(
+		s.cliCtx,
+		GetHeimdallServerEndpoint(fmt.Sprintf(SideTxResultURL, txHash.Hex())),
+	)
+	if err != nil {
+		return hmTypes.SideTxResultSkip, err
+	}
+
+	var result sidetxTypes.SideTxResultResponse
+	if err := json.Unmarshal(response.Result, &result); err != nil {
+		return hmTypes.SideTxResultSkip, err
+	}
+
+	return result.Result, nil
+}
@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
+	"math/big"
 	"net/http"
 	"strconv"
 	"time"
@@ -47,6 +49,9 @@ type SpanService struct {
 
 	// http client to subscribe to
 	httpClient *httpClient.HTTP
+
+	// latest span version seen per span
+	spanVersions map[uint64]uint64
 }
 
 // NewSpanService returns new service object
@@ -72,6 +77,7 @@ func NewSpanService(cdc *codec.Codec, queueConnector *QueueConnector, httpClient
 		cliCtx:         cliCtx,
 		queueConnector: queueConnector,
 		httpClient:     httpClient,
+		spanVersions:   make(map[uint64]uint64),
 	}
 
 	spanService.BaseService = *common.NewBaseService(logger, SpanServiceStr, spanService)
@@ -116,6 +122,7 @@ func (s *SpanService) startPolling(ctx context.Context, interval time.Duration)
 		select {
 		case <-ticker.C:
 			s.checkAndPropose()
+			s.checkAndReportStatus()
 		case <-ctx.Done():
 			ticker.Stop()
 			return
@@ -138,6 +145,66 @@ func (s *SpanService) checkAndPropose() {
 	}
 }
 
+// checkAndReportStatus reports commit status of recent spans read from bor validator set contract
+func (s *SpanService) checkAndReportStatus() {
+	lastSpan, err := s.getLastSpan()
+	if err != nil || lastSpan == nil {
+		return
+	}
+
+	// last span and the one before it are the only ones which can still change status
+	s.reportStatus(lastSpan)
+	if lastSpan.ID > 0 {
+		if span, err := s.getSpan(lastSpan.ID - 1); err == nil {
+			s.reportStatus(span)
+		}
+	}
+}
+
+// reportStatus broadcasts committed or missed status of span if it changed on bor
+func (s *SpanService) reportStatus(span *types.Span) {
+	// producers of span report its status, replaced producers are not producing anymore
+	if !s.isSpanProposer(s.getLatestProducers(span)) {
+		return
+	}
+
+	spanStatus, err := s.getSpanStatus(span.ID)
+	if err != nil || spanStatus.Status == borTypes.SpanStatusCommitted {
+		return
+	}
+
+	number, startBlock, endBlock, err := s.contractConnector.GetSpanDetails(new(big.Int).SetUint64(span.ID))
+	if err != nil {
+		s.Logger.Error("Unable to fetch span details from bor", "spanId", span.ID, "error", err)
+		return
+	}
+
+	status := ""
+	if number.Uint64() == span.ID && startBlock.Uint64() == span.StartBlock && endBlock.Uint64() == span.EndBlock {
+		status = borTypes.SpanStatusCommitted
+	} else if spanStatus.Status == borTypes.SpanStatusProposed {
+		currentBlock, err := s.getCurrentChildBlock()
+		if err != nil {
+			s.Logger.Error("Unable to fetch current block", "error", err)
+			return
+		}
+
+		if currentBlock >= span.StartBlock {
+			status = borTypes.SpanStatusMissed
+		}
+	}
+
+	if status == "" {
+		return
+	}
+
+	s.Logger.Info("Reporting span status", "spanId", span.ID, "status", status)
+	msg := borTypes.NewMsgSpanStatus(types.BytesToHeimdallAddress(helper.GetAddress()), span.ID, status)
+	if err := s.queueConnector.BroadcastToHeimdall(msg); err != nil {
+		s.Logger.Error("Error while broadcasting msg to heimdall", "error", err)
+	}
+}
+
 // propose producers for next span if needed
 func (s *SpanService) propose(lastSpan *types.Span, nextSpanMsg *types.Span) {
 	// call with last span on record + new span duration and see if it has been proposed
@@ -203,6 +270,65 @@ func (s *SpanService) getLastSpan() (*types.Span, error) {
 	return &lastSpan, nil
 }
 
+// getSpan fetches span by id from heimdall
+func (s *SpanService) getSpan(id uint64) (*types.Span, error) {
+	result, err := FetchFromAPI(s.cliCtx, GetHeimdallServerEndpoint(fmt.Sprintf(SpanURL, id)))
+	if err != nil {
+		s.Logger.Error("Error while fetching span", "spanId", id)
+		return nil, err
+	}
+
+	var span types.Span
+	if err := json.Unmarshal(result.Result, &span); err != nil {
+		s.Logger.Error("Error unmarshalling", "error", err)
+		return nil, err
+	}
+	return &span, nil
+}
+
+// getSpanStatus fetches commit status of span from heimdall
+func (s *SpanService) getSpanStatus(id uint64) (*borTypes.SpanStatus, error) {
+	result, err := FetchFromAPI(s.cliCtx, GetHeimdallServerEndpoint(fmt.Sprintf(SpanStatusURL, id)))
+	if err != nil {
+		s.Logger.Error("Error while fetching span status", "spanId", id)
+		return nil, err
+	}
+
+	var spanStatus borTypes.SpanStatus
+	if err := json.Unmarshal(result.Result, &spanStatus); err != nil {
+		s.Logger.Error("Error unmarshalling", "error", err)
+		return nil, err
+	}
+	return &spanStatus, nil
+}
+
+// getLatestProducers returns producers of latest span version, or selected producers if none was replaced.
+// Bor reads same producers from span query, replaced producers don't report span status.
+func (s *SpanService) getLatestProducers(span *types.Span) []types.Validator {
+	result, err := FetchFromAPI(s.cliCtx, GetHeimdallServerEndpoint(fmt.Sprintf(SpanVersionsURL, span.ID)))
+	if err != nil {
+		s.Logger.Error("Error while fetching span versions", "spanId", span.ID)
+		return span.SelectedProducers
+	}
+
+	var versions []borTypes.SpanVersion
+	if err := json.Unmarshal(result.Result, &versions); err != nil {
+		s.Logger.Error("Error unmarshalling", "error", err)
+		return span.SelectedProducers
+	}
+
+	if len(versions) == 0 {
+		return span.SelectedProducers
+	}
+
+	latest := versions[len(versions)-1]
+	if s.spanVersions[span.ID] != latest.Version {
+		s.spanVersions[span.ID] = latest.Version
+		s.Logger.Info("New span version found", "spanId", span.ID, "version", latest.Version, "effectiveBlock", latest.EffectiveBlock, "replacedProducer", latest.ReplacedProducer)
+	}
+	return latest.SelectedProducers
+}
+
 // getCurrentChildBlock gets the current child block
 func (s *SpanService) getCurrentChildBlock() (uint64, error) {
 	childBlock, err := s.contractConnector.GetMaticChainBlock(nil)
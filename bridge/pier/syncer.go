@@ -214,8 +214,15 @@ func (syncer *Syncer) processHeader(newHeader *types.Header) {
 
 	latestNumber := newHeader.Number
 
+	// fetch checkpoint params
+	checkpointParams, err := GetCheckpointParams(syncer.cliCtx)
+	if err != nil {
+		syncer.Logger.Error("Error while fetching checkpoint params", "error", err)
+		return
+	}
+
 	// confirmation
-	confirmationBlocks := big.NewInt(0).SetUint64(helper.GetConfig().ConfirmationBlocks)
+	confirmationBlocks := big.NewInt(0).SetUint64(checkpointParams.ConfirmationBlocks)
 	confirmationBlocks = confirmationBlocks.Add(confirmationBlocks, big.NewInt(1))
 	if latestNumber.Uint64() > confirmationBlocks.Uint64() {
 		latestNumber = latestNumber.Sub(latestNumber, confirmationBlocks)
@@ -264,12 +271,19 @@ func (syncer *Syncer) processHeader(newHeader *types.Header) {
 		FromBlock: fromBlock,
 		ToBlock:   toBlock,
 		Addresses: []ethCommon.Address{
-			helper.GetRootChainAddress(),
 			helper.GetStakingInfoAddress(),
 			helper.GetStateSenderAddress(),
 		},
 	}
 
+	// add rootchain contracts of all child chains
+	borChainIDs := make(map[ethCommon.Address]string)
+	for _, childChain := range helper.GetChildChains() {
+		rootChainAddress := ethCommon.HexToAddress(childChain.RootchainAddress)
+		borChainIDs[rootChainAddress] = childChain.BorChainID
+		query.Addresses = append(query.Addresses, rootChainAddress)
+	}
+
 	// get all logs
 	logs, err := syncer.contractConnector.MainChainClient.FilterLogs(context.Background(), query)
 	if err != nil {
@@ -288,7 +302,7 @@ func (syncer *Syncer) processHeader(newHeader *types.Header) {
 				syncer.Logger.Debug("selectedEvent ", " event name -", selectedEvent.Name)
 				switch selectedEvent.Name {
 				case "NewHeaderBlock":
-					syncer.processCheckpointEvent(selectedEvent.Name, abiObject, &vLog)
+					syncer.processCheckpointEvent(borChainIDs[vLog.Address], selectedEvent.Name, abiObject, &vLog)
 				// TODO remove post new bridge design
 				// case "Staked":
 				// 	syncer.processStakedEvent(selectedEvent.Name, abiObject, &vLog)
@@ -302,6 +316,14 @@ func (syncer *Syncer) processHeader(newHeader *types.Header) {
 					syncer.processReStakedEvent(selectedEvent.Name, abiObject, &vLog)
 				case "Jailed":
 					syncer.processJailedEvent(selectedEvent.Name, abiObject, &vLog)
+				case "ShareMinted":
+					syncer.processShareMintedEvent(selectedEvent.Name, abiObject, &vLog)
+				case "ShareBurned":
+					syncer.processShareBurnedEvent(selectedEvent.Name, abiObject, &vLog)
+				case "StartAuction":
+					syncer.processStartAuctionEvent(selectedEvent.Name, abiObject, &vLog)
+				case "ConfirmAuction":
+					syncer.processConfirmAuctionEvent(selectedEvent.Name, abiObject, &vLog)
 				case "StateSynced":
 					syncer.processStateSyncedEvent(selectedEvent.Name, abiObject, &vLog)
 				case "TopUpFee":
@@ -315,7 +337,7 @@ func (syncer *Syncer) processHeader(newHeader *types.Header) {
 	}
 }
 
-func (syncer *Syncer) processCheckpointEvent(eventName string, abiObject *abi.ABI, vLog *types.Log) {
+func (syncer *Syncer) processCheckpointEvent(borChainID string, eventName string, abiObject *abi.ABI, vLog *types.Log) {
 	event := new(rootchain.RootchainNewHeaderBlock)
 	if err := helper.UnpackLog(abiObject, event, eventName, vLog); err != nil {
 		logEventParseError(syncer.Logger, eventName, err)
@@ -323,6 +345,7 @@ func (syncer *Syncer) processCheckpointEvent(eventName string, abiObject *abi.AB
 		syncer.Logger.Info(
 			"⬜ New event found",
 			"event", eventName,
+			"borChainID", borChainID,
 			"start", event.Start,
 			"end", event.End,
 			"reward", event.Reward,
@@ -332,7 +355,18 @@ func (syncer *Syncer) processCheckpointEvent(eventName string, abiObject *abi.AB
 		)
 
 		// create msg checkpoint ack message
-		msg := checkpointTypes.NewMsgCheckpointAck(helper.GetFromAddress(syncer.cliCtx), event.HeaderBlockId.Uint64(), hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()), uint64(vLog.Index))
+		msg := checkpointTypes.NewMsgCheckpointAck(
+			helper.GetFromAddress(syncer.cliCtx),
+			event.HeaderBlockId.Uint64(),
+			hmTypes.BytesToHeimdallAddress(event.Proposer.Bytes()),
+			event.Start.Uint64(),
+			event.End.Uint64(),
+			hmTypes.BytesToHeimdallHash(event.Root[:]),
+			borChainID,
+			hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+			uint64(vLog.Index),
+			vLog.BlockNumber,
+		)
 		syncer.queueConnector.BroadcastToHeimdall(msg)
 	}
 }
@@ -357,6 +391,8 @@ func (syncer *Syncer) processStakedEvent(eventName string, abiObject *abi.ABI, v
 			msg := stakingTypes.NewMsgValidatorJoin(
 				hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
 				event.ValidatorId.Uint64(),
+				event.ActivationEpoch.Uint64(),
+				hmTypes.NewIntFromBigInt(event.Amount),
 				hmTypes.NewPubKey(pubkey[:]),
 				hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
 				uint64(vLog.Index),
@@ -387,6 +423,7 @@ func (syncer *Syncer) processUnstakeInitEvent(eventName string, abiObject *abi.A
 			msg := stakingTypes.NewMsgValidatorExit(
 				hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
 				event.ValidatorId.Uint64(),
+				event.DeactivationEpoch.Uint64(),
 				hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
 				uint64(vLog.Index),
 			)
@@ -414,8 +451,10 @@ func (syncer *Syncer) processStakeUpdateEvent(eventName string, abiObject *abi.A
 			msg := stakingTypes.NewMsgStakeUpdate(
 				hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
 				event.ValidatorId.Uint64(),
+				hmTypes.NewIntFromBigInt(event.NewAmount),
 				hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
 				uint64(vLog.Index),
+				vLog.BlockNumber,
 			)
 
 			// broadcast heimdall
@@ -446,6 +485,7 @@ func (syncer *Syncer) processSignerChangeEvent(eventName string, abiObject *abi.
 				hmTypes.NewPubKey(pubkey[:]),
 				hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
 				uint64(vLog.Index),
+				vLog.BlockNumber,
 			)
 
 			// process signer update
@@ -464,17 +504,23 @@ func (syncer *Syncer) processReStakedEvent(eventName string, abiObject *abi.ABI,
 			"event", eventName,
 			"validatorId", event.ValidatorId,
 			"amount", event.Amount,
+			"total", event.Total,
 		)
 
-		// // msg validator exit
-		// msg := staking.NewMsgValidatorExit(
-		// 	hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
-		// 	event.ValidatorId.Uint64(),
-		// 	vLog.TxHash,
-		// )
+		// msg validator restake
+		if isEventSender(syncer.cliCtx, event.ValidatorId.Uint64()) {
+			msg := stakingTypes.NewMsgValidatorRestake(
+				hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
+				event.ValidatorId.Uint64(),
+				hmTypes.NewIntFromBigInt(event.Amount),
+				hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+				uint64(vLog.Index),
+				vLog.BlockNumber,
+			)
 
-		// // broadcast heimdall
-		// syncer.queueConnector.BroadcastToHeimdall(msg)
+			// broadcast heimdall
+			syncer.queueConnector.BroadcastToHeimdall(msg)
+		}
 	}
 }
 
@@ -490,15 +536,185 @@ func (syncer *Syncer) processJailedEvent(eventName string, abiObject *abi.ABI, v
 			"exitEpoch", event.ExitEpoch,
 		)
 
-		// // msg validator exit
-		// msg := staking.NewMsgValidatorExit(
-		// 	hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
-		// 	event.ValidatorId.Uint64(),
-		// 	vLog.TxHash,
-		// )
+		// jailed validator might be offline, current proposer sends jailed msg
+		if isProposer(syncer.cliCtx) {
+			msg := stakingTypes.NewMsgValidatorJailed(
+				hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
+				event.ValidatorId.Uint64(),
+				event.ExitEpoch.Uint64(),
+				hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+				uint64(vLog.Index),
+				vLog.BlockNumber,
+			)
+
+			// broadcast heimdall
+			syncer.queueConnector.BroadcastToHeimdall(msg)
+		}
+	}
+}
+
+func (syncer *Syncer) processShareMintedEvent(eventName string, abiObject *abi.ABI, vLog *types.Log) {
+	event := new(stakinginfo.StakinginfoShareMinted)
+	if err := helper.UnpackLog(abiObject, event, eventName, vLog); err != nil {
+		logEventParseError(syncer.Logger, eventName, err)
+	} else {
+		syncer.Logger.Debug(
+			"⬜ New event found",
+			"event", eventName,
+			"validatorID", event.ValidatorId,
+			"user", event.User.Hex(),
+			"amount", event.Amount,
+			"tokens", event.Tokens,
+		)
+
+		// delegator is not a validator, current proposer sends delegate msg
+		if isProposer(syncer.cliCtx) {
+			msg := stakingTypes.NewMsgDelegate(
+				hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
+				event.ValidatorId.Uint64(),
+				hmTypes.BytesToHeimdallAddress(event.User.Bytes()),
+				hmTypes.NewIntFromBigInt(event.Amount),
+				hmTypes.NewIntFromBigInt(event.Tokens),
+				hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+				uint64(vLog.Index),
+				vLog.BlockNumber,
+			)
+
+			// broadcast heimdall
+			syncer.queueConnector.BroadcastToHeimdall(msg)
+		}
+	}
+}
+
+func (syncer *Syncer) processShareBurnedEvent(eventName string, abiObject *abi.ABI, vLog *types.Log) {
+	event := new(stakinginfo.StakinginfoShareBurned)
+	if err := helper.UnpackLog(abiObject, event, eventName, vLog); err != nil {
+		logEventParseError(syncer.Logger, eventName, err)
+	} else {
+		syncer.Logger.Debug(
+			"⬜ New event found",
+			"event", eventName,
+			"validatorID", event.ValidatorId,
+			"user", event.User.Hex(),
+			"amount", event.Amount,
+			"tokens", event.Tokens,
+		)
+
+		// delegator is not a validator, current proposer sends undelegate msg
+		if isProposer(syncer.cliCtx) {
+			msg := stakingTypes.NewMsgUndelegate(
+				hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
+				event.ValidatorId.Uint64(),
+				hmTypes.BytesToHeimdallAddress(event.User.Bytes()),
+				hmTypes.NewIntFromBigInt(event.Amount),
+				hmTypes.NewIntFromBigInt(event.Tokens),
+				hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+				uint64(vLog.Index),
+				vLog.BlockNumber,
+			)
+
+			// broadcast heimdall
+			syncer.queueConnector.BroadcastToHeimdall(msg)
+		}
+	}
+}
+
+func (syncer *Syncer) processStartAuctionEvent(eventName string, abiObject *abi.ABI, vLog *types.Log) {
+	event := new(stakinginfo.StakinginfoStartAuction)
+	if err := helper.UnpackLog(abiObject, event, eventName, vLog); err != nil {
+		logEventParseError(syncer.Logger, eventName, err)
+	} else {
+		syncer.Logger.Debug(
+			"⬜ New event found",
+			"event", eventName,
+			"validatorID", event.ValidatorId,
+			"amount", event.Amount,
+			"auctionAmount", event.AuctionAmount,
+		)
+
+		// bidder is not a validator yet, current proposer sends auction msg
+		if isProposer(syncer.cliCtx) {
+			msg := stakingTypes.NewMsgStartAuction(
+				hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
+				event.ValidatorId.Uint64(),
+				hmTypes.NewIntFromBigInt(event.Amount),
+				hmTypes.NewIntFromBigInt(event.AuctionAmount),
+				hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+				uint64(vLog.Index),
+				vLog.BlockNumber,
+			)
+
+			// broadcast heimdall
+			syncer.queueConnector.BroadcastToHeimdall(msg)
+		}
+	}
+}
+
+func (syncer *Syncer) processConfirmAuctionEvent(eventName string, abiObject *abi.ABI, vLog *types.Log) {
+	event := new(stakinginfo.StakinginfoConfirmAuction)
+	if err := helper.UnpackLog(abiObject, event, eventName, vLog); err != nil {
+		logEventParseError(syncer.Logger, eventName, err)
+		return
+	}
+
+	syncer.Logger.Debug(
+		"⬜ New event found",
+		"event", eventName,
+		"newValidatorID", event.NewValidatorId,
+		"oldValidatorID", event.OldValidatorId,
+		"amount", event.Amount,
+	)
 
-		// // broadcast heimdall
-		// syncer.queueConnector.BroadcastToHeimdall(msg)
+	checkpointParams, err := GetCheckpointParams(syncer.cliCtx)
+	if err != nil {
+		syncer.Logger.Error("Error while fetching checkpoint params", "error", err)
+		return
+	}
+
+	// auction winner is staked in same tx
+	receipt, err := syncer.contractConnector.GetConfirmedTxReceipt(vLog.TxHash, checkpointParams.ConfirmationBlocks)
+	if err != nil || receipt == nil {
+		syncer.Logger.Error("Unable to fetch receipt of confirm auction", "txHash", vLog.TxHash.Hex(), "error", err)
+		return
+	}
+
+	for _, stakedLog := range receipt.Logs {
+		if len(stakedLog.Topics) == 0 {
+			continue
+		}
+
+		selectedEvent := helper.EventByID(&syncer.contractConnector.StakingInfoABI, stakedLog.Topics[0].Bytes())
+		if selectedEvent == nil || selectedEvent.Name != stakeInitEvent {
+			continue
+		}
+
+		staked, err := syncer.contractConnector.DecodeValidatorJoinEvent(receipt, uint64(stakedLog.Index))
+		if err != nil || staked.ValidatorId.Cmp(event.NewValidatorId) != 0 {
+			continue
+		}
+
+		// only auction winner has pubkey of new signer
+		if !bytes.Equal(staked.Signer.Bytes(), helper.GetAddress()) {
+			return
+		}
+
+		pubkey := helper.GetPubKey()
+		msg := stakingTypes.NewMsgConfirmAuction(
+			hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
+			event.OldValidatorId.Uint64(),
+			event.NewValidatorId.Uint64(),
+			staked.ActivationEpoch.Uint64(),
+			hmTypes.NewIntFromBigInt(event.Amount),
+			hmTypes.NewPubKey(pubkey[:]),
+			hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+			uint64(vLog.Index),
+			uint64(stakedLog.Index),
+			vLog.BlockNumber,
+		)
+
+		// broadcast heimdall
+		syncer.queueConnector.BroadcastToHeimdall(msg)
+		return
 	}
 }
 
@@ -545,6 +761,8 @@ func (syncer *Syncer) processStateSyncedEvent(eventName string, abiObject *abi.A
 			hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
 			uint64(vLog.Index),
 			event.Id.Uint64(),
+			hmTypes.BytesToHeimdallAddress(event.ContractAddress.Bytes()),
+			event.Data,
 		)
 
 		// broadcast to heimdall
@@ -566,8 +784,23 @@ func (syncer *Syncer) processTopupFeeEvent(eventName string, abiObject *abi.ABI,
 			"Fee", event.Fee,
 		)
 
-		// create msg checkpoint ack message
-		msg := bankTypes.NewMsgTopup(helper.GetFromAddress(syncer.cliCtx), event.ValidatorId.Uint64(), hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()), uint64(vLog.Index))
+		// fetch validator signer from mainchain
+		validator, err := syncer.contractConnector.GetValidatorInfo(hmTypes.NewValidatorID(event.ValidatorId.Uint64()))
+		if err != nil {
+			syncer.Logger.Error("Unable to fetch validator from rootchain", "validatorId", event.ValidatorId, "error", err)
+			return
+		}
+
+		// create msg topup message
+		msg := bankTypes.NewMsgTopup(
+			helper.GetFromAddress(syncer.cliCtx),
+			event.ValidatorId.Uint64(),
+			validator.Signer,
+			hmTypes.NewIntFromBigInt(event.Fee),
+			hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+			uint64(vLog.Index),
+			vLog.BlockNumber,
+		)
 		syncer.queueConnector.BroadcastToHeimdall(msg)
 	}
 }
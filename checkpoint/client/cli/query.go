@@ -33,12 +33,51 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 			GetLastNoACK(cdc),
 			GetHeaderFromIndex(cdc),
 			GetCheckpointCount(cdc),
+			GetQueryParams(cdc),
 		)...,
 	)
 
 	return supplyQueryCmd
 }
 
+// GetConfirmationBlocks fetches confirmations required for root chain txs from checkpoint params
+func GetConfirmationBlocks(cliCtx context.CLIContext) (uint64, error) {
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParams), nil)
+	if err != nil {
+		return 0, err
+	}
+
+	var params types.Params
+	if err := cliCtx.Codec.UnmarshalJSON(res, &params); err != nil {
+		return 0, err
+	}
+	return params.ConfirmationBlocks, nil
+}
+
+// GetQueryParams implements the params query command.
+func GetQueryParams(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "show the current checkpoint parameters information",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParams), nil)
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			if err := cliCtx.Codec.UnmarshalJSON(res, &params); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
+
 // GetCheckpointBuffer get checkpoint present in buffer
 func GetCheckpointBuffer(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -47,7 +86,13 @@ func GetCheckpointBuffer(cdc *codec.Codec) *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointBuffer), nil)
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryBorChainParams(viper.GetString(FlagBorChainID)))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointBuffer), queryParams)
 			if err != nil {
 				return err
 			}
@@ -60,6 +105,7 @@ func GetCheckpointBuffer(cdc *codec.Codec) *cobra.Command {
 			return nil
 		},
 	}
+	cmd.Flags().String(FlagBorChainID, "", "--bor-chain-id=<bor-chain-id>")
 
 	return cmd
 }
@@ -104,7 +150,7 @@ func GetHeaderFromIndex(cdc *codec.Codec) *cobra.Command {
 			headerNumber := viper.GetInt(FlagHeaderNumber)
 
 			// get query params
-			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(uint64(headerNumber)))
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(viper.GetString(FlagBorChainID), uint64(headerNumber)))
 			if err != nil {
 				return err
 			}
@@ -119,6 +165,7 @@ func GetHeaderFromIndex(cdc *codec.Codec) *cobra.Command {
 			return nil
 		},
 	}
+	cmd.Flags().String(FlagBorChainID, "", "--bor-chain-id=<bor-chain-id>")
 	cmd.MarkFlagRequired(FlagHeaderNumber)
 
 	return cmd
@@ -132,7 +179,13 @@ func GetCheckpointCount(cdc *codec.Codec) *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAckCount), nil)
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryBorChainParams(viper.GetString(FlagBorChainID)))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAckCount), queryParams)
 			if err != nil {
 				return err
 			}
@@ -150,6 +203,7 @@ func GetCheckpointCount(cdc *codec.Codec) *cobra.Command {
 			return nil
 		},
 	}
+	cmd.Flags().String(FlagBorChainID, "", "--bor-chain-id=<bor-chain-id>")
 
 	return cmd
 }
@@ -96,6 +96,7 @@ func SendCheckpointTx(cdc *codec.Codec) *cobra.Command {
 				endBlock,
 				hmTypes.HexToHeimdallHash(rootHashStr),
 				hmTypes.HexToHeimdallHash(accountRootHashStr),
+				getBorChainID(),
 				uint64(time.Now().UTC().Unix()),
 			)
 
@@ -107,6 +108,7 @@ func SendCheckpointTx(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String(FlagEndBlock, "", "--end-block=<end-block-number>")
 	cmd.Flags().StringP(FlagRootHash, "r", "", "--root-hash=<root-hash>")
 	cmd.Flags().String(FlagAccountRootHash, "", "--account-root=<account-root>")
+	cmd.Flags().String(FlagBorChainID, "", "--bor-chain-id=<bor-chain-id>")
 	cmd.MarkFlagRequired(FlagStartBlock)
 	cmd.MarkFlagRequired(FlagEndBlock)
 	cmd.MarkFlagRequired(FlagRootHash)
@@ -129,25 +131,50 @@ func SendCheckpointACKTx(cdc *codec.Codec) *cobra.Command {
 				proposer = helper.GetFromAddress(cliCtx)
 			}
 
-			headerBlockStr := viper.GetString(FlagHeaderNumber)
-			if headerBlockStr == "" {
-				return fmt.Errorf("header number cannot be empty")
+			checkpointTxHashStr := viper.GetString(FlagCheckpointTxHash)
+			if checkpointTxHashStr == "" {
+				return fmt.Errorf("checkpoint tx hash cannot be empty")
 			}
 
-			headerBlock, err := strconv.ParseUint(headerBlockStr, 10, 64)
+			checkpointTxHash := hmTypes.BytesToHeimdallHash(common.FromHex(checkpointTxHashStr))
+			logIndex := uint64(viper.GetInt64(FlagCheckpointLogIndex))
+
+			contractCallerObj, err := helper.NewContractCaller()
 			if err != nil {
 				return err
 			}
 
-			checkpointTxHashStr := viper.GetString(FlagCheckpointTxHash)
-			if checkpointTxHashStr == "" {
-				return fmt.Errorf("checkpoint tx hash cannot be empty")
+			// confirmations required on main chain are checkpoint param
+			confirmationBlocks, err := GetConfirmationBlocks(cliCtx)
+			if err != nil {
+				return err
 			}
 
-			checkpointTxHash := hmTypes.BytesToHeimdallHash(common.FromHex(checkpointTxHashStr))
+			// get main tx receipt
+			receipt, err := contractCallerObj.GetConfirmedTxReceipt(checkpointTxHash.EthHash(), confirmationBlocks)
+			if err != nil || receipt == nil {
+				return fmt.Errorf("Transaction is not confirmed yet. Please wait for sometime and try again")
+			}
+
+			// decode header block submitted on root chain
+			event, err := contractCallerObj.DecodeNewHeaderBlockEvent(receipt, logIndex)
+			if err != nil {
+				return err
+			}
 
 			// new checkpoint
-			msg := types.NewMsgCheckpointAck(proposer, headerBlock, checkpointTxHash, uint64(viper.GetInt64(FlagCheckpointLogIndex)))
+			msg := types.NewMsgCheckpointAck(
+				proposer,
+				event.HeaderBlockId.Uint64(),
+				hmTypes.BytesToHeimdallAddress(event.Proposer.Bytes()),
+				event.Start.Uint64(),
+				event.End.Uint64(),
+				hmTypes.BytesToHeimdallHash(event.Root[:]),
+				getBorChainID(),
+				checkpointTxHash,
+				logIndex,
+				receipt.BlockNumber.Uint64(),
+			)
 
 			// msg
 			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
@@ -155,11 +182,10 @@ func SendCheckpointACKTx(cdc *codec.Codec) *cobra.Command {
 	}
 
 	cmd.Flags().StringP(FlagProposerAddress, "p", "", "--proposer=<proposer-address>")
-	cmd.Flags().String(FlagHeaderNumber, "", "--header=<header-index>")
 	cmd.Flags().StringP(FlagCheckpointTxHash, "t", "", "--txhash=<checkpoint-txhash>")
 	cmd.Flags().String(FlagCheckpointLogIndex, "", "--log-index=<log-index>")
+	cmd.Flags().String(FlagBorChainID, "", "--bor-chain-id=<bor-chain-id>")
 
-	cmd.MarkFlagRequired(FlagHeaderNumber)
 	cmd.MarkFlagRequired(FlagCheckpointTxHash)
 	cmd.MarkFlagRequired(FlagCheckpointLogIndex)
 
@@ -194,3 +220,11 @@ func SendCheckpointNoACKTx(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().StringP(FlagProposerAddress, "p", "", "--proposer=<proposer-address>")
 	return cmd
 }
+
+// getBorChainID returns bor chain id from flag, defaults to configured bor chain
+func getBorChainID() string {
+	if borChainID := viper.GetString(FlagBorChainID); borChainID != "" {
+		return borChainID
+	}
+	return helper.GetConfig().BorChainID
+}
@@ -14,8 +14,10 @@ import (
 
 	"github.com/maticnetwork/bor/common"
 	ethcmn "github.com/maticnetwork/bor/common"
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
 	"github.com/maticnetwork/heimdall/checkpoint/types"
 	"github.com/maticnetwork/heimdall/helper"
+	sidetxTypes "github.com/maticnetwork/heimdall/sidetx/types"
 	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 	hmRest "github.com/maticnetwork/heimdall/types/rest"
@@ -27,10 +29,18 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		checkpointBufferHandlerFn(cliCtx),
 	).Methods("GET")
 
+	r.HandleFunc("/checkpoint/chains",
+		borChainsHandlerFn(cliCtx),
+	).Methods("GET")
+
 	r.HandleFunc("/checkpoint/count",
 		checkpointCountHandlerFn(cliCtx),
 	).Methods("GET")
 
+	r.HandleFunc("/checkpoint/params",
+		paramsHandlerFn(cliCtx),
+	).Methods("GET")
+
 	r.HandleFunc(
 		"/checkpoint/headers/{headerBlockIndex}",
 		checkpointHeaderHandlerFn(cliCtx),
@@ -44,6 +54,30 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		checkpointByNumberHandlerFunc(cliCtx),
 	).Methods("GET")
 
+	r.HandleFunc("/checkpoint/proposer-stats/{id}",
+		proposerStatsHandlerFn(cliCtx),
+	).Methods("GET")
+
+	r.HandleFunc("/checkpoint/proof/{blockNumber}",
+		blockProofHandlerFn(cliCtx),
+	).Methods("GET")
+
+	r.HandleFunc("/checkpoint/{chainId}/buffer",
+		checkpointBufferHandlerFn(cliCtx),
+	).Methods("GET")
+
+	r.HandleFunc("/checkpoint/{chainId}/count",
+		checkpointCountHandlerFn(cliCtx),
+	).Methods("GET")
+
+	r.HandleFunc("/checkpoint/{chainId}/latest-checkpoint",
+		latestCheckpointHandlerFunc(cliCtx),
+	).Methods("GET")
+
+	r.HandleFunc("/checkpoint/{headerIndex}/submit-data",
+		checkpointSubmitDataHandlerFn(cliCtx),
+	).Methods("GET")
+
 	r.HandleFunc("/checkpoint/{start}/{end}",
 		checkpointHandlerFn(cliCtx),
 	).Methods("GET")
@@ -58,6 +92,32 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		checkpointListhandlerFn(cliCtx)).Methods("GET")
 }
 
+// getBorChainID returns bor chain id from route or query, empty bor chain id refers to primary bor chain
+func getBorChainID(r *http.Request) string {
+	if borChainID, ok := mux.Vars(r)["chainId"]; ok {
+		return borChainID
+	}
+	return r.URL.Query().Get("bor_chain_id")
+}
+
+func borChainsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		result, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryBorChains), nil)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, result)
+	}
+}
+
 func checkpointBufferHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
@@ -65,8 +125,15 @@ func checkpointBufferHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryBorChainParams(getBorChainID(r)))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
 		// fetch checkpoint
-		result, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointBuffer), nil)
+		result, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointBuffer), queryParams)
 		if err != nil {
 			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
@@ -77,6 +144,24 @@ func checkpointBufferHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+func paramsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParams), nil)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 func checkpointCountHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
@@ -84,8 +169,15 @@ func checkpointCountHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryBorChainParams(getBorChainID(r)))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
 		RestLogger.Debug("Fetching number of checkpoints from state")
-		ackCountBytes, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAckCount), nil)
+		ackCountBytes, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAckCount), queryParams)
 		if err != nil {
 			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
@@ -130,7 +222,7 @@ func checkpointHeaderHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		}
 
 		// get query params
-		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(headerNumber))
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(getBorChainID(r), headerNumber))
 		if err != nil {
 			return
 		}
@@ -147,8 +239,187 @@ func checkpointHeaderHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+func blockProofHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get block number
+		blockNumber, ok := rest.ParseUint64OrReturnBadRequest(w, vars["blockNumber"])
+		if !ok {
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryBlockProofParams(getBorChainID(r), blockNumber))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// fetch proof
+		result, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryProof), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, result)
+	}
+}
+
+func proposerStatsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get validator id
+		validatorID, ok := rest.ParseUint64OrReturnBadRequest(w, vars["id"])
+		if !ok {
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryProposerStatsParams(hmTypes.NewValidatorID(validatorID)))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// fetch proposer stats
+		result, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryProposerStats), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, result)
+	}
+}
+
+// checkpointSubmitDataHandlerFn returns vote, sigs and tx data to submit checkpoint at header index on root chain
+func checkpointSubmitDataHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get header index
+		headerIndex, ok := rest.ParseUint64OrReturnBadRequest(w, vars["headerIndex"])
+		if !ok {
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(getBorChainID(r), headerIndex))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// fetch checkpoint for header index
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySubmitCheckpoint), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		var checkpoint hmTypes.CheckpointBlockHeader
+		if err := json.Unmarshal(res, &checkpoint); err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		// fetch checkpoint tx with proof and votes
+		submitData, err := fetchCheckpointSubmitData(cliCtx, checkpoint)
+		if err != nil {
+			RestLogger.Error("Unable to fetch checkpoint submit data", "headerIndex", headerIndex, "error", err)
+			hmRest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+		submitData.HeaderIndex = headerIndex
+
+		result, err := json.Marshal(submitData)
+		if err != nil {
+			RestLogger.Error("Error while marshalling resposne to Json", "error", err)
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, result)
+	}
+}
+
+// fetchCheckpointSubmitData finds checkpoint tx approved by validators and collects votes which committed it
+func fetchCheckpointSubmitData(cliCtx context.CLIContext, checkpoint hmTypes.CheckpointBlockHeader) (submitData types.CheckpointSubmitData, err error) {
+	// search side tx vote which approved checkpoint, checkpoint event is only emitted on approval
+	tags := []string{
+		fmt.Sprintf("checkpoint.bor-chain-id='%v'", checkpoint.BorChainID),
+		fmt.Sprintf("checkpoint.start-block='%v'", checkpoint.StartBlock),
+		fmt.Sprintf("checkpoint.end-block='%v'", checkpoint.EndBlock),
+		fmt.Sprintf("%v.%v='%v'", sidetxTypes.EventTypeSideTxApproved, sidetxTypes.AttributeKeyMsgRoute, types.RouterKey),
+	}
+
+	searchResult, err := helper.QueryTxsByEvents(cliCtx, tags, 1, 1) // first page, 1 limit
+	if err != nil {
+		return submitData, err
+	}
+
+	if searchResult.Count == 0 {
+		return submitData, errors.New("No approved checkpoint tx found")
+	}
+
+	// checkpoint tx approved by vote
+	txHash, ok := sidetxTypes.GetApprovedTxHash(searchResult.Txs[0].Events, types.RouterKey)
+	if !ok {
+		return submitData, errors.New("No approved checkpoint tx found")
+	}
+
+	// tx with proof
+	tx, err := helper.QueryTxWithProof(cliCtx, txHash.Bytes())
+	if err != nil {
+		return submitData, err
+	}
+
+	// votes of tx block are committed in next block
+	block, err := helper.GetBlock(cliCtx, tx.Height+1)
+	if err != nil {
+		return submitData, err
+	}
+
+	votes := block.Block.LastCommit.Precommits
+	if len(votes) == 0 {
+		return submitData, errors.New("No votes found for checkpoint tx")
+	}
+
+	return types.CheckpointSubmitData{
+		BorChainID: checkpoint.BorChainID,
+		StartBlock: checkpoint.StartBlock,
+		EndBlock:   checkpoint.EndBlock,
+		TxHash:     tx.Hash.String(),
+		Height:     tx.Height,
+		Vote:       helper.GetVoteBytes(votes, block.Block.ChainID),
+		Sigs:       helper.GetSigs(votes),
+		TxData:     hmTypes.HexBytes(tx.Tx[authTypes.PulpHashLength:]),
+	}, nil
+}
+
 // HeaderBlockResult represents header block result
 type HeaderBlockResult struct {
+	BorChainID string                  `json:"borChainId"`
 	Proposer   hmTypes.HeimdallAddress `json:"proposer"`
 	RootHash   common.Hash             `json:"rootHash"`
 	StartBlock uint64                  `json:"startBlock"`
@@ -178,8 +449,14 @@ func checkpointHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
+		// get bor chain, defaults to configured bor chain
+		borChainID := getBorChainID(r)
+		if borChainID == "" {
+			borChainID = helper.GetConfig().BorChainID
+		}
+
 		// get headers
-		roothash, err := types.GetHeaders(uint64(start), uint64(end))
+		roothash, err := types.GetHeaders(borChainID, uint64(start), uint64(end))
 		if err != nil {
 			RestLogger.Error("Unable to get header", "Start", start, "End", end, "Error", err)
 			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -203,6 +480,7 @@ func checkpointHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 
 		// header block -- checkpoint
 		checkpoint := HeaderBlockResult{
+			BorChainID: borChainID,
 			Proposer:   validatorSet.Proposer.Signer,
 			StartBlock: uint64(start),
 			EndBlock:   uint64(end),
@@ -369,11 +647,20 @@ func latestCheckpointHandlerFunc(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
+		borChainID := getBorChainID(r)
+
+		// get bor chain query params
+		borChainParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryBorChainParams(borChainID))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
 		//
 		// Get ack count
 		//
 
-		ackcountBytes, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAckCount), nil)
+		ackcountBytes, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAckCount), borChainParams)
 		if err != nil {
 			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -395,14 +682,21 @@ func latestCheckpointHandlerFunc(cliCtx context.CLIContext) http.HandlerFunc {
 		//
 
 		RestLogger.Debug("ACK Count fetched", "ackCount", ackCount)
-		lastCheckpointKey := helper.GetConfig().ChildBlockInterval * ackCount
+
+		params, err := fetchParams(cliCtx)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		lastCheckpointKey := params.ChildBlockInterval * ackCount
 		RestLogger.Debug("Last checkpoint key generated",
 			"lastCheckpointKey", lastCheckpointKey,
-			"min", helper.GetConfig().ChildBlockInterval,
+			"min", params.ChildBlockInterval,
 		)
 
 		// get query params
-		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(lastCheckpointKey))
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(borChainID, lastCheckpointKey))
 		if err != nil {
 			return
 		}
@@ -443,14 +737,21 @@ func checkpointByNumberHandlerFunc(cliCtx context.CLIContext) http.HandlerFunc {
 		}
 
 		RestLogger.Debug("Get Checkpoint for ", "checkpointNumber", checkpointNumber)
-		checkpointKey := helper.GetConfig().ChildBlockInterval * checkpointNumber
+
+		params, err := fetchParams(cliCtx)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		checkpointKey := params.ChildBlockInterval * checkpointNumber
 		RestLogger.Debug("checkpoint key generated",
 			"checkpointKey", checkpointKey,
-			"min", helper.GetConfig().ChildBlockInterval,
+			"min", params.ChildBlockInterval,
 		)
 
 		// get query params
-		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(checkpointKey))
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(getBorChainID(r), checkpointKey))
 		if err != nil {
 			return
 		}
@@ -495,7 +796,7 @@ func checkpointListhandlerFn(
 		}
 
 		// get query params
-		queryParams, err := cliCtx.Codec.MarshalJSON(hmTypes.NewQueryPaginationParams(page, limit))
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointListParams(getBorChainID(r), page, limit))
 		if err != nil {
 			return
 		}
@@ -515,3 +816,14 @@ func checkpointListhandlerFn(
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
+
+// fetchParams fetches checkpoint params from store
+func fetchParams(cliCtx context.CLIContext) (params types.Params, err error) {
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParams), nil)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(res, &params)
+	return
+}
@@ -33,6 +33,7 @@ type (
 		AccountRootHash hmTypes.HeimdallHash    `json:"accountRootHash"`
 		StartBlock      uint64                  `json:"startBlock"`
 		EndBlock        uint64                  `json:"endBlock"`
+		BorChainID      string                  `json:"borChainId"`
 	}
 
 	// HeaderACKReq struct for sending ACK for a new headers
@@ -40,10 +41,16 @@ type (
 	HeaderACKReq struct {
 		BaseReq rest.BaseReq `json:"base_req"`
 
-		Proposer    hmTypes.HeimdallAddress `json:"proposer"`
-		HeaderBlock uint64                  `json:"headerBlock"`
-		TxHash      hmTypes.HeimdallHash    `json:"tx_hash"`
-		LogIndex    uint64                  `json:"log_index"`
+		Proposer       hmTypes.HeimdallAddress `json:"proposer"`
+		HeaderBlock    uint64                  `json:"headerBlock"`
+		HeaderProposer hmTypes.HeimdallAddress `json:"header_proposer"`
+		StartBlock     uint64                  `json:"startBlock"`
+		EndBlock       uint64                  `json:"endBlock"`
+		RootHash       hmTypes.HeimdallHash    `json:"rootHash"`
+		BorChainID     string                  `json:"borChainId"`
+		TxHash         hmTypes.HeimdallHash    `json:"tx_hash"`
+		LogIndex       uint64                  `json:"log_index"`
+		BlockNumber    uint64                  `json:"block_number"`
 	}
 
 	// HeaderNoACKReq struct for sending no-ack for a new headers
@@ -73,6 +80,7 @@ func newCheckpointHandler(cliCtx context.CLIContext) http.HandlerFunc {
 			req.EndBlock,
 			req.RootHash,
 			req.AccountRootHash,
+			req.BorChainID,
 			uint64(time.Now().UTC().Unix()),
 		)
 
@@ -94,7 +102,18 @@ func newCheckpointACKHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		}
 
 		// draft a message and send response
-		msg := types.NewMsgCheckpointAck(req.Proposer, req.HeaderBlock, req.TxHash, req.LogIndex)
+		msg := types.NewMsgCheckpointAck(
+			req.Proposer,
+			req.HeaderBlock,
+			req.HeaderProposer,
+			req.StartBlock,
+			req.EndBlock,
+			req.RootHash,
+			req.BorChainID,
+			req.TxHash,
+			req.LogIndex,
+			req.BlockNumber,
+		)
 
 		// send response
 		restClient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
@@ -6,49 +6,74 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/maticnetwork/heimdall/checkpoint/types"
-	"github.com/maticnetwork/heimdall/helper"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
 // InitGenesis sets distribution information for genesis.
 func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
+	keeper.SetParams(ctx, data.Params)
+
+	// Set registered bor chains
+	keeper.SetBorChainIDs(ctx, data.BorChainIDs)
+
 	// Set last no-ack
 	if data.LastNoACK > 0 {
 		keeper.SetLastNoAck(ctx, data.LastNoACK)
 	}
 
-	// Add finalised checkpoints to state
-	if len(data.Headers) != 0 {
-		// check if we are provided all the headers
-		if int(data.AckCount) != len(data.Headers) {
-			panic(errors.New("Incorrect state in state-dump , Please Check "))
-		}
-		// sort headers before loading to state
-		data.Headers = hmTypes.SortHeaders(data.Headers)
+	for _, borChainID := range data.BorChainIDs {
+		ackCount := data.GetAckCount(borChainID)
 
-		// load checkpoints to state
-		for i, header := range data.Headers {
-			checkpointHeaderIndex := helper.GetConfig().ChildBlockInterval * (uint64(i) + 1)
-			keeper.AddCheckpoint(ctx, checkpointHeaderIndex, header)
+		// Add finalised checkpoints to state
+		if headers := data.GetHeaders(borChainID); len(headers) != 0 {
+			// check if we are provided all the headers
+			if int(ackCount) != len(headers) {
+				panic(errors.New("Incorrect state in state-dump , Please Check "))
+			}
+			// sort headers before loading to state
+			headers = hmTypes.SortHeaders(headers)
+
+			// load checkpoints to state
+			for i, header := range headers {
+				checkpointHeaderIndex := data.Params.ChildBlockInterval * (uint64(i) + 1)
+				keeper.AddCheckpoint(ctx, checkpointHeaderIndex, header)
+			}
 		}
+
+		// Set initial ack count
+		keeper.UpdateACKCountWithValue(ctx, borChainID, ackCount)
 	}
 
-	// Add checkpoint in buffer
-	if data.BufferedCheckpoint != nil {
-		keeper.SetCheckpointBuffer(ctx, *data.BufferedCheckpoint)
+	// Add checkpoints in buffer
+	for _, checkpoint := range data.BufferedCheckpoints {
+		keeper.SetCheckpointBuffer(ctx, checkpoint)
 	}
 
-	// Set initial ack count
-	keeper.UpdateACKCountWithValue(ctx, data.AckCount)
+	// Set proposer stats
+	for _, stats := range data.ProposerStats {
+		keeper.SetProposerStats(ctx, stats)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
-	bufferedCheckpoint, _ := keeper.GetCheckpointFromBuffer(ctx)
+	borChainIDs := keeper.GetBorChainIDs(ctx)
+
+	ackCounts := make([]types.ChainAckCount, 0, len(borChainIDs))
+	for _, borChainID := range borChainIDs {
+		ackCounts = append(ackCounts, types.ChainAckCount{
+			BorChainID: borChainID,
+			AckCount:   keeper.GetChainACKCount(ctx, borChainID),
+		})
+	}
+
 	return types.NewGenesisState(
-		bufferedCheckpoint,
+		keeper.GetParams(ctx),
+		borChainIDs,
+		keeper.GetCheckpointBuffers(ctx),
 		keeper.GetLastNoAck(ctx),
-		keeper.GetACKCount(ctx),
+		ackCounts,
 		hmTypes.SortHeaders(keeper.GetCheckpointHeaders(ctx)),
+		keeper.GetAllProposerStats(ctx),
 	)
 }
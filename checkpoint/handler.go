@@ -20,9 +20,9 @@ func NewHandler(k Keeper, contractCaller helper.IContractCaller) sdk.Handler {
 
 		switch msg := msg.(type) {
 		case types.MsgCheckpoint:
-			return handleMsgCheckpoint(ctx, msg, k, contractCaller)
+			return handleMsgCheckpoint(ctx, msg, k)
 		case types.MsgCheckpointAck:
-			return handleMsgCheckpointAck(ctx, msg, k, contractCaller)
+			return handleMsgCheckpointAck(ctx, msg, k)
 		case types.MsgCheckpointNoAck:
 			return handleMsgCheckpointNoAck(ctx, msg, k)
 		default:
@@ -31,69 +31,75 @@ func NewHandler(k Keeper, contractCaller helper.IContractCaller) sdk.Handler {
 	}
 }
 
-// handleMsgCheckpoint Validates checkpoint transaction
-func handleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper, contractCaller helper.IContractCaller) sdk.Result {
+// handleMsgCheckpoint validates checkpoint against current state, root hash is verified by validators as side tx.
+// Checkpoint event is emitted by post handler once validators approved checkpoint, so that
+// bridge doesn't dispatch rejected checkpoints to root chain.
+func handleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper) sdk.Result {
 	k.Logger(ctx).Debug("Validating checkpoint data", "TxData", msg)
 
-	if msg.TimeStamp == 0 || msg.TimeStamp > uint64(time.Now().UTC().Unix()) {
-		k.Logger(ctx).Error("Checkpoint timestamp must be in near past", "CurrentTime", time.Now().UTC().Unix(), "CheckpointTime", msg.TimeStamp, "Condition", msg.TimeStamp >= uint64(time.Now().UTC().Unix()))
-		return common.ErrBadTimeStamp(k.Codespace()).Result()
+	if err := validateCheckpoint(ctx, msg, k); err != nil {
+		return err.Result()
 	}
 
-	checkpointBuffer, err := k.GetCheckpointFromBuffer(ctx)
-	if err == nil {
-		if msg.TimeStamp == 0 || checkpointBuffer.TimeStamp == 0 || ((msg.TimeStamp > checkpointBuffer.TimeStamp) && msg.TimeStamp-checkpointBuffer.TimeStamp >= uint64(helper.GetConfig().CheckpointBufferTime.Seconds())) {
-			k.Logger(ctx).Debug("Checkpoint has been timed out, flushing buffer", "CheckpointTimestamp", msg.TimeStamp, "PrevCheckpointTimestamp", checkpointBuffer.TimeStamp)
-			k.FlushCheckpointBuffer(ctx)
-		} else {
-			// calulates remaining time for buffer to be flushed
-			checkpointTime := time.Unix(int64(checkpointBuffer.TimeStamp), 0)
-			expiryTime := checkpointTime.Add(helper.GetConfig().CheckpointBufferTime)
-			diff := expiryTime.Sub(time.Now().UTC()).Seconds()
-			k.Logger(ctx).Error("Checkpoint already exits in buffer", "Checkpoint", checkpointBuffer.String(), "Expires", expiryTime)
-			return common.ErrNoACK(k.Codespace(), diff).Result()
-		}
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
 	}
-	// k.Logger(ctx).Debug("Received checkpoint from buffer", "Checkpoint", checkpointBuffer.String())
+}
 
-	// validate checkpoint
-	validCheckpoint, err := types.ValidateCheckpoint(msg.StartBlock, msg.EndBlock, msg.RootHash)
-	if err != nil {
-		k.Logger(ctx).Error("Error validating checkpoint",
-			"Error", err,
-			"StartBlock", msg.StartBlock,
-			"EndBlock", msg.EndBlock)
-		return common.ErrBadBlockDetails(k.Codespace()).Result()
+// validateCheckpoint checks checkpoint against current state
+func validateCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper) sdk.Error {
+	// block time is agreed upon by all validators, unlike local wall clocks
+	blockTime := ctx.BlockTime().UTC()
+	if msg.TimeStamp == 0 || msg.TimeStamp > uint64(blockTime.Unix()) {
+		k.Logger(ctx).Error("Checkpoint timestamp must be in near past", "BlockTime", blockTime.Unix(), "CheckpointTime", msg.TimeStamp, "Condition", msg.TimeStamp >= uint64(blockTime.Unix()))
+		return common.ErrBadTimeStamp(k.Codespace())
 	}
 
-	if !validCheckpoint {
-		k.Logger(ctx).Error("RootHash is not valid",
+	// checkpoint must be for registered bor chain
+	if !k.HasBorChainID(ctx, msg.BorChainID) {
+		k.Logger(ctx).Error("Checkpoint for unknown bor chain", "borChainID", msg.BorChainID)
+		return common.ErrUnknownBorChain(k.Codespace(), msg.BorChainID)
+	}
+
+	params := k.GetParams(ctx)
+
+	// checkpoint must not exceed max checkpoint length
+	if msg.EndBlock-msg.StartBlock+1 > params.MaxCheckpointLength {
+		k.Logger(ctx).Error("Checkpoint exceeds max checkpoint length",
 			"StartBlock", msg.StartBlock,
 			"EndBlock", msg.EndBlock,
-			"RootHash", msg.RootHash)
-		return common.ErrBadBlockDetails(k.Codespace()).Result()
+			"MaxCheckpointLength", params.MaxCheckpointLength)
+		return common.ErrBadBlockDetails(k.Codespace())
 	}
 
-	k.Logger(ctx).Debug("Valid Roothash in checkpoint", "StartBlock", msg.StartBlock, "EndBlock", msg.EndBlock)
+	// checkpoint in buffer must have expired
+	if checkpointBuffer, err := k.GetCheckpointFromBuffer(ctx, msg.BorChainID); err == nil {
+		if expired, expiryTime := isCheckpointBufferExpired(ctx, k, checkpointBuffer); !expired {
+			// calulates remaining time for buffer to be flushed
+			diff := expiryTime.Sub(blockTime).Seconds()
+			k.Logger(ctx).Error("Checkpoint already exits in buffer", "Checkpoint", checkpointBuffer.String(), "Expires", expiryTime)
+			return common.ErrNoACK(k.Codespace(), diff)
+		}
+	}
 
 	// fetch last checkpoint from store
-	if lastCheckpoint, err := k.GetLastCheckpoint(ctx); err == nil {
+	if lastCheckpoint, err := k.GetLastCheckpoint(ctx, msg.BorChainID); err == nil {
 		// make sure new checkpoint is after tip
 		if lastCheckpoint.EndBlock > msg.StartBlock {
 			k.Logger(ctx).Error("Checkpoint already exists",
 				"currentTip", lastCheckpoint.EndBlock,
 				"startBlock", msg.StartBlock)
-			return common.ErrOldCheckpoint(k.Codespace()).Result()
+			return common.ErrOldCheckpoint(k.Codespace())
 		}
 		if lastCheckpoint.EndBlock+1 != msg.StartBlock {
 			k.Logger(ctx).Error("Checkpoint not in countinuity",
 				"currentTip", lastCheckpoint.EndBlock,
 				"startBlock", msg.StartBlock)
-			return common.ErrDisCountinuousCheckpoint(k.Codespace()).Result()
+			return common.ErrDisCountinuousCheckpoint(k.Codespace())
 		}
 	} else if err.Error() == common.ErrNoCheckpointFound(k.Codespace()).Error() && msg.StartBlock != 0 {
 		k.Logger(ctx).Error("First checkpoint to start from block 1", "Error", err)
-		return common.ErrBadBlockDetails(k.Codespace()).Result()
+		return common.ErrBadBlockDetails(k.Codespace())
 	}
 	k.Logger(ctx).Debug("Valid checkpoint tip")
 
@@ -102,12 +108,16 @@ func handleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper, con
 	dividendAccounts := k.sk.GetAllDividendAccounts(ctx)
 	k.Logger(ctx).Debug("DividendAccounts of all validators", "dividendAccounts", dividendAccounts)
 	accountRoot, err := types.GetAccountRootHash(dividendAccounts)
+	if err != nil {
+		k.Logger(ctx).Error("Unable to generate account root hash", "error", err)
+		return common.ErrBadBlockDetails(k.Codespace())
+	}
 	k.Logger(ctx).Info("Validator Account root hash generated", "AccountRootHash", hmTypes.BytesToHeimdallHash(accountRoot).String())
 
 	if !bytes.Equal(accountRoot, msg.AccountRootHash.Bytes()) {
 		k.Logger(ctx).Error("AccountRootHash of current state", hmTypes.BytesToHeimdallHash(accountRoot).String(),
 			"doesn't match with AccountRootHash of msg", msg.AccountRootHash)
-		return common.ErrBadBlockDetails(k.Codespace()).Result()
+		return common.ErrBadBlockDetails(k.Codespace())
 	}
 
 	k.Logger(ctx).Debug("AccountRootHash matches")
@@ -117,149 +127,108 @@ func handleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper, con
 		k.Logger(ctx).Error("Invalid proposer in message",
 			"currentProposer", k.sk.GetValidatorSet(ctx).Proposer.Signer.String(),
 			"checkpointProposer", msg.Proposer.String())
-		return common.ErrBadProposerDetails(k.Codespace(), k.sk.GetValidatorSet(ctx).Proposer.Signer).Result()
+		return common.ErrBadProposerDetails(k.Codespace(), k.sk.GetValidatorSet(ctx).Proposer.Signer)
 	}
 	k.Logger(ctx).Debug("Valid proposer in checkpoint")
 
-	// add checkpoint to buffer
-	// Add AccountRootHash to CheckpointBuffer
-	k.SetCheckpointBuffer(ctx, hmTypes.CheckpointBlockHeader{
-		StartBlock:      msg.StartBlock,
-		EndBlock:        msg.EndBlock,
-		RootHash:        msg.RootHash,
-		AccountRootHash: msg.AccountRootHash,
-		Proposer:        msg.Proposer,
-		TimeStamp:       msg.TimeStamp,
-	})
+	return nil
+}
 
-	checkpoint, _ := k.GetCheckpointFromBuffer(ctx)
-	k.Logger(ctx).Debug("Adding good checkpoint to buffer to await ACK", "checkpointStored", checkpoint.String())
+// isCheckpointBufferExpired checks if buffered checkpoint has outlived checkpoint buffer time
+func isCheckpointBufferExpired(ctx sdk.Context, k Keeper, checkpointBuffer *hmTypes.CheckpointBlockHeader) (bool, time.Time) {
+	// buffer expires once checkpoint buffer time has passed since buffered checkpoint
+	bufferTime := k.GetParams(ctx).CheckpointBufferTime
+	checkpointTime := time.Unix(int64(checkpointBuffer.TimeStamp), 0)
+	expiryTime := checkpointTime.Add(bufferTime)
+	return checkpointBuffer.TimeStamp == 0 || !ctx.BlockTime().UTC().Before(expiryTime), expiryTime
+}
 
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeCheckpoint,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-			sdk.NewAttribute(types.AttributeKeyProposer, msg.Proposer.String()),
-			sdk.NewAttribute(types.AttributeKeyStartBlock, strconv.FormatUint(uint64(msg.StartBlock), 10)),
-			sdk.NewAttribute(types.AttributeKeyEndBlock, strconv.FormatUint(uint64(msg.EndBlock), 10)),
-		),
-	})
+// handleMsgCheckpointAck validates checkpoint ack against current state, header block is verified by validators as side tx
+func handleMsgCheckpointAck(ctx sdk.Context, msg types.MsgCheckpointAck, k Keeper) sdk.Result {
+	k.Logger(ctx).Debug("Validating Checkpoint ACK", "Tx", msg)
+
+	if _, err := validateCheckpointAck(ctx, msg, k); err != nil {
+		return err.Result()
+	}
 
 	return sdk.Result{
 		Events: ctx.EventManager().Events(),
 	}
 }
 
-// handleMsgCheckpointAck Validates if checkpoint submitted on chain is valid
-func handleMsgCheckpointAck(ctx sdk.Context, msg types.MsgCheckpointAck, k Keeper, contractCaller helper.IContractCaller) sdk.Result {
-	k.Logger(ctx).Debug("Validating Checkpoint ACK", "Tx", msg)
-
-	// make call to headerBlock with header number
-	root, start, end, createdAt, proposer, err := contractCaller.GetHeaderInfo(msg.HeaderBlock)
-	if err != nil {
-		k.Logger(ctx).Error("Unable to fetch header from rootchain contract", "Error", err, "headerBlockIndex", msg.HeaderBlock)
-		return common.ErrBadAck(k.Codespace()).Result()
+// validateCheckpointAck checks checkpoint ack against checkpoint in buffer and returns buffered checkpoint
+func validateCheckpointAck(ctx sdk.Context, msg types.MsgCheckpointAck, k Keeper) (*hmTypes.CheckpointBlockHeader, sdk.Error) {
+	// ack must be for registered bor chain
+	if !k.HasBorChainID(ctx, msg.BorChainID) {
+		k.Logger(ctx).Error("Checkpoint ack for unknown bor chain", "borChainID", msg.BorChainID)
+		return nil, common.ErrUnknownBorChain(k.Codespace(), msg.BorChainID)
 	}
 
-	// check confirmation
-	latestBlock, err := contractCaller.GetMainChainBlock(nil)
-	if err != nil {
-		k.Logger(ctx).Error("Unable to connect to mainchain", "Error", err)
-		return common.ErrNoConn(k.Codespace()).Result()
+	// check if root chain event is already processed
+	if k.HasCheckpointSequence(ctx, getCheckpointSequence(msg.BlockNumber, msg.LogIndex)) {
+		k.Logger(ctx).Error("Older invalid tx found", "txHash", msg.TxHash.String(), "logIndex", msg.LogIndex)
+		return nil, common.ErrOldTx(k.Codespace())
 	}
 
-	if latestBlock.Number.Uint64()-createdAt < helper.GetConfig().ConfirmationBlocks {
-		k.Logger(ctx).Error("Not enough confirmations", "latestBlock", latestBlock.Number.Uint64(), "txBlock", createdAt)
-		return common.ErrWaitForConfirmation(k.Codespace()).Result()
-	}
+	params := k.GetParams(ctx)
 
-	k.Logger(ctx).Debug("HeaderBlock fetched",
-		"headerBlock", msg.HeaderBlock,
-		"start", start,
-		"end", end,
-		"roothash", root,
-		"proposer", proposer,
-		"createdAt", createdAt,
-		"latest", latestBlock.Number.Uint64(),
-	)
+	// header block must be next one after last acked checkpoint, lookups read headers at ack count * child block interval
+	expectedHeaderBlock := (k.GetChainACKCount(ctx, msg.BorChainID) + 1) * params.ChildBlockInterval
+	if msg.HeaderBlock != expectedHeaderBlock {
+		k.Logger(ctx).Error("Invalid header block", "headerBlockIndex", msg.HeaderBlock, "expectedHeaderBlockIndex", expectedHeaderBlock)
+		return nil, common.ErrBadAck(k.Codespace())
+	}
 
 	// get last checkpoint from buffer
-	headerBlock, err := k.GetCheckpointFromBuffer(ctx)
+	headerBlock, err := k.GetCheckpointFromBuffer(ctx, msg.BorChainID)
 	if err != nil {
 		k.Logger(ctx).Error("Unable to get checkpoint", "error", err)
-		return common.ErrBadAck(k.Codespace()).Result()
+		return nil, common.ErrBadAck(k.Codespace())
 	}
-	if start != headerBlock.StartBlock {
-		k.Logger(ctx).Error("Invalid start block", "startExpected", headerBlock.StartBlock, "startReceived", start)
-		return common.ErrBadAck(k.Codespace()).Result()
-	} else if start == headerBlock.StartBlock && end == headerBlock.EndBlock && !bytes.Equal(root.Bytes(), headerBlock.RootHash.Bytes()) {
+
+	if msg.StartBlock != headerBlock.StartBlock {
+		k.Logger(ctx).Error("Invalid start block", "startExpected", headerBlock.StartBlock, "startReceived", msg.StartBlock)
+		return nil, common.ErrBadAck(k.Codespace())
+	} else if msg.EndBlock == headerBlock.EndBlock && !bytes.Equal(msg.RootHash.Bytes(), headerBlock.RootHash.Bytes()) {
 		k.Logger(ctx).Error("Invalid ACK",
 			"startExpected", headerBlock.StartBlock,
-			"startReceived", start,
+			"startReceived", msg.StartBlock,
 			"endExpected", headerBlock.EndBlock,
-			"endReceived", end,
+			"endReceived", msg.EndBlock,
 			"rootExpected", headerBlock.RootHash.String(),
-			"rootRecieved", root.String())
-		return common.ErrBadAck(k.Codespace()).Result()
-	}
-	if headerBlock.EndBlock > end {
-		k.Logger(ctx).Info("Adjusting endBlock to one already submitted on chain", "OldEndBlock", headerBlock.EndBlock, "AdjustedEndBlock", end)
-		headerBlock.EndBlock = end
-		headerBlock.RootHash = hmTypes.HeimdallHash(root)
-		// TODO proposer also needs to be changed
+			"rootRecieved", msg.RootHash.String())
+		return nil, common.ErrBadAck(k.Codespace())
 	}
 
-	// Add checkpoint to headerBlocks
-	k.AddCheckpoint(ctx, msg.HeaderBlock, *headerBlock)
-	k.Logger(ctx).Info("Checkpoint added to store", "headerBlock", headerBlock.String())
-
-	// flush buffer
-	k.FlushCheckpointBuffer(ctx)
-	k.Logger(ctx).Debug("Checkpoint buffer flushed after receiving checkpoint ack", "checkpoint", headerBlock)
-
-	// update ack count
-	k.UpdateACKCount(ctx)
-	k.Logger(ctx).Debug("Valid ack received", "CurrentACKCount", k.GetACKCount(ctx)-1, "UpdatedACKCount", k.GetACKCount(ctx))
-
-	// --- Update to new proposer
-
-	// increment accum
-	k.sk.IncrementAccum(ctx, 1)
-
-	//log new proposer
-	vs := k.sk.GetValidatorSet(ctx)
-	newProposer := vs.GetProposer()
-	k.Logger(ctx).Debug(
-		"New proposer selected",
-		"validator", newProposer.Signer.String(),
-		"signer", newProposer.Signer.String(),
-		"power", newProposer.VotingPower,
-	)
-
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeCheckpointAck,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-			sdk.NewAttribute(types.AttributeKeyHeaderIndex, strconv.FormatUint(uint64(msg.HeaderBlock), 10)),
-		),
-	})
+	return headerBlock, nil
+}
 
-	return sdk.Result{
-		Events: ctx.EventManager().Events(),
-	}
+// getCheckpointSequence returns sequence id for root chain header block log
+func getCheckpointSequence(blockNumber uint64, logIndex uint64) uint64 {
+	return (blockNumber * hmTypes.DefaultLogIndexUnit) + logIndex
 }
 
 // Validate checkpoint no-ack transaction
 func handleMsgCheckpointNoAck(ctx sdk.Context, msg types.MsgCheckpointNoAck, k Keeper) sdk.Result {
 	k.Logger(ctx).Debug("Validating checkpoint no-ack", "TxData", msg)
 	// current time
-	currentTime := time.Unix(int64(msg.TimeStamp), 0) // buffer time
-	bufferTime := helper.GetConfig().CheckpointBufferTime
+	currentTime := ctx.BlockTime().UTC()
+	if msg.TimeStamp > uint64(currentTime.Unix()) {
+		k.Logger(ctx).Error("No-ack timestamp must be in near past", "BlockTime", currentTime.Unix(), "NoAckTime", msg.TimeStamp)
+		return common.ErrBadTimeStamp(k.Codespace()).Result()
+	}
 
-	// fetch last checkpoint from store
-	// TODO figure out how to handle this error
-	lastCheckpoint, _ := k.GetLastCheckpoint(ctx)
-	lastCheckpointTime := time.Unix(int64(lastCheckpoint.TimeStamp), 0)
+	// buffer time
+	bufferTime := k.GetParams(ctx).CheckpointBufferTime
+
+	// fetch latest checkpoint across bor chains from store
+	var lastCheckpointTimeStamp uint64
+	for _, borChainID := range k.GetBorChainIDs(ctx) {
+		if lastCheckpoint, err := k.GetLastCheckpoint(ctx, borChainID); err == nil && lastCheckpoint.TimeStamp > lastCheckpointTimeStamp {
+			lastCheckpointTimeStamp = lastCheckpoint.TimeStamp
+		}
+	}
+	lastCheckpointTime := time.Unix(int64(lastCheckpointTimeStamp), 0)
 
 	// if last checkpoint is not present or last checkpoint happens before checkpoint buffer time -- thrown an error
 	if lastCheckpointTime.After(currentTime) || (currentTime.Sub(lastCheckpointTime) < bufferTime) {
@@ -308,3 +277,57 @@ func handleMsgCheckpointNoAck(ctx sdk.Context, msg types.MsgCheckpointNoAck, k K
 		Events: ctx.EventManager().Events(),
 	}
 }
+
+// EndBlocker flushes checkpoint buffers once buffered checkpoint has outlived checkpoint buffer time
+// and rotates proposer, so that checkpointing doesn't depend on bridge no-ack service
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	var expiredBuffers []hmTypes.CheckpointBlockHeader
+	for _, checkpointBuffer := range k.GetCheckpointBuffers(ctx) {
+		if expired, _ := isCheckpointBufferExpired(ctx, k, &checkpointBuffer); expired {
+			expiredBuffers = append(expiredBuffers, checkpointBuffer)
+		}
+	}
+
+	if len(expiredBuffers) > 0 {
+		flushExpiredCheckpoints(ctx, k, expiredBuffers)
+	}
+}
+
+// flushExpiredCheckpoints flushes expired checkpoints of bor chains and rotates proposer once
+func flushExpiredCheckpoints(ctx sdk.Context, k Keeper, checkpointBuffers []hmTypes.CheckpointBlockHeader) {
+	for _, checkpointBuffer := range checkpointBuffers {
+		k.Logger(ctx).Info("Checkpoint in buffer expired, flushing buffer", "checkpoint", checkpointBuffer.String())
+
+		// flush stale checkpoint
+		k.FlushCheckpointBuffer(ctx, checkpointBuffer.BorChainID)
+	}
+
+	// --- Update to new proposer, once however many bor chains expired in block
+
+	// increment accum
+	k.sk.IncrementAccum(ctx, 1)
+
+	// log new proposer
+	vs := k.sk.GetValidatorSet(ctx)
+	newProposer := vs.GetProposer()
+	k.Logger(ctx).Debug(
+		"New proposer selected",
+		"validator", newProposer.Signer.String(),
+		"power", newProposer.VotingPower,
+	)
+
+	// add events
+	for _, checkpointBuffer := range checkpointBuffers {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCheckpointTimeout,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyProposer, checkpointBuffer.Proposer.String()),
+				sdk.NewAttribute(types.AttributeKeyBorChainID, checkpointBuffer.BorChainID),
+				sdk.NewAttribute(types.AttributeKeyStartBlock, strconv.FormatUint(checkpointBuffer.StartBlock, 10)),
+				sdk.NewAttribute(types.AttributeKeyEndBlock, strconv.FormatUint(checkpointBuffer.EndBlock, 10)),
+				sdk.NewAttribute(types.AttributeKeyNewProposer, newProposer.Signer.String()),
+			),
+		)
+	}
+}
@@ -256,7 +256,7 @@ func TestHandleMsgCheckpoint(t *testing.T) {
 			// make sure proposer has min ether
 			contractCallerObj.On("GetBalance", header.Proposer).Return(helper.MinBalance, nil)
 			// create checkpoint 257 seconds prev to current time
-			header.TimeStamp = uint64(time.Now().Add(-(helper.CheckpointBufferTime + time.Second)).Unix())
+			header.TimeStamp = uint64(time.Now().Add(-(checkpointTypes.DefaultCheckpointBufferTime + time.Second)).Unix())
 			t.Log("Sending checkpoint with timestamp", "Timestamp", header.TimeStamp, "Current", time.Now().UTC().Unix())
 			// send old checkpoint
 			SentValidCheckpoint(header, ck, sk, ctx, contractCallerObj, t)
@@ -0,0 +1,44 @@
+package checkpoint
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+)
+
+// RegisterInvariants registers all checkpoint invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "ack-count", AckCountInvariant(k))
+}
+
+// AllInvariants runs all invariants of the checkpoint module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return AckCountInvariant(k)(ctx)
+	}
+}
+
+// AckCountInvariant checks that ack count of every bor chain matches number of its stored headers
+func AckCountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		store := ctx.KVStore(k.storeKey)
+
+		for _, borChainID := range k.GetBorChainIDs(ctx) {
+			var headers uint64
+			iterator := sdk.KVStorePrefixIterator(store, GetHeaderPrefixKey(borChainID))
+			for ; iterator.Valid(); iterator.Next() {
+				headers++
+			}
+			iterator.Close()
+
+			if ackCount := k.GetChainACKCount(ctx, borChainID); ackCount != headers {
+				msg += fmt.Sprintf("\tbor chain %v has ack count %v but %v stored headers\n", borChainID, ackCount, headers)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "ack count", msg), msg != ""
+	}
+}
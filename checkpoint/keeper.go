@@ -11,7 +11,6 @@ import (
 
 	"github.com/maticnetwork/heimdall/checkpoint/types"
 	cmn "github.com/maticnetwork/heimdall/common"
-	"github.com/maticnetwork/heimdall/helper"
 	"github.com/maticnetwork/heimdall/staking"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 )
@@ -19,10 +18,13 @@ import (
 var (
 	DefaultValue = []byte{0x01} // Value to store in CacheCheckpoint and CacheCheckpointACK & ValidatorSetChange Flag
 
-	ACKCountKey         = []byte{0x11} // key to store ACK count
-	BufferCheckpointKey = []byte{0x12} // Key to store checkpoint in buffer
-	HeaderBlockKey      = []byte{0x13} // prefix key for when storing header after ACK
-	LastNoACKKey        = []byte{0x14} // key to store last no-ack
+	ACKCountKey           = []byte{0x11} // prefix key to store ACK count of bor chain
+	BufferCheckpointKey   = []byte{0x12} // prefix key to store checkpoint of bor chain in buffer
+	HeaderBlockKey        = []byte{0x13} // prefix key for when storing header after ACK
+	LastNoACKKey          = []byte{0x14} // key to store last no-ack
+	ProposerStatsKey      = []byte{0x15} // prefix key for storing proposer stats
+	BorChainIDsKey        = []byte{0x16} // key to store registered bor chain ids
+	CheckpointSequenceKey = []byte{0x17} // prefix key for processed root chain header block events
 )
 
 // Keeper stores all related data
@@ -49,7 +51,7 @@ func NewKeeper(
 	keeper := Keeper{
 		cdc:        cdc,
 		storeKey:   storeKey,
-		paramSpace: paramSpace,
+		paramSpace: paramSpace.WithKeyTable(types.ParamKeyTable()),
 		codespace:  codespace,
 		sk:         stakingKeeper,
 	}
@@ -66,9 +68,9 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", types.ModuleName)
 }
 
-// AddCheckpoint adds checkpoint into final blocks
+// AddCheckpoint adds checkpoint into final blocks of checkpoint's bor chain
 func (k *Keeper) AddCheckpoint(ctx sdk.Context, headerBlockNumber uint64, headerBlock hmTypes.CheckpointBlockHeader) error {
-	key := GetHeaderKey(headerBlockNumber)
+	key := GetHeaderKey(headerBlock.BorChainID, headerBlockNumber)
 	err := k.addCheckpoint(ctx, key, headerBlock)
 	if err != nil {
 		return err
@@ -77,9 +79,9 @@ func (k *Keeper) AddCheckpoint(ctx sdk.Context, headerBlockNumber uint64, header
 	return nil
 }
 
-// SetCheckpointBuffer flushes Checkpoint Buffer
+// SetCheckpointBuffer sets checkpoint in buffer of checkpoint's bor chain
 func (k *Keeper) SetCheckpointBuffer(ctx sdk.Context, headerBlock hmTypes.CheckpointBlockHeader) error {
-	err := k.addCheckpoint(ctx, BufferCheckpointKey, headerBlock)
+	err := k.addCheckpoint(ctx, GetBufferCheckpointKey(headerBlock.BorChainID), headerBlock)
 	if err != nil {
 		return err
 	}
@@ -104,9 +106,9 @@ func (k *Keeper) addCheckpoint(ctx sdk.Context, key []byte, headerBlock hmTypes.
 }
 
 // GetCheckpointByIndex to get checkpoint by header block index 10,000 ,20,000 and so on
-func (k *Keeper) GetCheckpointByIndex(ctx sdk.Context, headerIndex uint64) (hmTypes.CheckpointBlockHeader, error) {
+func (k *Keeper) GetCheckpointByIndex(ctx sdk.Context, borChainID string, headerIndex uint64) (hmTypes.CheckpointBlockHeader, error) {
 	store := ctx.KVStore(k.storeKey)
-	headerKey := GetHeaderKey(headerIndex)
+	headerKey := GetHeaderKey(borChainID, headerIndex)
 	var _checkpoint hmTypes.CheckpointBlockHeader
 
 	if store.Has(headerKey) {
@@ -121,8 +123,8 @@ func (k *Keeper) GetCheckpointByIndex(ctx sdk.Context, headerIndex uint64) (hmTy
 	}
 }
 
-// GetCheckpointList returns all checkpoints with params like page and limit
-func (k *Keeper) GetCheckpointList(ctx sdk.Context, page uint64, limit uint64) ([]hmTypes.CheckpointBlockHeader, error) {
+// GetCheckpointList returns all checkpoints of bor chain with params like page and limit
+func (k *Keeper) GetCheckpointList(ctx sdk.Context, borChainID string, page uint64, limit uint64) ([]hmTypes.CheckpointBlockHeader, error) {
 	store := ctx.KVStore(k.storeKey)
 
 	// create headers
@@ -134,7 +136,7 @@ func (k *Keeper) GetCheckpointList(ctx sdk.Context, page uint64, limit uint64) (
 	}
 
 	// get paginated iterator
-	iterator := hmTypes.KVStorePrefixIteratorPaginated(store, HeaderBlockKey, uint(page), uint(limit))
+	iterator := hmTypes.KVStorePrefixIteratorPaginated(store, GetHeaderPrefixKey(borChainID), uint(page), uint(limit))
 
 	// loop through validators to get valid validators
 	for ; iterator.Valid(); iterator.Next() {
@@ -147,13 +149,59 @@ func (k *Keeper) GetCheckpointList(ctx sdk.Context, page uint64, limit uint64) (
 	return headers, nil
 }
 
-// GetLastCheckpoint gets last checkpoint, headerIndex = TotalACKs * ChildBlockInterval
-func (k *Keeper) GetLastCheckpoint(ctx sdk.Context) (hmTypes.CheckpointBlockHeader, error) {
+// GetCheckpointByBlockNumber returns header index and checkpoint which contains given child block
+func (k *Keeper) GetCheckpointByBlockNumber(ctx sdk.Context, borChainID string, blockNumber uint64) (uint64, hmTypes.CheckpointBlockHeader, error) {
+	childBlockInterval := k.GetParams(ctx).ChildBlockInterval
+
+	// checkpoints are stored in increasing order of blocks, binary search on ack count
+	low, high := uint64(1), k.GetChainACKCount(ctx, borChainID)
+	for low <= high {
+		mid := low + (high-low)/2
+		headerIndex := mid * childBlockInterval
+
+		_checkpoint, err := k.GetCheckpointByIndex(ctx, borChainID, headerIndex)
+		if err != nil {
+			return 0, _checkpoint, err
+		}
+
+		switch {
+		case blockNumber < _checkpoint.StartBlock:
+			high = mid - 1
+		case blockNumber > _checkpoint.EndBlock:
+			low = mid + 1
+		default:
+			return headerIndex, _checkpoint, nil
+		}
+	}
+
+	return 0, hmTypes.CheckpointBlockHeader{}, cmn.ErrNoCheckpointFound(k.Codespace())
+}
+
+// GetCheckpointForSubmit returns checkpoint which is (or will be) submitted on root chain at header index.
+// Committed checkpoint is returned for acked header index, buffered checkpoint for next header index.
+func (k *Keeper) GetCheckpointForSubmit(ctx sdk.Context, borChainID string, headerIndex uint64) (hmTypes.CheckpointBlockHeader, error) {
+	if checkpoint, err := k.GetCheckpointByIndex(ctx, borChainID, headerIndex); err == nil {
+		return checkpoint, nil
+	}
+
+	// buffered checkpoint will be submitted at next header index
+	nextHeaderIndex := (k.GetChainACKCount(ctx, borChainID) + 1) * k.GetParams(ctx).ChildBlockInterval
+	if headerIndex == nextHeaderIndex {
+		if checkpoint, err := k.GetCheckpointFromBuffer(ctx, borChainID); err == nil {
+			return *checkpoint, nil
+		}
+	}
+
+	return hmTypes.CheckpointBlockHeader{}, errors.New("Invalid header Index")
+}
+
+// GetLastCheckpoint gets last checkpoint of bor chain, headerIndex = TotalACKs * ChildBlockInterval
+func (k *Keeper) GetLastCheckpoint(ctx sdk.Context, borChainID string) (hmTypes.CheckpointBlockHeader, error) {
 	store := ctx.KVStore(k.storeKey)
-	acksCount := k.GetACKCount(ctx)
+	acksCount := k.GetChainACKCount(ctx, borChainID)
 
 	// fetch last checkpoint key (NumberOfACKs * ChildBlockInterval)
-	lastCheckpointKey := helper.GetConfig().ChildBlockInterval * acksCount
+	lastCheckpointKey := k.GetParams(ctx).ChildBlockInterval * acksCount
 
 	// fetch checkpoint and unmarshall
 	var _checkpoint hmTypes.CheckpointBlockHeader
@@ -161,7 +209,7 @@ func (k *Keeper) GetLastCheckpoint(ctx sdk.Context) (hmTypes.CheckpointBlockHead
 	// no checkpoint received
 	if acksCount >= 0 {
 		// header key
-		headerKey := GetHeaderKey(lastCheckpointKey)
+		headerKey := GetHeaderKey(borChainID, lastCheckpointKey)
 		if store.Has(headerKey) {
 			err := k.cdc.UnmarshalBinaryBare(store.Get(headerKey), &_checkpoint)
 			if err != nil {
@@ -175,10 +223,25 @@ func (k *Keeper) GetLastCheckpoint(ctx sdk.Context) (hmTypes.CheckpointBlockHead
 	return _checkpoint, cmn.ErrNoCheckpointFound(k.Codespace())
 }
 
-// GetHeaderKey appends prefix to headerNumber
-func GetHeaderKey(headerNumber uint64) []byte {
+// GetHeaderPrefixKey returns prefix key for headers of bor chain
+func GetHeaderPrefixKey(borChainID string) []byte {
+	return append(append(HeaderBlockKey, []byte(borChainID)...), ':')
+}
+
+// GetHeaderKey appends bor chain prefix to headerNumber
+func GetHeaderKey(borChainID string, headerNumber uint64) []byte {
 	headerNumberBytes := []byte(strconv.FormatUint(headerNumber, 10))
-	return append(HeaderBlockKey, headerNumberBytes...)
+	return append(GetHeaderPrefixKey(borChainID), headerNumberBytes...)
+}
+
+// GetBufferCheckpointKey returns buffer key of bor chain
+func GetBufferCheckpointKey(borChainID string) []byte {
+	return append(BufferCheckpointKey, []byte(borChainID)...)
+}
+
+// GetACKCountKey returns ack count key of bor chain
+func GetACKCountKey(borChainID string) []byte {
+	return append(ACKCountKey, []byte(borChainID)...)
 }
 
 // HasStoreValue check if value exists in store or not
@@ -190,28 +253,39 @@ func (k *Keeper) HasStoreValue(ctx sdk.Context, key []byte) bool {
 	return false
 }
 
-// FlushCheckpointBuffer flushes Checkpoint Buffer
-func (k *Keeper) FlushCheckpointBuffer(ctx sdk.Context) {
+// FlushCheckpointBuffer flushes Checkpoint Buffer of bor chain
+func (k *Keeper) FlushCheckpointBuffer(ctx sdk.Context, borChainID string) {
 	store := ctx.KVStore(k.storeKey)
-	store.Delete(BufferCheckpointKey)
+	store.Delete(GetBufferCheckpointKey(borChainID))
 }
 
-// GetCheckpointFromBuffer gets checkpoint in buffer
-func (k *Keeper) GetCheckpointFromBuffer(ctx sdk.Context) (*hmTypes.CheckpointBlockHeader, error) {
+// GetCheckpointFromBuffer gets checkpoint of bor chain in buffer
+func (k *Keeper) GetCheckpointFromBuffer(ctx sdk.Context, borChainID string) (*hmTypes.CheckpointBlockHeader, error) {
 	store := ctx.KVStore(k.storeKey)
 
 	// checkpoint block header
 	var checkpoint hmTypes.CheckpointBlockHeader
 
-	if store.Has(BufferCheckpointKey) {
+	key := GetBufferCheckpointKey(borChainID)
+	if store.Has(key) {
 		// Get checkpoint and unmarshall
-		err := k.cdc.UnmarshalBinaryBare(store.Get(BufferCheckpointKey), &checkpoint)
+		err := k.cdc.UnmarshalBinaryBare(store.Get(key), &checkpoint)
 		return &checkpoint, err
 	}
 
 	return nil, errors.New("No checkpoint found in buffer")
 }
 
+// GetCheckpointBuffers returns buffered checkpoints of all bor chains
+func (k *Keeper) GetCheckpointBuffers(ctx sdk.Context) (checkpoints []hmTypes.CheckpointBlockHeader) {
+	for _, borChainID := range k.GetBorChainIDs(ctx) {
+		if checkpoint, err := k.GetCheckpointFromBuffer(ctx, borChainID); err == nil {
+			checkpoints = append(checkpoints, *checkpoint)
+		}
+	}
+	return checkpoints
+}
+
 // SetLastNoAck set last no-ack object
 func (k *Keeper) SetLastNoAck(ctx sdk.Context, timestamp uint64) {
 	store := ctx.KVStore(k.storeKey)
@@ -259,13 +333,24 @@ func (k *Keeper) GetCheckpointHeaders(ctx sdk.Context) []hmTypes.CheckpointBlock
 // Ack count
 //
 
-// GetACKCount returns current ACK count
+// GetACKCount returns current ACK count of primary bor chain, which defines validator epochs
 func (k Keeper) GetACKCount(ctx sdk.Context) uint64 {
+	return k.GetChainACKCount(ctx, k.GetPrimaryBorChainID(ctx))
+}
+
+// GetConfirmationBlocks returns number of main chain blocks required to confirm root chain tx
+func (k Keeper) GetConfirmationBlocks(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).ConfirmationBlocks
+}
+
+// GetChainACKCount returns current ACK count of bor chain
+func (k Keeper) GetChainACKCount(ctx sdk.Context, borChainID string) uint64 {
 	store := ctx.KVStore(k.storeKey)
+	key := GetACKCountKey(borChainID)
 	// check if ack count is there
-	if store.Has(ACKCountKey) {
+	if store.Has(key) {
 		// get current ACK count
-		ackCount, err := strconv.ParseUint(string(store.Get(ACKCountKey)), 10, 64)
+		ackCount, err := strconv.ParseUint(string(store.Get(key)), 10, 64)
 		if err != nil {
 			k.Logger(ctx).Error("Unable to convert key to int")
 		} else {
@@ -276,27 +361,148 @@ func (k Keeper) GetACKCount(ctx sdk.Context) uint64 {
 	return 0
 }
 
-// UpdateACKCountWithValue updates ACK with value
-func (k Keeper) UpdateACKCountWithValue(ctx sdk.Context, value uint64) {
+// UpdateACKCountWithValue updates ACK of bor chain with value
+func (k Keeper) UpdateACKCountWithValue(ctx sdk.Context, borChainID string, value uint64) {
 	store := ctx.KVStore(k.storeKey)
 
 	// convert
 	ackCount := []byte(strconv.FormatUint(value, 10))
 
 	// update
-	store.Set(ACKCountKey, ackCount)
+	store.Set(GetACKCountKey(borChainID), ackCount)
 }
 
-// UpdateACKCount updates ACK count by 1
-func (k Keeper) UpdateACKCount(ctx sdk.Context) {
+// UpdateACKCount updates ACK count of bor chain by 1
+func (k Keeper) UpdateACKCount(ctx sdk.Context, borChainID string) {
 	store := ctx.KVStore(k.storeKey)
 
 	// get current ACK Count
-	ACKCount := k.GetACKCount(ctx)
+	ACKCount := k.GetChainACKCount(ctx, borChainID)
 
 	// increment by 1
 	ACKs := []byte(strconv.FormatUint(ACKCount+1, 10))
 
 	// update
-	store.Set(ACKCountKey, ACKs)
+	store.Set(GetACKCountKey(borChainID), ACKs)
+}
+
+//
+// Bor chains
+//
+
+// SetBorChainIDs sets registered bor chain ids
+func (k Keeper) SetBorChainIDs(ctx sdk.Context, borChainIDs []string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(BorChainIDsKey, k.cdc.MustMarshalBinaryBare(borChainIDs))
+}
+
+// GetBorChainIDs returns registered bor chain ids, primary bor chain first
+func (k Keeper) GetBorChainIDs(ctx sdk.Context) (borChainIDs []string) {
+	store := ctx.KVStore(k.storeKey)
+	if store.Has(BorChainIDsKey) {
+		k.cdc.MustUnmarshalBinaryBare(store.Get(BorChainIDsKey), &borChainIDs)
+	}
+	return borChainIDs
+}
+
+// HasBorChainID checks if bor chain is registered
+func (k Keeper) HasBorChainID(ctx sdk.Context, borChainID string) bool {
+	for _, id := range k.GetBorChainIDs(ctx) {
+		if id == borChainID {
+			return true
+		}
+	}
+	return false
+}
+
+// GetPrimaryBorChainID returns primary bor chain id (first registered bor chain)
+func (k Keeper) GetPrimaryBorChainID(ctx sdk.Context) string {
+	borChainIDs := k.GetBorChainIDs(ctx)
+	if len(borChainIDs) == 0 {
+		return ""
+	}
+	return borChainIDs[0]
+}
+
+//
+//  Proposer stats
+//
+
+// GetProposerStatsKey appends prefix to validator id
+func GetProposerStatsKey(validatorID hmTypes.ValidatorID) []byte {
+	return append(ProposerStatsKey, validatorID.Bytes()...)
+}
+
+// GetProposerStats returns checkpoint stats of proposer
+func (k *Keeper) GetProposerStats(ctx sdk.Context, validatorID hmTypes.ValidatorID) types.ProposerStats {
+	store := ctx.KVStore(k.storeKey)
+	stats := types.NewProposerStats(validatorID)
+
+	key := GetProposerStatsKey(validatorID)
+	if store.Has(key) {
+		k.cdc.MustUnmarshalBinaryBare(store.Get(key), &stats)
+	}
+
+	return stats
+}
+
+// AddProposerCheckpoint credits acknowledged checkpoint to proposer stats
+func (k *Keeper) AddProposerCheckpoint(ctx sdk.Context, validatorID hmTypes.ValidatorID, checkpoint hmTypes.CheckpointBlockHeader) {
+	stats := k.GetProposerStats(ctx, validatorID)
+	stats.CheckpointCount++
+	stats.BlockCount += checkpoint.EndBlock - checkpoint.StartBlock + 1
+	k.SetProposerStats(ctx, stats)
+}
+
+// SetProposerStats sets checkpoint stats of proposer
+func (k *Keeper) SetProposerStats(ctx sdk.Context, stats types.ProposerStats) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetProposerStatsKey(stats.ValidatorID), k.cdc.MustMarshalBinaryBare(stats))
+}
+
+// GetAllProposerStats returns checkpoint stats of all proposers
+func (k *Keeper) GetAllProposerStats(ctx sdk.Context) (allStats []types.ProposerStats) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, ProposerStatsKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var stats types.ProposerStats
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &stats); err == nil {
+			allStats = append(allStats, stats)
+		}
+	}
+	return allStats
+}
+
+// GetCheckpointSequenceKey returns checkpoint sequence key
+func GetCheckpointSequenceKey(sequence uint64) []byte {
+	return append(CheckpointSequenceKey, []byte(strconv.FormatUint(sequence, 10))...)
+}
+
+// SetCheckpointSequence marks root chain header block event as processed
+func (k *Keeper) SetCheckpointSequence(ctx sdk.Context, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetCheckpointSequenceKey(sequence), DefaultValue)
+}
+
+// HasCheckpointSequence checks if root chain header block event is already processed
+func (k *Keeper) HasCheckpointSequence(ctx sdk.Context, sequence uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(GetCheckpointSequenceKey(sequence))
+}
+
+//
+//  Params
+//
+
+// SetParams sets the checkpoint module's parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetParams gets the checkpoint module's parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return
 }
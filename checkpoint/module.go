@@ -24,6 +24,7 @@ var (
 	_ module.AppModule            = AppModule{}
 	_ module.AppModuleBasic       = AppModuleBasic{}
 	_ hmTypes.HeimdallModuleBasic = AppModule{}
+	_ hmTypes.SideModule          = AppModule{}
 	// _ module.AppModuleSimulation = AppModule{}
 )
 
@@ -109,8 +110,10 @@ func (AppModule) Name() string {
 	return types.ModuleName
 }
 
-// RegisterInvariants performs a no-op.
-func (AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+// RegisterInvariants registers the checkpoint module invariants.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	RegisterInvariants(ir, am.keeper)
+}
 
 // Route returns the message routing key for the auth module.
 func (AppModule) Route() string {
@@ -122,6 +125,16 @@ func (am AppModule) NewHandler() sdk.Handler {
 	return NewHandler(am.keeper, am.contractCaller)
 }
 
+// NewSideTxHandler returns side tx handler for the module.
+func (am AppModule) NewSideTxHandler() hmTypes.SideTxHandler {
+	return NewSideTxHandler(am.keeper, am.contractCaller)
+}
+
+// NewPostTxHandler returns post tx handler for the module.
+func (am AppModule) NewPostTxHandler() hmTypes.PostTxHandler {
+	return NewPostTxHandler(am.keeper, am.contractCaller)
+}
+
 // QuerierRoute returns the auth module's querier route name.
 func (AppModule) QuerierRoute() string {
 	return types.QuerierRoute
@@ -158,9 +171,10 @@ func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
 // BeginBlock returns the begin blocker for the auth module.
 func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
-// EndBlock returns the end blocker for the auth module. It returns no validator
-// updates.
-func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+// EndBlock returns the end blocker for the checkpoint module. It flushes expired
+// checkpoint buffer and returns no validator updates.
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	EndBlocker(ctx, am.keeper)
 	return []abci.ValidatorUpdate{}
 }
 
@@ -173,25 +187,39 @@ func verifyGenesis(state types.GenesisState) error {
 		return err
 	}
 
-	// check header count
-	currentHeaderIndex, err := contractCaller.CurrentHeaderBlock()
-	if err != nil {
-		return nil
-	}
+	childBlockInterval := state.Params.ChildBlockInterval
+	for _, borChainID := range state.BorChainIDs {
+		// check header count
+		currentHeaderIndex, err := contractCaller.CurrentHeaderBlock(borChainID)
+		if err != nil {
+			return nil
+		}
+
+		ackCount := state.GetAckCount(borChainID)
+		if ackCount*childBlockInterval != currentHeaderIndex {
+			fmt.Println("Header Count doesn't match",
+				"BorChainID", borChainID,
+				"ExpectedHeader", currentHeaderIndex,
+				"HeaderIndexFound", ackCount*childBlockInterval)
+			return nil
+		}
+
+		fmt.Println("ACK count valid:", "borChainID", borChainID, "count", currentHeaderIndex)
 
-	if state.AckCount*helper.GetConfig().ChildBlockInterval != currentHeaderIndex {
-		fmt.Println("Header Count doesn't match",
-			"ExpectedHeader", currentHeaderIndex,
-			"HeaderIndexFound", state.AckCount*helper.GetConfig().ChildBlockInterval)
-		return nil
+		// check all headers
+		if err := verifyGenesisHeaders(&contractCaller, borChainID, childBlockInterval, state.GetHeaders(borChainID)); err != nil {
+			return err
+		}
 	}
 
-	fmt.Println("ACK count valid:", "count", currentHeaderIndex)
+	return nil
+}
 
-	// check all headers
-	for i, header := range state.Headers {
+// verifyGenesisHeaders checks headers of bor chain against root chain
+func verifyGenesisHeaders(contractCaller helper.IContractCaller, borChainID string, childBlockInterval uint64, headers []hmTypes.CheckpointBlockHeader) error {
+	for i, header := range hmTypes.SortHeaders(headers) {
 		ackCount := uint64(i + 1)
-		root, start, end, _, _, err := contractCaller.GetHeaderInfo(ackCount * helper.GetConfig().ChildBlockInterval)
+		root, start, end, _, _, err := contractCaller.GetHeaderInfo(borChainID, ackCount*childBlockInterval)
 		if err != nil {
 			return err
 		}
@@ -1,6 +1,7 @@
 package checkpoint
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 
@@ -26,14 +27,70 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return handleQueryLastNoAck(ctx, req, keeper)
 		case types.QueryCheckpointList:
 			return handleQueryCheckpointList(ctx, req, keeper)
+		case types.QueryParams:
+			return handleQueryParams(ctx, req, keeper)
+		case types.QueryProof:
+			return handleQueryProof(ctx, req, keeper)
+		case types.QueryProposerStats:
+			return handleQueryProposerStats(ctx, req, keeper)
+		case types.QueryBorChains:
+			return handleQueryBorChains(ctx, req, keeper)
+		case types.QuerySubmitCheckpoint:
+			return handleQuerySubmitCheckpoint(ctx, req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown auth query endpoint")
 		}
 	}
 }
 
+// getQueryBorChainID returns registered bor chain id, empty bor chain id refers to primary bor chain
+func getQueryBorChainID(ctx sdk.Context, keeper Keeper, borChainID string) (string, sdk.Error) {
+	if borChainID == "" {
+		return keeper.GetPrimaryBorChainID(ctx), nil
+	}
+
+	if !keeper.HasBorChainID(ctx, borChainID) {
+		return "", common.ErrUnknownBorChain(keeper.Codespace(), borChainID)
+	}
+
+	return borChainID, nil
+}
+
+// parseBorChainParams parses optional bor chain params
+func parseBorChainParams(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (string, sdk.Error) {
+	var params types.QueryBorChainParams
+	if len(req.Data) != 0 {
+		if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+			return "", sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+		}
+	}
+
+	return getQueryBorChainID(ctx, keeper, params.BorChainID)
+}
+
+func handleQueryBorChains(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	bz, err := json.Marshal(keeper.GetBorChainIDs(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
 func handleQueryAckCount(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
-	bz, err := json.Marshal(keeper.GetACKCount(ctx))
+	borChainID, sdkErr := parseBorChainParams(ctx, req, keeper)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	bz, err := json.Marshal(keeper.GetChainACKCount(ctx, borChainID))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryParams(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	bz, err := json.Marshal(keeper.GetParams(ctx))
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
 	}
@@ -46,7 +103,12 @@ func handleQueryCheckpoint(ctx sdk.Context, req abci.RequestQuery, keeper Keeper
 		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
 	}
 
-	res, err := keeper.GetCheckpointByIndex(ctx, params.HeaderIndex)
+	borChainID, sdkErr := getQueryBorChainID(ctx, keeper, params.BorChainID)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	res, err := keeper.GetCheckpointByIndex(ctx, borChainID, params.HeaderIndex)
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not fetch checkpoint by index %v", params.HeaderIndex), err.Error()))
 	}
@@ -58,8 +120,92 @@ func handleQueryCheckpoint(ctx sdk.Context, req abci.RequestQuery, keeper Keeper
 	return bz, nil
 }
 
+func handleQuerySubmitCheckpoint(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryCheckpointParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	borChainID, sdkErr := getQueryBorChainID(ctx, keeper, params.BorChainID)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	res, err := keeper.GetCheckpointForSubmit(ctx, borChainID, params.HeaderIndex)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not fetch checkpoint for header index %v", params.HeaderIndex), err.Error()))
+	}
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryProof(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryBlockProofParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	borChainID, sdkErr := getQueryBorChainID(ctx, keeper, params.BorChainID)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	headerIndex, checkpoint, err := keeper.GetCheckpointByBlockNumber(ctx, borChainID, params.BlockNumber)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not find checkpoint for block %v", params.BlockNumber), err.Error()))
+	}
+
+	// rebuild headers tree from bor chain
+	leaf, root, proof, err := types.GetBlockProof(borChainID, checkpoint.StartBlock, checkpoint.EndBlock, params.BlockNumber)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not generate proof for block %v", params.BlockNumber), err.Error()))
+	}
+
+	if !bytes.Equal(root, checkpoint.RootHash.Bytes()) {
+		return nil, common.ErrBadBlockDetails(keeper.Codespace())
+	}
+
+	bz, err := json.Marshal(types.BlockProof{
+		BorChainID:  borChainID,
+		HeaderIndex: headerIndex,
+		StartBlock:  checkpoint.StartBlock,
+		EndBlock:    checkpoint.EndBlock,
+		RootHash:    checkpoint.RootHash,
+		BlockNumber: params.BlockNumber,
+		Leaf:        hmTypes.BytesToHeimdallHash(leaf),
+		Index:       params.BlockNumber - checkpoint.StartBlock,
+		Proof:       proof,
+	})
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryProposerStats(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryProposerStatsParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	bz, err := json.Marshal(keeper.GetProposerStats(ctx, params.ValidatorID))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
 func handleQueryCheckpointBuffer(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
-	res, err := keeper.GetCheckpointFromBuffer(ctx)
+	borChainID, sdkErr := parseBorChainParams(ctx, req, keeper)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	res, err := keeper.GetCheckpointFromBuffer(ctx, borChainID)
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not fetch checkpoint buffer", err.Error()))
 	}
@@ -87,12 +233,17 @@ func handleQueryLastNoAck(ctx sdk.Context, req abci.RequestQuery, keeper Keeper)
 }
 
 func handleQueryCheckpointList(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
-	var params hmTypes.QueryPaginationParams
+	var params types.QueryCheckpointListParams
 	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
 		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
 	}
 
-	res, err := keeper.GetCheckpointList(ctx, params.Page, params.Limit)
+	borChainID, sdkErr := getQueryBorChainID(ctx, keeper, params.BorChainID)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	res, err := keeper.GetCheckpointList(ctx, borChainID, params.Page, params.Limit)
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr(fmt.Sprintf("could not fetch checkpoint list with page %v and limit %v", params.Page, params.Limit), err.Error()))
 	}
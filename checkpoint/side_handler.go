@@ -0,0 +1,264 @@
+package checkpoint
+
+import (
+	"bytes"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// NewSideTxHandler returns side tx handler for checkpoint module
+func NewSideTxHandler(k Keeper, contractCaller helper.IContractCaller) hmTypes.SideTxHandler {
+	return func(ctx sdk.Context, msg sdk.Msg) hmTypes.SideTxResult {
+		switch msg := msg.(type) {
+		case types.MsgCheckpoint:
+			return sideHandleMsgCheckpoint(ctx, msg, k)
+		case types.MsgCheckpointAck:
+			return sideHandleMsgCheckpointAck(ctx, msg, k, contractCaller)
+		default:
+			return hmTypes.SideTxResultSkip
+		}
+	}
+}
+
+// NewPostTxHandler returns post tx handler for checkpoint module
+func NewPostTxHandler(k Keeper, contractCaller helper.IContractCaller) hmTypes.PostTxHandler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case types.MsgCheckpoint:
+			return postHandleMsgCheckpoint(ctx, msg, k)
+		case types.MsgCheckpointAck:
+			return postHandleMsgCheckpointAck(ctx, msg, k)
+		default:
+			return sdk.ErrTxDecode("Invalid message in checkpoint module").Result()
+		}
+	}
+}
+
+// sideHandleMsgCheckpoint verifies checkpoint root hash against Bor chain
+func sideHandleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper) hmTypes.SideTxResult {
+	validCheckpoint, err := types.ValidateCheckpoint(msg.BorChainID, msg.StartBlock, msg.EndBlock, msg.RootHash)
+	if err != nil {
+		k.Logger(ctx).Error("Error validating checkpoint",
+			"Error", err,
+			"BorChainID", msg.BorChainID,
+			"StartBlock", msg.StartBlock,
+			"EndBlock", msg.EndBlock)
+		return hmTypes.SideTxResultSkip
+	}
+
+	if !validCheckpoint {
+		k.Logger(ctx).Error("RootHash is not valid",
+			"StartBlock", msg.StartBlock,
+			"EndBlock", msg.EndBlock,
+			"RootHash", msg.RootHash)
+		return hmTypes.SideTxResultNo
+	}
+
+	k.Logger(ctx).Debug("Valid Roothash in checkpoint", "StartBlock", msg.StartBlock, "EndBlock", msg.EndBlock)
+	return hmTypes.SideTxResultYes
+}
+
+// sideHandleMsgCheckpointAck verifies header block in ack against NewHeaderBlock event on root chain
+func sideHandleMsgCheckpointAck(ctx sdk.Context, msg types.MsgCheckpointAck, k Keeper, contractCaller helper.IContractCaller) hmTypes.SideTxResult {
+	// get main tx receipt with confirmations agreed on chain
+	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), k.GetConfirmationBlocks(ctx))
+	if err != nil || receipt == nil {
+		k.Logger(ctx).Error("Unable to fetch confirmed receipt from rootchain", "Error", err, "txHash", msg.TxHash.String())
+		return hmTypes.SideTxResultSkip
+	}
+
+	eventLog, err := contractCaller.DecodeNewHeaderBlockEvent(receipt, msg.LogIndex)
+	if err != nil || eventLog == nil {
+		k.Logger(ctx).Error("Error fetching log from txhash", "Error", err, "txHash", msg.TxHash.String(), "logIndex", msg.LogIndex)
+		return hmTypes.SideTxResultNo
+	}
+
+	// event must be emitted by rootchain contract of bor chain
+	rootChainAddress := helper.GetChildChainRootChainAddress(msg.BorChainID)
+	if !bytes.Equal(eventLog.Raw.Address.Bytes(), rootChainAddress.Bytes()) {
+		k.Logger(ctx).Error("Event not emitted by rootchain contract of bor chain",
+			"borChainID", msg.BorChainID,
+			"rootChainExpected", rootChainAddress.Hex(),
+			"rootChainReceived", eventLog.Raw.Address.Hex())
+		return hmTypes.SideTxResultNo
+	}
+
+	if receipt.BlockNumber.Uint64() != msg.BlockNumber {
+		k.Logger(ctx).Error("BlockNumber in message doesnt match blocknumber in receipt", "MsgBlockNumber", msg.BlockNumber, "ReceiptBlockNumber", receipt.BlockNumber.Uint64())
+		return hmTypes.SideTxResultNo
+	}
+
+	k.Logger(ctx).Debug("HeaderBlock event fetched",
+		"headerBlock", eventLog.HeaderBlockId,
+		"start", eventLog.Start,
+		"end", eventLog.End,
+		"roothash", hmTypes.BytesToHeimdallHash(eventLog.Root[:]).String(),
+		"proposer", eventLog.Proposer.Hex(),
+		"txBlock", receipt.BlockNumber.Uint64(),
+	)
+
+	if eventLog.HeaderBlockId.Uint64() != msg.HeaderBlock ||
+		eventLog.Start.Uint64() != msg.StartBlock ||
+		eventLog.End.Uint64() != msg.EndBlock ||
+		!bytes.Equal(eventLog.Root[:], msg.RootHash.Bytes()) ||
+		!bytes.Equal(eventLog.Proposer.Bytes(), msg.Proposer.Bytes()) {
+		k.Logger(ctx).Error("Header block in ack doesn't match root chain",
+			"headerBlockExpected", eventLog.HeaderBlockId,
+			"headerBlockReceived", msg.HeaderBlock,
+			"startExpected", eventLog.Start,
+			"startReceived", msg.StartBlock,
+			"endExpected", eventLog.End,
+			"endReceived", msg.EndBlock,
+			"rootExpected", hmTypes.BytesToHeimdallHash(eventLog.Root[:]).String(),
+			"rootRecieved", msg.RootHash.String())
+		return hmTypes.SideTxResultNo
+	}
+
+	return hmTypes.SideTxResultYes
+}
+
+// postHandleMsgCheckpoint adds approved checkpoint to buffer
+func postHandleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper) sdk.Result {
+	// state might have changed since checkpoint was submitted
+	if err := validateCheckpoint(ctx, msg, k); err != nil {
+		return err.Result()
+	}
+
+	if checkpointBuffer, err := k.GetCheckpointFromBuffer(ctx, msg.BorChainID); err == nil {
+		k.Logger(ctx).Debug("Checkpoint has been timed out, flushing buffer", "BlockTime", ctx.BlockTime().Unix(), "PrevCheckpointTimestamp", checkpointBuffer.TimeStamp)
+		k.FlushCheckpointBuffer(ctx, msg.BorChainID)
+	}
+
+	// add checkpoint to buffer
+	// Add AccountRootHash to CheckpointBuffer
+	k.SetCheckpointBuffer(ctx, hmTypes.CheckpointBlockHeader{
+		StartBlock:      msg.StartBlock,
+		EndBlock:        msg.EndBlock,
+		RootHash:        msg.RootHash,
+		AccountRootHash: msg.AccountRootHash,
+		Proposer:        msg.Proposer,
+		BorChainID:      msg.BorChainID,
+		TimeStamp:       msg.TimeStamp,
+	})
+
+	checkpoint, _ := k.GetCheckpointFromBuffer(ctx, msg.BorChainID)
+	k.Logger(ctx).Debug("Adding good checkpoint to buffer to await ACK", "checkpointStored", checkpoint.String())
+
+	// bridge dispatches approved checkpoint to root chain
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCheckpoint,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyProposer, msg.Proposer.String()),
+			sdk.NewAttribute(types.AttributeKeyBorChainID, msg.BorChainID),
+			sdk.NewAttribute(types.AttributeKeyStartBlock, strconv.FormatUint(uint64(msg.StartBlock), 10)),
+			sdk.NewAttribute(types.AttributeKeyEndBlock, strconv.FormatUint(uint64(msg.EndBlock), 10)),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// postHandleMsgCheckpointAck adds approved checkpoint to state and selects new proposer
+func postHandleMsgCheckpointAck(ctx sdk.Context, msg types.MsgCheckpointAck, k Keeper) sdk.Result {
+	// state might have changed since ack was submitted
+	headerBlock, err := validateCheckpointAck(ctx, msg, k)
+	if err != nil {
+		return err.Result()
+	}
+
+	if headerBlock.EndBlock > msg.EndBlock {
+		k.Logger(ctx).Info("Adjusting endBlock to one already submitted on chain",
+			"OldEndBlock", headerBlock.EndBlock,
+			"AdjustedEndBlock", msg.EndBlock,
+			"OldProposer", headerBlock.Proposer.String(),
+			"AdjustedProposer", msg.Proposer.String())
+
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeCheckpointAdjust,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyBorChainID, msg.BorChainID),
+				sdk.NewAttribute(types.AttributeKeyHeaderIndex, strconv.FormatUint(msg.HeaderBlock, 10)),
+				sdk.NewAttribute(types.AttributeKeyStartBlock, strconv.FormatUint(headerBlock.StartBlock, 10)),
+				sdk.NewAttribute(types.AttributeKeyOriginalEndBlock, strconv.FormatUint(headerBlock.EndBlock, 10)),
+				sdk.NewAttribute(types.AttributeKeyEndBlock, strconv.FormatUint(msg.EndBlock, 10)),
+				sdk.NewAttribute(types.AttributeKeyOriginalRootHash, headerBlock.RootHash.String()),
+				sdk.NewAttribute(types.AttributeKeyRootHash, msg.RootHash.String()),
+				sdk.NewAttribute(types.AttributeKeyOriginalProposer, headerBlock.Proposer.String()),
+				sdk.NewAttribute(types.AttributeKeyProposer, msg.Proposer.String()),
+			),
+		})
+
+		headerBlock.EndBlock = msg.EndBlock
+		headerBlock.RootHash = msg.RootHash
+	}
+
+	// proposer is the one who submitted header block on root chain (verified by validators)
+	headerBlock.Proposer = msg.Proposer
+
+	// Add checkpoint to headerBlocks
+	if err := k.AddCheckpoint(ctx, msg.HeaderBlock, *headerBlock); err != nil {
+		k.Logger(ctx).Error("Unable to add checkpoint", "error", err)
+		return common.ErrBadAck(k.Codespace()).Result()
+	}
+	k.Logger(ctx).Info("Checkpoint added to store", "headerBlock", headerBlock.String())
+
+	// credit checkpoint to actual proposer. Checkpoint reward and proposer bonus are paid on root
+	// chain to header proposer, so only stats are kept here; dividend accounts hold topped up fees.
+	if proposer, err := k.sk.GetValidatorInfo(ctx, msg.Proposer.Bytes()); err == nil {
+		k.AddProposerCheckpoint(ctx, proposer.ID, *headerBlock)
+	} else {
+		k.Logger(ctx).Error("Checkpoint proposer is not a validator", "proposer", msg.Proposer.String(), "error", err)
+	}
+
+	// flush buffer
+	k.FlushCheckpointBuffer(ctx, msg.BorChainID)
+	k.Logger(ctx).Debug("Checkpoint buffer flushed after receiving checkpoint ack", "checkpoint", headerBlock)
+
+	// mark root chain event as processed
+	k.SetCheckpointSequence(ctx, getCheckpointSequence(msg.BlockNumber, msg.LogIndex))
+
+	// update ack count
+	k.UpdateACKCount(ctx, msg.BorChainID)
+	k.Logger(ctx).Debug("Valid ack received", "BorChainID", msg.BorChainID, "UpdatedACKCount", k.GetChainACKCount(ctx, msg.BorChainID))
+
+	// --- Update to new proposer
+
+	// increment accum
+	k.sk.IncrementAccum(ctx, 1)
+
+	//log new proposer
+	vs := k.sk.GetValidatorSet(ctx)
+	newProposer := vs.GetProposer()
+	k.Logger(ctx).Debug(
+		"New proposer selected",
+		"validator", newProposer.Signer.String(),
+		"signer", newProposer.Signer.String(),
+		"power", newProposer.VotingPower,
+	)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCheckpointAck,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyBorChainID, msg.BorChainID),
+			sdk.NewAttribute(types.AttributeKeyHeaderIndex, strconv.FormatUint(uint64(msg.HeaderBlock), 10)),
+			sdk.NewAttribute(types.AttributeKeyProposer, msg.Proposer.String()),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
@@ -2,15 +2,23 @@ package types
 
 // Checkpoint tags
 var (
-	EventTypeCheckpoint      = "checkpoint"
-	EventTypeCheckpointAck   = "checkpoint-ack"
-	EventTypeCheckpointNoAck = "checkpoint-noack"
+	EventTypeCheckpoint        = "checkpoint"
+	EventTypeCheckpointAck     = "checkpoint-ack"
+	EventTypeCheckpointNoAck   = "checkpoint-noack"
+	EventTypeCheckpointTimeout = "checkpoint-timeout"
+	EventTypeCheckpointAdjust  = "checkpoint-ack-adjusted"
 
 	AttributeKeyProposer    = "proposer"
+	AttributeKeyBorChainID  = "bor-chain-id"
 	AttributeKeyStartBlock  = "start-block"
 	AttributeKeyEndBlock    = "end-block"
 	AttributeKeyHeaderIndex = "header-index"
 	AttributeKeyNewProposer = "new-proposer"
 
+	AttributeKeyOriginalProposer = "original-proposer"
+	AttributeKeyOriginalEndBlock = "original-end-block"
+	AttributeKeyOriginalRootHash = "original-root-hash"
+	AttributeKeyRootHash         = "root-hash"
+
 	AttributeValueCategory = ModuleName
 )
@@ -3,43 +3,138 @@ package types
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
+	"strconv"
 
+	"github.com/maticnetwork/heimdall/helper"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
+// ChainAckCount is the ack count of a bor chain
+type ChainAckCount struct {
+	BorChainID string `json:"bor_chain_id" yaml:"bor_chain_id"`
+	AckCount   uint64 `json:"ack_count" yaml:"ack_count"`
+}
+
 // GenesisState is the checkpoint state that must be provided at genesis.
 type GenesisState struct {
-	BufferedCheckpoint *hmTypes.CheckpointBlockHeader  `json:"buffered_checkpoint" yaml:"buffered_checkpoint"`
-	LastNoACK          uint64                          `json:"last_no_ack" yaml:"last_no_ack"`
-	AckCount           uint64                          `json:"ack_count" yaml:"ack_count"`
-	Headers            []hmTypes.CheckpointBlockHeader `json:"headers" yaml:"headers"`
+	Params Params `json:"params" yaml:"params"`
+
+	BorChainIDs         []string                        `json:"bor_chain_ids" yaml:"bor_chain_ids"`
+	BufferedCheckpoints []hmTypes.CheckpointBlockHeader `json:"buffered_checkpoints" yaml:"buffered_checkpoints"`
+	LastNoACK           uint64                          `json:"last_no_ack" yaml:"last_no_ack"`
+	AckCounts           []ChainAckCount                 `json:"ack_counts" yaml:"ack_counts"`
+	Headers             []hmTypes.CheckpointBlockHeader `json:"headers" yaml:"headers"`
+	ProposerStats       []ProposerStats                 `json:"proposer_stats" yaml:"proposer_stats"`
 }
 
 // NewGenesisState creates a new genesis state.
 func NewGenesisState(
-	bufferedCheckpoint *hmTypes.CheckpointBlockHeader,
+	params Params,
+	borChainIDs []string,
+	bufferedCheckpoints []hmTypes.CheckpointBlockHeader,
 	lastNoACK uint64,
-	ackCount uint64,
+	ackCounts []ChainAckCount,
 	headers []hmTypes.CheckpointBlockHeader,
+	proposerStats []ProposerStats,
 ) GenesisState {
 	return GenesisState{
-		BufferedCheckpoint: bufferedCheckpoint,
-		LastNoACK:          lastNoACK,
-		AckCount:           ackCount,
-		Headers:            headers,
+		Params:              params,
+		BorChainIDs:         borChainIDs,
+		BufferedCheckpoints: bufferedCheckpoints,
+		LastNoACK:           lastNoACK,
+		AckCounts:           ackCounts,
+		Headers:             headers,
+		ProposerStats:       proposerStats,
 	}
 }
 
-// DefaultGenesisState returns a default genesis state
+// DefaultGenesisState returns a default genesis state, primary bor chain is bor chain of heimdall config
 func DefaultGenesisState() GenesisState {
-	return GenesisState{}
+	borChainID := helper.GetConfig().BorChainID
+	if borChainID == "" {
+		borChainID = strconv.Itoa(helper.DefaultBorChainID)
+	}
+
+	return GenesisState{
+		Params:      DefaultParams(),
+		BorChainIDs: []string{borChainID},
+	}
+}
+
+// GetAckCount returns ack count of bor chain
+func (data GenesisState) GetAckCount(borChainID string) uint64 {
+	for _, ackCount := range data.AckCounts {
+		if ackCount.BorChainID == borChainID {
+			return ackCount.AckCount
+		}
+	}
+	return 0
+}
+
+// GetHeaders returns headers of bor chain
+func (data GenesisState) GetHeaders(borChainID string) (headers []hmTypes.CheckpointBlockHeader) {
+	for _, header := range data.Headers {
+		if header.BorChainID == borChainID {
+			headers = append(headers, header)
+		}
+	}
+	return headers
 }
 
 // ValidateGenesis performs basic validation of bor genesis data returning an
 // error for any failed validation criteria.
 func ValidateGenesis(data GenesisState) error {
-	if len(data.Headers) != 0 {
-		if int(data.AckCount) != len(data.Headers) {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	if len(data.BorChainIDs) == 0 {
+		return errors.New("At least one bor chain is required")
+	}
+
+	borChainIDs := make(map[string]bool)
+	for _, borChainID := range data.BorChainIDs {
+		if borChainID == "" || borChainIDs[borChainID] {
+			return fmt.Errorf("Invalid or duplicate bor chain id %q", borChainID)
+		}
+		borChainIDs[borChainID] = true
+	}
+
+	for _, checkpoint := range data.BufferedCheckpoints {
+		if !borChainIDs[checkpoint.BorChainID] {
+			return fmt.Errorf("Buffered checkpoint for unknown bor chain %q", checkpoint.BorChainID)
+		}
+	}
+
+	for _, ackCount := range data.AckCounts {
+		if !borChainIDs[ackCount.BorChainID] {
+			return fmt.Errorf("Ack count for unknown bor chain %q", ackCount.BorChainID)
+		}
+	}
+
+	for _, header := range data.Headers {
+		if !borChainIDs[header.BorChainID] {
+			return fmt.Errorf("Header for unknown bor chain %q", header.BorChainID)
+		}
+	}
+
+	proposers := make(map[hmTypes.ValidatorID]bool)
+	for _, stats := range data.ProposerStats {
+		if proposers[stats.ValidatorID] {
+			return fmt.Errorf("Duplicate proposer stats for validator %v", stats.ValidatorID)
+		}
+		proposers[stats.ValidatorID] = true
+
+		// every acknowledged checkpoint covers at least one block
+		if stats.BlockCount < stats.CheckpointCount {
+			return fmt.Errorf("Invalid proposer stats %v", stats.String())
+		}
+	}
+
+	for _, borChainID := range data.BorChainIDs {
+		headers := data.GetHeaders(borChainID)
+		if len(headers) != 0 && int(data.GetAckCount(borChainID)) != len(headers) {
 			return errors.New("Incorrect state in state-dump , Please Check")
 		}
 	}
@@ -20,14 +20,14 @@ import (
 )
 
 // ValidateCheckpoint - Validates if checkpoint rootHash matches or not
-func ValidateCheckpoint(start uint64, end uint64, rootHash hmTypes.HeimdallHash) (bool, error) {
+func ValidateCheckpoint(borChainID string, start uint64, end uint64, rootHash hmTypes.HeimdallHash) (bool, error) {
 	// Check if blocks exist locally
-	if !CheckIfBlocksExist(end) {
+	if !CheckIfBlocksExist(borChainID, end) {
 		return false, errors.New("blocks not found locally")
 	}
 
 	// Compare RootHash
-	root, err := GetHeaders(start, end)
+	root, err := GetHeaders(borChainID, start, end)
 	if err != nil {
 		return false, err
 	}
@@ -39,10 +39,14 @@ func ValidateCheckpoint(start uint64, end uint64, rootHash hmTypes.HeimdallHash)
 	return false, nil
 }
 
-// CheckIfBlocksExist - check if latest block number is greater than end block
-func CheckIfBlocksExist(end uint64) bool {
+// CheckIfBlocksExist - check if latest block number of bor chain is greater than end block
+func CheckIfBlocksExist(borChainID string, end uint64) bool {
 	// Get Latest block number.
-	rpcClient := helper.GetMaticRPCClient()
+	rpcClient := helper.GetChildChainRPCClient(borChainID)
+	if rpcClient == nil {
+		return false
+	}
+
 	var latestBlock *types.Header
 
 	err := rpcClient.Call(&latestBlock, "eth_getBlockByNumber", "latest", false)
@@ -57,8 +61,73 @@ func CheckIfBlocksExist(end uint64) bool {
 	return true
 }
 
-func GetHeaders(start uint64, end uint64) ([]byte, error) {
-	rpcClient := helper.GetMaticRPCClient()
+// GetHeaders returns root hash of block headers tree of bor chain from start to end
+func GetHeaders(borChainID string, start uint64, end uint64) ([]byte, error) {
+	headers, err := getHeaderLeaves(borChainID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	tree, err := generateHeaderTree(headers)
+	if err != nil {
+		return nil, err
+	}
+
+	return tree.Root().Hash, nil
+}
+
+// GetBlockProof returns leaf, root hash and merkle proof of block in block headers tree of bor chain from start to end
+func GetBlockProof(borChainID string, start uint64, end uint64, blockNumber uint64) (leaf []byte, root []byte, proof []byte, err error) {
+	if blockNumber < start || blockNumber > end {
+		return nil, nil, nil, errors.New("block is not in range")
+	}
+
+	headers, err := getHeaderLeaves(borChainID, start, end)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	root, proof, err = GetMerkleProof(headers, blockNumber-start)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return headers[blockNumber-start][:], root, proof, nil
+}
+
+// GetMerkleProof returns root hash and sibling path (from leaf to root) of leaf at index.
+// Proof is concatenation of 32 byte siblings as expected by RootChain contract.
+func GetMerkleProof(leaves [][32]byte, index uint64) (root []byte, proof []byte, err error) {
+	if index >= uint64(len(leaves)) {
+		return nil, nil, errors.New("leaf index out of range")
+	}
+
+	// pad leaves to next power of two
+	if expectedLength := nextPowerOfTwo(uint64(len(leaves))); expectedLength != uint64(len(leaves)) {
+		leaves = append(leaves, make([][32]byte, expectedLength-uint64(len(leaves)))...)
+	}
+
+	tree, err := generateHeaderTree(leaves)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// walk from leaves to root (excluding root level)
+	for h := tree.Height(); h > 1; h-- {
+		nodes := tree.GetNodesAtHeight(h)
+		proof = append(proof, nodes[index^1].Hash...)
+		index = index / 2
+	}
+
+	return tree.Root().Hash, proof, nil
+}
+
+// getHeaderLeaves fetches block headers from start to end and returns tree leaves, padded to next power of two
+func getHeaderLeaves(borChainID string, start uint64, end uint64) ([][32]byte, error) {
+	rpcClient := helper.GetChildChainRPCClient(borChainID)
+	if rpcClient == nil {
+		return nil, errors.New("unknown bor chain")
+	}
 
 	if start > end {
 		return nil, errors.New("start is greater than end")
@@ -105,12 +174,17 @@ func GetHeaders(start uint64, end uint64) ([]byte, error) {
 		headers[i] = arr
 	}
 
+	return headers, nil
+}
+
+// generateHeaderTree generates keccak merkle tree from leaves
+func generateHeaderTree(leaves [][32]byte) (*merkle.Tree, error) {
 	tree := merkle.NewTreeWithOpts(merkle.TreeOptions{EnableHashSorting: false, DisableHashLeaves: true})
-	if err := tree.Generate(convert(headers), sha3.NewLegacyKeccak256()); err != nil {
+	if err := tree.Generate(convert(leaves), sha3.NewLegacyKeccak256()); err != nil {
 		return nil, err
 	}
 
-	return tree.Root().Hash, nil
+	return &tree, nil
 }
 
 // GetAccountRootHash returns roothash of Validator Account State Tree
@@ -231,7 +305,7 @@ func nextPowerOfTwo(n uint64) uint64 {
 
 // spins go-routines to fetch batch elements to allow creation of large merkle trees
 func fetchBatchElements(rpcClient *rpc.Client, elements []rpc.BatchElem) (err error) {
-	var batchLength = int(helper.GetConfig().AvgCheckpointLength)
+	var batchLength = int(DefaultAvgCheckpointLength)
 	// group
 	var g errgroup.Group
 
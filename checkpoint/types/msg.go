@@ -2,7 +2,6 @@ package types
 
 import (
 	"bytes"
-	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -24,6 +23,7 @@ type MsgCheckpoint struct {
 	EndBlock        uint64                `json:"endBlock"`
 	RootHash        types.HeimdallHash    `json:"rootHash"`
 	AccountRootHash types.HeimdallHash    `json:"accountRootHash"`
+	BorChainID      string                `json:"borChainId"`
 	TimeStamp       uint64                `json:"timestamp"`
 }
 
@@ -34,6 +34,7 @@ func NewMsgCheckpointBlock(
 	endBlock uint64,
 	roothash types.HeimdallHash,
 	accountRootHash types.HeimdallHash,
+	borChainID string,
 	timestamp uint64,
 ) MsgCheckpoint {
 	return MsgCheckpoint{
@@ -42,6 +43,7 @@ func NewMsgCheckpointBlock(
 		EndBlock:        endBlock,
 		RootHash:        roothash,
 		AccountRootHash: accountRootHash,
+		BorChainID:      borChainID,
 		TimeStamp:       timestamp,
 	}
 }
@@ -68,6 +70,11 @@ func (msg MsgCheckpoint) GetSignBytes() []byte {
 	return sdk.MustSortJSON(b)
 }
 
+// IsSideTxMsg marks checkpoint as side tx, root hash is verified against Bor chain by validators
+func (msg MsgCheckpoint) IsSideTxMsg() bool {
+	return true
+}
+
 func (msg MsgCheckpoint) ValidateBasic() sdk.Error {
 	if bytes.Equal(msg.RootHash.Bytes(), helper.ZeroHash.Bytes()) {
 		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid rootHash %v", msg.RootHash.String())
@@ -81,6 +88,10 @@ func (msg MsgCheckpoint) ValidateBasic() sdk.Error {
 		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid startBlock %v or/and endBlock %v", msg.StartBlock, msg.EndBlock)
 	}
 
+	if msg.BorChainID == "" {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid bor chain id %v", msg.BorChainID)
+	}
+
 	return nil
 }
 
@@ -91,19 +102,43 @@ func (msg MsgCheckpoint) ValidateBasic() sdk.Error {
 var _ sdk.Msg = &MsgCheckpointAck{}
 
 // MsgCheckpointAck Add mainchain commit transaction hash to MsgCheckpointAck
+// Header block details are the ones submitted on root chain, which are verified by validators
 type MsgCheckpointAck struct {
 	From        types.HeimdallAddress `json:"from"`
 	HeaderBlock uint64                `json:"headerBlock"`
+	Proposer    types.HeimdallAddress `json:"proposer"`
+	StartBlock  uint64                `json:"startBlock"`
+	EndBlock    uint64                `json:"endBlock"`
+	RootHash    types.HeimdallHash    `json:"rootHash"`
+	BorChainID  string                `json:"borChainId"`
 	TxHash      types.HeimdallHash    `json:"tx_hash"`
 	LogIndex    uint64                `json:"log_index"`
+	BlockNumber uint64                `json:"block_number"`
 }
 
-func NewMsgCheckpointAck(from types.HeimdallAddress, headerBlock uint64, txHash types.HeimdallHash, logIndex uint64) MsgCheckpointAck {
+func NewMsgCheckpointAck(
+	from types.HeimdallAddress,
+	headerBlock uint64,
+	proposer types.HeimdallAddress,
+	startBlock uint64,
+	endBlock uint64,
+	rootHash types.HeimdallHash,
+	borChainID string,
+	txHash types.HeimdallHash,
+	logIndex uint64,
+	blockNumber uint64,
+) MsgCheckpointAck {
 	return MsgCheckpointAck{
 		From:        from,
 		HeaderBlock: headerBlock,
+		Proposer:    proposer,
+		StartBlock:  startBlock,
+		EndBlock:    endBlock,
+		RootHash:    rootHash,
+		BorChainID:  borChainID,
 		TxHash:      txHash,
 		LogIndex:    logIndex,
+		BlockNumber: blockNumber,
 	}
 }
 
@@ -127,16 +162,30 @@ func (msg MsgCheckpointAck) GetSignBytes() []byte {
 	return sdk.MustSortJSON(b)
 }
 
+// IsSideTxMsg marks checkpoint ack as side tx, header block is verified against root chain by validators
+func (msg MsgCheckpointAck) IsSideTxMsg() bool {
+	return true
+}
+
 func (msg MsgCheckpointAck) ValidateBasic() sdk.Error {
 	if msg.From.Empty() {
 		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid from %v", msg.From.String())
 	}
 
-	childBlockInterval := helper.GetConfig().ChildBlockInterval
-	if msg.HeaderBlock > 0 && msg.HeaderBlock%childBlockInterval != 0 {
-		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid header block %d", msg.HeaderBlock)
+	if bytes.Equal(msg.RootHash.Bytes(), helper.ZeroHash.Bytes()) {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid rootHash %v", msg.RootHash.String())
+	}
+
+	if msg.StartBlock >= msg.EndBlock {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid startBlock %v or/and endBlock %v", msg.StartBlock, msg.EndBlock)
+	}
+
+	if msg.BorChainID == "" {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid bor chain id %v", msg.BorChainID)
 	}
 
+	// header block is checked against child block interval param in handler
+
 	return nil
 }
 
@@ -189,7 +238,8 @@ func (msg MsgCheckpointNoAck) GetSignBytes() []byte {
 }
 
 func (msg MsgCheckpointNoAck) ValidateBasic() sdk.Error {
-	if msg.TimeStamp == 0 || msg.TimeStamp > uint64(time.Now().UTC().Unix()) {
+	// timestamp is checked against block time in handler
+	if msg.TimeStamp == 0 {
 		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid timestamp %d", msg.TimeStamp)
 	}
 
@@ -0,0 +1,139 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/x/params/subspace"
+)
+
+// Default parameter values
+const (
+	DefaultChildBlockInterval   uint64        = 10000              // difference between 2 indexes of header blocks
+	DefaultAvgCheckpointLength  uint64        = 256                // average number of blocks checkpoint would contain
+	DefaultMaxCheckpointLength  uint64        = 1024               // max blocks in one checkpoint
+	DefaultCheckpointBufferTime time.Duration = 1000 * time.Second // time checkpoint is allowed to stay in buffer (1000 seconds ~ 17 mins)
+	DefaultNoACKWaitTime        time.Duration = 1800 * time.Second // time ack service waits to clear buffer and elect new proposer (1800 seconds ~ 30 mins)
+	DefaultConfirmationBlocks   uint64        = 6                  // number of main chain blocks for confirmation
+)
+
+// Parameter keys
+var (
+	KeyChildBlockInterval   = []byte("ChildBlockInterval")
+	KeyAvgCheckpointLength  = []byte("AvgCheckpointLength")
+	KeyMaxCheckpointLength  = []byte("MaxCheckpointLength")
+	KeyCheckpointBufferTime = []byte("CheckpointBufferTime")
+	KeyNoACKWaitTime        = []byte("NoACKWaitTime")
+	KeyConfirmationBlocks   = []byte("ConfirmationBlocks")
+)
+
+var _ subspace.ParamSet = &Params{}
+
+// Params defines the parameters for the checkpoint module.
+type Params struct {
+	ChildBlockInterval   uint64        `json:"child_chain_block_interval" yaml:"child_chain_block_interval"`
+	AvgCheckpointLength  uint64        `json:"avg_checkpoint_length" yaml:"avg_checkpoint_length"`
+	MaxCheckpointLength  uint64        `json:"max_checkpoint_length" yaml:"max_checkpoint_length"`
+	CheckpointBufferTime time.Duration `json:"checkpoint_buffer_time" yaml:"checkpoint_buffer_time"`
+	NoACKWaitTime        time.Duration `json:"no_ack_wait_time" yaml:"no_ack_wait_time"`
+	ConfirmationBlocks   uint64        `json:"confirmation_blocks" yaml:"confirmation_blocks"`
+}
+
+// NewParams creates a new Params object
+func NewParams(
+	childBlockInterval uint64,
+	avgCheckpointLength uint64,
+	maxCheckpointLength uint64,
+	checkpointBufferTime time.Duration,
+	noACKWaitTime time.Duration,
+	confirmationBlocks uint64,
+) Params {
+	return Params{
+		ChildBlockInterval:   childBlockInterval,
+		AvgCheckpointLength:  avgCheckpointLength,
+		MaxCheckpointLength:  maxCheckpointLength,
+		CheckpointBufferTime: checkpointBufferTime,
+		NoACKWaitTime:        noACKWaitTime,
+		ConfirmationBlocks:   confirmationBlocks,
+	}
+}
+
+// ParamKeyTable for checkpoint module
+func ParamKeyTable() subspace.KeyTable {
+	return subspace.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+// pairs of checkpoint module's parameters.
+// nolint
+func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
+	return subspace.ParamSetPairs{
+		{Key: KeyChildBlockInterval, Value: &p.ChildBlockInterval},
+		{Key: KeyAvgCheckpointLength, Value: &p.AvgCheckpointLength},
+		{Key: KeyMaxCheckpointLength, Value: &p.MaxCheckpointLength},
+		{Key: KeyCheckpointBufferTime, Value: &p.CheckpointBufferTime},
+		{Key: KeyNoACKWaitTime, Value: &p.NoACKWaitTime},
+		{Key: KeyConfirmationBlocks, Value: &p.ConfirmationBlocks},
+	}
+}
+
+// Equal returns a boolean determining if two Params types are identical.
+func (p Params) Equal(p2 Params) bool {
+	bz1 := ModuleCdc.MustMarshalBinaryLengthPrefixed(&p)
+	bz2 := ModuleCdc.MustMarshalBinaryLengthPrefixed(&p2)
+	return bytes.Equal(bz1, bz2)
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return NewParams(
+		DefaultChildBlockInterval,
+		DefaultAvgCheckpointLength,
+		DefaultMaxCheckpointLength,
+		DefaultCheckpointBufferTime,
+		DefaultNoACKWaitTime,
+		DefaultConfirmationBlocks,
+	)
+}
+
+// String implements the stringer interface.
+func (p Params) String() string {
+	var sb strings.Builder
+	sb.WriteString("Params: \n")
+	sb.WriteString(fmt.Sprintf("ChildBlockInterval: %d\n", p.ChildBlockInterval))
+	sb.WriteString(fmt.Sprintf("AvgCheckpointLength: %d\n", p.AvgCheckpointLength))
+	sb.WriteString(fmt.Sprintf("MaxCheckpointLength: %d\n", p.MaxCheckpointLength))
+	sb.WriteString(fmt.Sprintf("CheckpointBufferTime: %s\n", p.CheckpointBufferTime))
+	sb.WriteString(fmt.Sprintf("NoACKWaitTime: %s\n", p.NoACKWaitTime))
+	sb.WriteString(fmt.Sprintf("ConfirmationBlocks: %d\n", p.ConfirmationBlocks))
+	return sb.String()
+}
+
+// Validate checks that the parameters have valid values.
+func (p Params) Validate() error {
+	if p.ChildBlockInterval == 0 {
+		return errors.New("child block interval should be non-zero")
+	}
+
+	if p.AvgCheckpointLength == 0 || p.MaxCheckpointLength < p.AvgCheckpointLength {
+		return fmt.Errorf("invalid checkpoint length: avg %d, max %d", p.AvgCheckpointLength, p.MaxCheckpointLength)
+	}
+
+	if p.CheckpointBufferTime <= 0 {
+		return fmt.Errorf("invalid checkpoint buffer time: %s", p.CheckpointBufferTime)
+	}
+
+	if p.NoACKWaitTime <= 0 {
+		return fmt.Errorf("invalid no-ack wait time: %s", p.NoACKWaitTime)
+	}
+
+	// confirmation depth protects every side handler reading root chain against reorgs
+	if p.ConfirmationBlocks == 0 {
+		return errors.New("confirmation blocks should be non-zero")
+	}
+
+	return nil
+}
@@ -1,5 +1,9 @@
 package types
 
+import (
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
 // query endpoints supported by the auth Querier
 const (
 	QueryAckCount         = "ack-count"
@@ -7,14 +11,90 @@ const (
 	QueryCheckpointBuffer = "checkpoint-buffer"
 	QueryLastNoAck        = "last-no-ack"
 	QueryCheckpointList   = "checkpoint-list"
+	QueryParams           = "params"
+	QueryProof            = "proof"
+	QueryProposerStats    = "proposer-stats"
+	QueryBorChains        = "bor-chains"
+	QuerySubmitCheckpoint = "submit-checkpoint"
 )
 
+// QueryBorChainParams defines the params for querying state of bor chain.
+// Empty bor chain id refers to primary bor chain.
+type QueryBorChainParams struct {
+	BorChainID string
+}
+
+// NewQueryBorChainParams creates a new instance of QueryBorChainParams.
+func NewQueryBorChainParams(borChainID string) QueryBorChainParams {
+	return QueryBorChainParams{BorChainID: borChainID}
+}
+
 // QueryCheckpointParams defines the params for querying accounts.
 type QueryCheckpointParams struct {
+	BorChainID  string
 	HeaderIndex uint64
 }
 
 // NewQueryCheckpointParams creates a new instance of QueryCheckpointHeaderIndex.
-func NewQueryCheckpointParams(headerIndex uint64) QueryCheckpointParams {
-	return QueryCheckpointParams{HeaderIndex: headerIndex}
+func NewQueryCheckpointParams(borChainID string, headerIndex uint64) QueryCheckpointParams {
+	return QueryCheckpointParams{BorChainID: borChainID, HeaderIndex: headerIndex}
+}
+
+// QueryCheckpointListParams defines the params for querying checkpoint list.
+type QueryCheckpointListParams struct {
+	BorChainID string
+	Page       uint64
+	Limit      uint64
+}
+
+// NewQueryCheckpointListParams creates a new instance of QueryCheckpointListParams.
+func NewQueryCheckpointListParams(borChainID string, page uint64, limit uint64) QueryCheckpointListParams {
+	return QueryCheckpointListParams{BorChainID: borChainID, Page: page, Limit: limit}
+}
+
+// QueryBlockProofParams defines the params for querying block proof.
+type QueryBlockProofParams struct {
+	BorChainID  string
+	BlockNumber uint64
+}
+
+// NewQueryBlockProofParams creates a new instance of QueryBlockProofParams.
+func NewQueryBlockProofParams(borChainID string, blockNumber uint64) QueryBlockProofParams {
+	return QueryBlockProofParams{BorChainID: borChainID, BlockNumber: blockNumber}
+}
+
+// QueryProposerStatsParams defines the params for querying proposer stats.
+type QueryProposerStatsParams struct {
+	ValidatorID hmTypes.ValidatorID
+}
+
+// NewQueryProposerStatsParams creates a new instance of QueryProposerStatsParams.
+func NewQueryProposerStatsParams(validatorID hmTypes.ValidatorID) QueryProposerStatsParams {
+	return QueryProposerStatsParams{ValidatorID: validatorID}
+}
+
+// BlockProof represents inclusion proof of child block in checkpoint
+type BlockProof struct {
+	BorChainID  string               `json:"bor_chain_id"`
+	HeaderIndex uint64               `json:"header_index"`
+	StartBlock  uint64               `json:"start_block"`
+	EndBlock    uint64               `json:"end_block"`
+	RootHash    hmTypes.HeimdallHash `json:"root_hash"`
+	BlockNumber uint64               `json:"block_number"`
+	Leaf        hmTypes.HeimdallHash `json:"leaf"`
+	Index       uint64               `json:"index"`
+	Proof       hmTypes.HexBytes     `json:"proof"`
+}
+
+// CheckpointSubmitData represents data required to submit checkpoint on root chain
+type CheckpointSubmitData struct {
+	BorChainID  string           `json:"bor_chain_id"`
+	HeaderIndex uint64           `json:"header_index"`
+	StartBlock  uint64           `json:"start_block"`
+	EndBlock    uint64           `json:"end_block"`
+	TxHash      string           `json:"tx_hash"`
+	Height      int64            `json:"height"`
+	Vote        hmTypes.HexBytes `json:"vote"`
+	Sigs        hmTypes.HexBytes `json:"sigs"`
+	TxData      hmTypes.HexBytes `json:"txData"`
 }
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"fmt"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// ProposerStats represents checkpoints acknowledged on root chain for a proposer.
+// Rewards are not tracked, root chain pays them to proposer of header block.
+type ProposerStats struct {
+	ValidatorID     hmTypes.ValidatorID `json:"validator_id" yaml:"validator_id"`
+	CheckpointCount uint64              `json:"checkpoint_count" yaml:"checkpoint_count"`
+	BlockCount      uint64              `json:"block_count" yaml:"block_count"`
+}
+
+// NewProposerStats creates new proposer stats
+func NewProposerStats(validatorID hmTypes.ValidatorID) ProposerStats {
+	return ProposerStats{
+		ValidatorID: validatorID,
+	}
+}
+
+// String returns human readable proposer stats
+func (s ProposerStats) String() string {
+	return fmt.Sprintf(
+		"ProposerStats{%v %v %v}",
+		s.ValidatorID,
+		s.CheckpointCount,
+		s.BlockCount,
+	)
+}
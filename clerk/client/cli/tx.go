@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	checkpointCli "github.com/maticnetwork/heimdall/checkpoint/client/cli"
 	clerkTypes "github.com/maticnetwork/heimdall/clerk/types"
 	hmClient "github.com/maticnetwork/heimdall/client"
 	"github.com/maticnetwork/heimdall/helper"
@@ -77,12 +79,53 @@ func CreateNewStateRecord(cdc *codec.Codec) *cobra.Command {
 				return fmt.Errorf("log index cannot be empty")
 			}
 
+			contractCallerObj, err := helper.NewContractCaller()
+			if err != nil {
+				return err
+			}
+
+			// confirmations required on main chain are checkpoint param
+			confirmationBlocks, err := checkpointCli.GetConfirmationBlocks(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			// get confirmed tx receipt
+			receipt, err := contractCallerObj.GetConfirmedTxReceipt(types.HexToHeimdallHash(txHashStr).EthHash(), confirmationBlocks)
+			if err != nil || receipt == nil {
+				return errors.New("Transaction is not confirmed yet. Please for sometime and try again")
+			}
+
+			// find state synced event
+			var contractAddress types.HeimdallAddress
+			var data types.HexBytes
+			found := false
+			for _, log := range receipt.Logs {
+				if uint64(log.Index) == logIndex {
+					event, err := contractCallerObj.EncodeStateSyncedEvent(log)
+					if err != nil {
+						return err
+					}
+
+					contractAddress = types.BytesToHeimdallAddress(event.ContractAddress.Bytes())
+					data = event.Data
+					found = true
+					break
+				}
+			}
+
+			if !found {
+				return fmt.Errorf("Invalid tx for state record")
+			}
+
 			// create new state record
 			msg := clerkTypes.NewMsgEventRecord(
 				proposer,
 				types.HexToHeimdallHash(txHashStr),
 				logIndex,
 				recordID,
+				contractAddress,
+				data,
 			)
 
 			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
@@ -24,9 +24,11 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 type AddRecordReq struct {
 	BaseReq rest.BaseReq `json:"base_req"`
 
-	TxHash   types.HeimdallHash `json:"tx_hash"`
-	LogIndex uint64             `json:"log_index"`
-	ID       uint64             `json:"id"`
+	TxHash          types.HeimdallHash    `json:"tx_hash"`
+	LogIndex        uint64                `json:"log_index"`
+	ID              uint64                `json:"id"`
+	ContractAddress types.HeimdallAddress `json:"contract_address"`
+	Data            types.HexBytes        `json:"data"`
 }
 
 func newEventRecordHandler(cliCtx context.CLIContext) http.HandlerFunc {
@@ -48,6 +50,8 @@ func newEventRecordHandler(cliCtx context.CLIContext) http.HandlerFunc {
 			req.TxHash,
 			req.LogIndex,
 			req.ID,
+			req.ContractAddress,
+			req.Data,
 		)
 
 		// send response
@@ -17,6 +17,11 @@ var (
 	StateRecordPrefixKey = []byte{0x11} // prefix key for when storing state
 )
 
+// ConfirmationRetriever retrieves confirmations required for root chain txs
+type ConfirmationRetriever interface {
+	GetConfirmationBlocks(ctx sdk.Context) uint64
+}
+
 // Keeper stores all related data
 type Keeper struct {
 	cdc *codec.Codec
@@ -26,6 +31,8 @@ type Keeper struct {
 	codespace sdk.CodespaceType
 	// param space
 	paramSpace params.Subspace
+	// confirmation retriever
+	confirmationRetriever ConfirmationRetriever
 }
 
 // NewKeeper create new keeper
@@ -34,12 +41,14 @@ func NewKeeper(
 	storeKey sdk.StoreKey,
 	paramSpace params.Subspace,
 	codespace sdk.CodespaceType,
+	confirmationRetriever ConfirmationRetriever,
 ) Keeper {
 	keeper := Keeper{
-		cdc:        cdc,
-		storeKey:   storeKey,
-		paramSpace: paramSpace,
-		codespace:  codespace,
+		cdc:                   cdc,
+		storeKey:              storeKey,
+		paramSpace:            paramSpace,
+		codespace:             codespace,
+		confirmationRetriever: confirmationRetriever,
 	}
 	return keeper
 }
@@ -54,6 +63,11 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", types.ModuleName)
 }
 
+// GetConfirmationBlocks returns number of main chain blocks required to confirm root chain tx
+func (k Keeper) GetConfirmationBlocks(ctx sdk.Context) uint64 {
+	return k.confirmationRetriever.GetConfirmationBlocks(ctx)
+}
+
 // SetEventRecord adds record to store
 func (k *Keeper) SetEventRecord(ctx sdk.Context, record types.EventRecord) error {
 	store := ctx.KVStore(k.storeKey)
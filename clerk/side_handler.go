@@ -0,0 +1,118 @@
+package clerk
+
+import (
+	"bytes"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/clerk/types"
+	"github.com/maticnetwork/heimdall/contracts/statesender"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// NewSideTxHandler returns side tx handler for clerk module
+func NewSideTxHandler(k Keeper, contractCaller helper.IContractCaller) hmTypes.SideTxHandler {
+	return func(ctx sdk.Context, msg sdk.Msg) hmTypes.SideTxResult {
+		switch msg := msg.(type) {
+		case types.MsgEventRecord:
+			return sideHandleMsgEventRecord(ctx, msg, k, contractCaller)
+		default:
+			return hmTypes.SideTxResultSkip
+		}
+	}
+}
+
+// NewPostTxHandler returns post tx handler for clerk module
+func NewPostTxHandler(k Keeper, contractCaller helper.IContractCaller) hmTypes.PostTxHandler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case types.MsgEventRecord:
+			return postHandleMsgEventRecord(ctx, msg, k)
+		default:
+			return sdk.ErrTxDecode("Invalid message in clerk module").Result()
+		}
+	}
+}
+
+// sideHandleMsgEventRecord verifies event record against state synced event on root chain
+func sideHandleMsgEventRecord(ctx sdk.Context, msg types.MsgEventRecord, k Keeper, contractCaller helper.IContractCaller) hmTypes.SideTxResult {
+	// get confirmed tx receipt
+	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), k.GetConfirmationBlocks(ctx))
+	if receipt == nil || err != nil {
+		return hmTypes.SideTxResultSkip
+	}
+
+	var parsedLog *statesender.StatesenderStateSynced
+	for _, log := range receipt.Logs {
+		if uint64(log.Index) == msg.LogIndex && len(log.Topics) == 3 {
+			p, err := contractCaller.EncodeStateSyncedEvent(log)
+			if err != nil {
+				break
+			}
+
+			if p != nil && msg.ID == p.Id.Uint64() {
+				parsedLog = p
+			}
+		}
+	}
+
+	if parsedLog == nil {
+		k.Logger(ctx).Error("State synced event not found", "id", msg.ID, "txHash", msg.TxHash, "logIndex", msg.LogIndex)
+		return hmTypes.SideTxResultNo
+	}
+
+	if !bytes.Equal(parsedLog.ContractAddress.Bytes(), msg.ContractAddress.Bytes()) {
+		k.Logger(ctx).Error("Contract address in message doesn't match with logs", "msgContract", msg.ContractAddress, "contractFromTx", parsedLog.ContractAddress)
+		return hmTypes.SideTxResultNo
+	}
+
+	if !bytes.Equal(parsedLog.Data, msg.Data) {
+		k.Logger(ctx).Error("Data in message doesn't match with logs", "id", msg.ID)
+		return hmTypes.SideTxResultNo
+	}
+
+	return hmTypes.SideTxResultYes
+}
+
+// postHandleMsgEventRecord saves event record once it is approved
+func postHandleMsgEventRecord(ctx sdk.Context, msg types.MsgEventRecord, k Keeper) sdk.Result {
+	// record might have been synced while side tx was pending
+	if exists := k.HasEventRecord(ctx, msg.ID); exists {
+		return types.ErrEventRecordAlreadySynced(k.Codespace()).Result()
+	}
+
+	// create event record
+	record := types.NewEventRecord(
+		msg.TxHash,
+		msg.LogIndex,
+		msg.ID,
+		msg.ContractAddress,
+		msg.Data,
+	)
+
+	// save event into state
+	if err := k.SetEventRecord(ctx, record); err != nil {
+		k.Logger(ctx).Error("Unable to update event record", "error", err, "id", msg.ID)
+		return types.ErrEventUpdate(k.Codespace()).Result()
+	}
+
+	// add events
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRecord,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyRecordID, strconv.FormatUint(msg.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyRecordContract, msg.ContractAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyRecordTxHash, msg.TxHash.String()),
+			sdk.NewAttribute(types.AttributeKeyRecordTxLogIndex, strconv.FormatUint(msg.LogIndex, 10)),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
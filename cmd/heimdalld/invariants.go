@@ -0,0 +1,57 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/maticnetwork/heimdall/app"
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+const flagDataDir = "data-dir"
+
+// checkInvariantsCmd runs all module invariants against state in data dir
+func checkInvariantsCmd(ctx *server.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check-invariants",
+		Short: "Run all module invariants against latest committed state in data dir",
+		Args:  cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(cli.HomeFlag))
+
+			dataDir := viper.GetString(flagDataDir)
+			if dataDir == "" {
+				dataDir = filepath.Join(config.RootDir, "data")
+			}
+
+			db, err := sdk.NewLevelDB("application", dataDir)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			// init heimdall config
+			helper.InitHeimdallConfig("")
+
+			happ := app.NewHeimdallApp(ctx.Logger, db)
+			broken := happ.CheckInvariants()
+			if len(broken) != 0 {
+				return fmt.Errorf("%d invariant(s) broken at height %d:\n%s", len(broken), happ.LastBlockHeight(), strings.Join(broken, ""))
+			}
+
+			fmt.Printf("All %d invariants hold at height %d\n", len(happ.InvariantRoutes()), happ.LastBlockHeight())
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagDataDir, "", "Data directory of heimdall (default <home>/data)")
+	return cmd
+}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/maticnetwork/heimdall/helper"
 	"github.com/maticnetwork/heimdall/types"
 )
 
@@ -27,6 +26,7 @@ const (
 	CodeOldCheckpoint            CodeType = 1509
 	CodeDisCountinuousCheckpoint CodeType = 1510
 	CodeNoCheckpointBuffer       CodeType = 1511
+	CodeUnknownBorChain          CodeType = 1512
 
 	CodeOldValidator       CodeType = 2500
 	CodeNoValidator        CodeType = 2501
@@ -40,11 +40,16 @@ const (
 	CodeNoConn             CodeType = 2509
 	CodeWaitFrConfirmation CodeType = 2510
 
-	CodeSpanNotCountinuous CodeType = 3501
-	CodeUnableToFreezeSet  CodeType = 3502
-	CodeSpanNotFound       CodeType = 3503
-	CodeValSetMisMatch     CodeType = 3504
-	CodeProducerMisMatch   CodeType = 3505
+	CodeSpanNotCountinuous         CodeType = 3501
+	CodeUnableToFreezeSet          CodeType = 3502
+	CodeSpanNotFound               CodeType = 3503
+	CodeValSetMisMatch             CodeType = 3504
+	CodeProducerMisMatch           CodeType = 3505
+	CodeInvalidBorChainID          CodeType = 3506
+	CodeInvalidSpanProposer        CodeType = 3507
+	CodeInvalidSpanStatus          CodeType = 3508
+	CodeInvalidProducerReplacement CodeType = 3509
+	CodeProducerReplacementVoted   CodeType = 3510
 
 	CodeFetchCheckpointSigners       CodeType = 4501
 	CodeErrComputeGenesisAccountRoot CodeType = 4503
@@ -87,8 +92,8 @@ func ErrNoConn(codespace sdk.CodespaceType) sdk.Error {
 	return newError(codespace, CodeNoConn, "Unable to connect to chain")
 }
 
-func ErrWaitForConfirmation(codespace sdk.CodespaceType) sdk.Error {
-	return newError(codespace, CodeWaitFrConfirmation, fmt.Sprintf("Please wait for %v confirmations before sending transaction", helper.GetConfig().ConfirmationBlocks))
+func ErrWaitForConfirmation(codespace sdk.CodespaceType, confirmations uint64) sdk.Error {
+	return newError(codespace, CodeWaitFrConfirmation, fmt.Sprintf("Please wait for %v confirmations before sending transaction", confirmations))
 }
 
 func ErrNoCheckpointFound(codespace sdk.CodespaceType) sdk.Error {
@@ -99,6 +104,10 @@ func ErrNoCheckpointBufferFound(codespace sdk.CodespaceType) sdk.Error {
 	return newError(codespace, CodeNoCheckpointBuffer, "Checkpoint buffer not found")
 }
 
+func ErrUnknownBorChain(codespace sdk.CodespaceType, borChainID string) sdk.Error {
+	return newError(codespace, CodeUnknownBorChain, fmt.Sprintf("Unknown bor chain %v", borChainID))
+}
+
 func ErrInvalidNoACK(codespace sdk.CodespaceType) sdk.Error {
 	return newError(codespace, CodeInvalidNoACK, "Invalid no-ack")
 }
@@ -171,6 +180,26 @@ func ErrUnableToFreezeValSet(codespace sdk.CodespaceType) sdk.Error {
 	return newError(codespace, CodeUnableToFreezeSet, "Unable to freeze validator set for next span")
 }
 
+func ErrInvalidBorChainID(codespace sdk.CodespaceType) sdk.Error {
+	return newError(codespace, CodeInvalidBorChainID, "Invalid bor chain id")
+}
+
+func ErrInvalidSpanProposer(codespace sdk.CodespaceType) sdk.Error {
+	return newError(codespace, CodeInvalidSpanProposer, "Span proposer is not current validator or producer of next span")
+}
+
+func ErrInvalidSpanStatus(codespace sdk.CodespaceType) sdk.Error {
+	return newError(codespace, CodeInvalidSpanStatus, "Span status can not be changed to reported status")
+}
+
+func ErrInvalidProducerReplacement(codespace sdk.CodespaceType) sdk.Error {
+	return newError(codespace, CodeInvalidProducerReplacement, "Invalid producer replacement")
+}
+
+func ErrProducerReplacementVoted(codespace sdk.CodespaceType) sdk.Error {
+	return newError(codespace, CodeProducerReplacementVoted, "Validator already voted for producer replacement")
+}
+
 func ErrValSetMisMatch(codespace sdk.CodespaceType) sdk.Error {
 	return newError(codespace, CodeValSetMisMatch, "Validator set mismatch")
 }
@@ -24,22 +24,30 @@ import (
 
 // IContractCaller represents contract caller
 type IContractCaller interface {
-	GetHeaderInfo(headerID uint64) (root common.Hash, start, end, createdAt uint64, proposer types.HeimdallAddress, err error)
+	GetHeaderInfo(borChainID string, headerID uint64) (root common.Hash, start, end, createdAt uint64, proposer types.HeimdallAddress, err error)
 	GetValidatorInfo(valID types.ValidatorID) (validator types.Validator, err error)
-	GetLastChildBlock() (uint64, error)
-	CurrentHeaderBlock() (uint64, error)
+	GetLastChildBlock(borChainID string) (uint64, error)
+	CurrentHeaderBlock(borChainID string) (uint64, error)
 	GetBalance(address common.Address) (*big.Int, error)
-	SendCheckpoint(voteSignBytes []byte, sigs []byte, txData []byte)
+	SendCheckpoint(borChainID string, voteSignBytes []byte, sigs []byte, txData []byte)
 	GetCheckpointSign(txHash common.Hash) ([]byte, []byte, []byte, error)
 	GetMainChainBlock(*big.Int) (*ethTypes.Header, error)
 	GetMaticChainBlock(*big.Int) (*ethTypes.Header, error)
-	IsTxConfirmed(common.Hash) bool
-	GetConfirmedTxReceipt(common.Hash) (*ethTypes.Receipt, error)
+	IsTxConfirmed(common.Hash, uint64) bool
+	GetConfirmedTxReceipt(common.Hash, uint64) (*ethTypes.Receipt, error)
 	GetBlockNumberFromTxHash(common.Hash) (*big.Int, error)
 	DecodeValidatorTopupFeesEvent(*ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoTopUpFee, error)
 	DecodeValidatorStakeUpdateEvent(*ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoStakeUpdate, error)
 	DecodeNewHeaderBlockEvent(*ethTypes.Receipt, uint64) (*rootchain.RootchainNewHeaderBlock, error)
 	DecodeSignerUpdateEvent(*ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoSignerChange, error)
+	DecodeValidatorJoinEvent(*ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoStaked, error)
+	DecodeValidatorExitEvent(*ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoUnstakeInit, error)
+	DecodeValidatorReStakedEvent(*ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoReStaked, error)
+	DecodeValidatorJailedEvent(*ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoJailed, error)
+	DecodeShareMintedEvent(*ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoShareMinted, error)
+	DecodeShareBurnedEvent(*ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoShareBurned, error)
+	DecodeStartAuctionEvent(*ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoStartAuction, error)
+	DecodeConfirmAuctionEvent(*ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoConfirmAuction, error)
 	GetMainTxReceipt(common.Hash) (*ethTypes.Receipt, error)
 	GetMaticTxReceipt(common.Hash) (*ethTypes.Receipt, error)
 	ApproveTokens(*big.Int) error
@@ -61,6 +69,7 @@ type ContractCaller struct {
 	MaticChainClient *ethclient.Client
 
 	RootChainInstance     *rootchain.Rootchain
+	RootChainInstances    map[string]*rootchain.Rootchain // rootchain instances by bor chain id
 	StakingInfoInstance   *stakinginfo.Stakinginfo
 	ValidatorSetInstance  *validatorset.Validatorset
 	StateSenderInstance   *statesender.Statesender
@@ -102,6 +111,14 @@ func NewContractCaller() (contractCallerObj ContractCaller, err error) {
 		return
 	}
 
+	// rootchain instances of all child chains
+	contractCallerObj.RootChainInstances = make(map[string]*rootchain.Rootchain)
+	for _, childChain := range GetChildChains() {
+		if contractCallerObj.RootChainInstances[childChain.BorChainID], err = rootchain.NewRootchain(common.HexToAddress(childChain.RootchainAddress), contractCallerObj.MainChainClient); err != nil {
+			return
+		}
+	}
+
 	if contractCallerObj.StakingInfoInstance, err = stakinginfo.NewStakinginfo(GetStakingInfoAddress(), contractCallerObj.MainChainClient); err != nil {
 		return
 	}
@@ -162,7 +179,7 @@ func NewContractCaller() (contractCallerObj ContractCaller, err error) {
 }
 
 // GetHeaderInfo get header info from header id
-func (c *ContractCaller) GetHeaderInfo(headerID uint64) (
+func (c *ContractCaller) GetHeaderInfo(borChainID string, headerID uint64) (
 	root common.Hash,
 	start uint64,
 	end uint64,
@@ -170,8 +187,13 @@ func (c *ContractCaller) GetHeaderInfo(headerID uint64) (
 	proposer types.HeimdallAddress,
 	err error,
 ) {
+	rootChainInstance, err := c.GetRootChainInstance(borChainID)
+	if err != nil {
+		return root, start, end, createdAt, proposer, err
+	}
+
 	// get header from rootchain
-	headerBlock, err := c.RootChainInstance.HeaderBlocks(nil, big.NewInt(0).SetUint64(headerID))
+	headerBlock, err := rootChainInstance.HeaderBlocks(nil, big.NewInt(0).SetUint64(headerID))
 	if err != nil {
 		Logger.Error("Unable to fetch header block from rootchain", "headerBlockIndex", headerID)
 		return root, start, end, createdAt, proposer, errors.New("Unable to fetch header block")
@@ -185,9 +207,24 @@ func (c *ContractCaller) GetHeaderInfo(headerID uint64) (
 		nil
 }
 
+// GetRootChainInstance returns rootchain instance of bor chain
+func (c *ContractCaller) GetRootChainInstance(borChainID string) (*rootchain.Rootchain, error) {
+	rootChainInstance, ok := c.RootChainInstances[borChainID]
+	if !ok {
+		Logger.Error("Rootchain contract not configured for bor chain", "borChainID", borChainID)
+		return nil, errors.New("Unknown bor chain")
+	}
+	return rootChainInstance, nil
+}
+
 // GetLastChildBlock fetch current child block
-func (c *ContractCaller) GetLastChildBlock() (uint64, error) {
-	GetLastChildBlock, err := c.RootChainInstance.GetLastChildBlock(nil)
+func (c *ContractCaller) GetLastChildBlock(borChainID string) (uint64, error) {
+	rootChainInstance, err := c.GetRootChainInstance(borChainID)
+	if err != nil {
+		return 0, err
+	}
+
+	GetLastChildBlock, err := rootChainInstance.GetLastChildBlock(nil)
 	if err != nil {
 		Logger.Error("Could not fetch current child block from rootchain contract", "Error", err)
 		return 0, err
@@ -196,8 +233,13 @@ func (c *ContractCaller) GetLastChildBlock() (uint64, error) {
 }
 
 // CurrentHeaderBlock fetches current header block
-func (c *ContractCaller) CurrentHeaderBlock() (uint64, error) {
-	currentHeaderBlock, err := c.RootChainInstance.CurrentHeaderBlock(nil)
+func (c *ContractCaller) CurrentHeaderBlock(borChainID string) (uint64, error) {
+	rootChainInstance, err := c.GetRootChainInstance(borChainID)
+	if err != nil {
+		return 0, err
+	}
+
+	currentHeaderBlock, err := rootChainInstance.CurrentHeaderBlock(nil)
 	if err != nil {
 		Logger.Error("Could not fetch current header block from rootchain contract", "Error", err)
 		return 0, err
@@ -225,6 +267,7 @@ func (c *ContractCaller) GetValidatorInfo(valID types.ValidatorID) (validator ty
 		return
 	}
 
+	amount := new(big.Int).Set(stakerDetails.Amount)
 	newAmount, err := GetPowerFromAmount(stakerDetails.Amount)
 	if err != nil {
 		return
@@ -234,6 +277,7 @@ func (c *ContractCaller) GetValidatorInfo(valID types.ValidatorID) (validator ty
 	validator = types.Validator{
 		ID:          valID,
 		VotingPower: newAmount.Int64(),
+		Amount:      types.NewIntFromBigInt(amount),
 		StartEpoch:  stakerDetails.ActivationEpoch.Uint64(),
 		EndEpoch:    stakerDetails.DeactivationEpoch.Uint64(),
 		Signer:      types.BytesToHeimdallAddress(stakerDetails.Signer.Bytes()),
@@ -282,9 +326,9 @@ func (c *ContractCaller) GetBlockNumberFromTxHash(tx common.Hash) (*big.Int, err
 }
 
 // IsTxConfirmed is tx confirmed
-func (c *ContractCaller) IsTxConfirmed(tx common.Hash) bool {
+func (c *ContractCaller) IsTxConfirmed(tx common.Hash, requiredConfirmations uint64) bool {
 	// get main tx receipt
-	receipt, err := c.GetConfirmedTxReceipt(tx)
+	receipt, err := c.GetConfirmedTxReceipt(tx, requiredConfirmations)
 	if receipt == nil || err != nil {
 		return false
 	}
@@ -292,8 +336,8 @@ func (c *ContractCaller) IsTxConfirmed(tx common.Hash) bool {
 	return true
 }
 
-// GetConfirmedTxReceipt returns confirmed tx receipt
-func (c *ContractCaller) GetConfirmedTxReceipt(tx common.Hash) (*ethTypes.Receipt, error) {
+// GetConfirmedTxReceipt returns tx receipt once tx has required confirmations on main chain
+func (c *ContractCaller) GetConfirmedTxReceipt(tx common.Hash, requiredConfirmations uint64) (*ethTypes.Receipt, error) {
 	// get main tx receipt
 	receipt, err := c.GetMainTxReceipt(tx)
 	if err != nil {
@@ -310,7 +354,7 @@ func (c *ContractCaller) GetConfirmedTxReceipt(tx common.Hash) (*ethTypes.Receip
 	Logger.Debug("Latest block on main chain obtained", "Block", latestBlk.Number.Uint64())
 
 	diff := latestBlk.Number.Uint64() - receipt.BlockNumber.Uint64()
-	if diff < GetConfig().ConfirmationBlocks {
+	if diff < requiredConfirmations {
 		return nil, errors.New("Not enough confirmations")
 	}
 
@@ -405,6 +449,182 @@ func (c *ContractCaller) DecodeSignerUpdateEvent(receipt *ethTypes.Receipt, logI
 	return event, nil
 }
 
+// DecodeValidatorJoinEvent represents validator staked event
+func (c *ContractCaller) DecodeValidatorJoinEvent(receipt *ethTypes.Receipt, logIndex uint64) (*stakinginfo.StakinginfoStaked, error) {
+	event := new(stakinginfo.StakinginfoStaked)
+
+	found := false
+	for _, vLog := range receipt.Logs {
+		if uint64(vLog.Index) == logIndex {
+			found = true
+			if err := UnpackLog(&c.StakingInfoABI, event, "Staked", vLog); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
+	if !found {
+		return nil, errors.New("Event not found")
+	}
+
+	return event, nil
+}
+
+// DecodeValidatorExitEvent represents validator unstake init event
+func (c *ContractCaller) DecodeValidatorExitEvent(receipt *ethTypes.Receipt, logIndex uint64) (*stakinginfo.StakinginfoUnstakeInit, error) {
+	event := new(stakinginfo.StakinginfoUnstakeInit)
+
+	found := false
+	for _, vLog := range receipt.Logs {
+		if uint64(vLog.Index) == logIndex {
+			found = true
+			if err := UnpackLog(&c.StakingInfoABI, event, "UnstakeInit", vLog); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
+	if !found {
+		return nil, errors.New("Event not found")
+	}
+
+	return event, nil
+}
+
+// DecodeValidatorReStakedEvent represents validator restake event
+func (c *ContractCaller) DecodeValidatorReStakedEvent(receipt *ethTypes.Receipt, logIndex uint64) (*stakinginfo.StakinginfoReStaked, error) {
+	event := new(stakinginfo.StakinginfoReStaked)
+
+	found := false
+	for _, vLog := range receipt.Logs {
+		if uint64(vLog.Index) == logIndex {
+			found = true
+			if err := UnpackLog(&c.StakingInfoABI, event, "ReStaked", vLog); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
+	if !found {
+		return nil, errors.New("Event not found")
+	}
+
+	return event, nil
+}
+
+// DecodeValidatorJailedEvent represents validator jailed event
+func (c *ContractCaller) DecodeValidatorJailedEvent(receipt *ethTypes.Receipt, logIndex uint64) (*stakinginfo.StakinginfoJailed, error) {
+	event := new(stakinginfo.StakinginfoJailed)
+
+	found := false
+	for _, vLog := range receipt.Logs {
+		if uint64(vLog.Index) == logIndex {
+			found = true
+			if err := UnpackLog(&c.StakingInfoABI, event, "Jailed", vLog); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
+	if !found {
+		return nil, errors.New("Event not found")
+	}
+
+	return event, nil
+}
+
+// DecodeShareMintedEvent represents delegator share minted event
+func (c *ContractCaller) DecodeShareMintedEvent(receipt *ethTypes.Receipt, logIndex uint64) (*stakinginfo.StakinginfoShareMinted, error) {
+	event := new(stakinginfo.StakinginfoShareMinted)
+
+	found := false
+	for _, vLog := range receipt.Logs {
+		if uint64(vLog.Index) == logIndex {
+			found = true
+			if err := UnpackLog(&c.StakingInfoABI, event, "ShareMinted", vLog); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
+	if !found {
+		return nil, errors.New("Event not found")
+	}
+
+	return event, nil
+}
+
+// DecodeShareBurnedEvent represents delegator share burned event
+func (c *ContractCaller) DecodeShareBurnedEvent(receipt *ethTypes.Receipt, logIndex uint64) (*stakinginfo.StakinginfoShareBurned, error) {
+	event := new(stakinginfo.StakinginfoShareBurned)
+
+	found := false
+	for _, vLog := range receipt.Logs {
+		if uint64(vLog.Index) == logIndex {
+			found = true
+			if err := UnpackLog(&c.StakingInfoABI, event, "ShareBurned", vLog); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
+	if !found {
+		return nil, errors.New("Event not found")
+	}
+
+	return event, nil
+}
+
+// DecodeStartAuctionEvent represents validator slot auction bid event
+func (c *ContractCaller) DecodeStartAuctionEvent(receipt *ethTypes.Receipt, logIndex uint64) (*stakinginfo.StakinginfoStartAuction, error) {
+	event := new(stakinginfo.StakinginfoStartAuction)
+
+	found := false
+	for _, vLog := range receipt.Logs {
+		if uint64(vLog.Index) == logIndex {
+			found = true
+			if err := UnpackLog(&c.StakingInfoABI, event, "StartAuction", vLog); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
+	if !found {
+		return nil, errors.New("Event not found")
+	}
+
+	return event, nil
+}
+
+// DecodeConfirmAuctionEvent represents validator slot auction confirm event
+func (c *ContractCaller) DecodeConfirmAuctionEvent(receipt *ethTypes.Receipt, logIndex uint64) (*stakinginfo.StakinginfoConfirmAuction, error) {
+	event := new(stakinginfo.StakinginfoConfirmAuction)
+
+	found := false
+	for _, vLog := range receipt.Logs {
+		if uint64(vLog.Index) == logIndex {
+			found = true
+			if err := UnpackLog(&c.StakingInfoABI, event, "ConfirmAuction", vLog); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
+	if !found {
+		return nil, errors.New("Event not found")
+	}
+
+	return event, nil
+}
+
 // CurrentAccountStateRoot get current account root from on chain
 func (c *ContractCaller) CurrentAccountStateRoot() ([32]byte, error) {
 	accountStateRoot, err := c.StakingInfoInstance.GetAccountStateRoot(nil)
@@ -58,19 +58,14 @@ const (
 	DefaultHeimdallServerURL = "http://0.0.0.0:1317"
 	DefaultTendermintNodeURL = "http://0.0.0.0:26657"
 
-	NoACKWaitTime        = 1800 * time.Second // Time ack service waits to clear buffer and elect new proposer (1800 seconds ~ 30 mins)
-	CheckpointBufferTime = 1000 * time.Second // Time checkpoint is allowed to stay in buffer (1000 seconds ~ 17 mins)
-
 	DefaultCheckpointerPollInterval = 5 * time.Minute
 	DefaultSyncerPollInterval       = 1 * time.Minute
 	DefaultNoACKPollInterval        = 1010 * time.Second
 	DefaultClerkPollingInterval     = 10 * time.Second
 	DefaultSpanPollingInterval      = 5 * time.Minute
+	DefaultSideTxPollInterval       = 30 * time.Second
 
-	DefaultCheckpointLength   = 256   // checkpoint number 	 with 0, so length = defaultCheckpointLength -1
-	MaxCheckpointLength       = 1024  // max blocks in one checkpoint
-	DefaultChildBlockInterval = 10000 // difference between 2 indexes of header blocks
-	ConfirmationBlocks        = 6
+	DefaultInvCheckPeriod = 0
 
 	DefaultBorChainID           = 15001
 	DefaultValidatorSetAddress  = "0000000000000000000000000000000000001000"
@@ -91,6 +86,13 @@ func init() {
 	Logger = logger.NewTMLogger(logger.NewSyncWriter(os.Stdout))
 }
 
+// ChildChainConfig represents config of additional bor chain which is checkpointed
+type ChildChainConfig struct {
+	BorChainID       string `mapstructure:"bor_chain_id"`       // bor chain id
+	BorRPCUrl        string `mapstructure:"bor_RPC_URL"`        // RPC endpoint for bor chain
+	RootchainAddress string `mapstructure:"rootchain_contract"` // Rootchain contract address on main chain for bor chain
+}
+
 // Configuration represents heimdall config
 type Configuration struct {
 	EthRPCUrl        string `mapstructure:"eth_RPC_URL"`        // RPC endpoint for main chain
@@ -110,24 +112,41 @@ type Configuration struct {
 	StakeManagerAddress  string `mapstructure:"stake_manager_contract"`
 	MaticTokenAddress    string `mapstructure:"matic_token"`
 
-	ChildBlockInterval uint64 `mapstructure:"child_chain_block_interval"` // Difference between header index of 2 child blocks submitted on main chain
-
 	// config related to bridge
 	CheckpointerPollInterval time.Duration `mapstructure:"checkpoint_poll_interval"` // Poll interval for checkpointer service to send new checkpoints or missing ACK
 	SyncerPollInterval       time.Duration `mapstructure:"syncer_poll_interval"`     // Poll interval for syncher service to sync for changes on main chain
 	NoACKPollInterval        time.Duration `mapstructure:"noack_poll_interval"`      // Poll interval for ack service to send no-ack in case of no checkpoints
 	ClerkPollingInterval     time.Duration `mapstructure:"clerk_polling_interval"`
 	SpanPollingInterval      time.Duration `mapstructure:"span_polling_interval"`
+	SideTxPollInterval       time.Duration `mapstructure:"sidetx_poll_interval"` // Poll interval for side tx service to vote on pending side txs
 
-	// checkpoint length related options
-	AvgCheckpointLength uint64 `mapstructure:"avg_checkpoint_length"` // Average number of blocks checkpoint would contain
-	MaxCheckpointLength uint64 `mapstructure:"max_checkpoint_length"` // Maximium number of blocks checkpoint would contain
+	InvCheckPeriod uint64 `mapstructure:"inv_check_period"` // Number of blocks between invariant checks in end block, 0 disables checks
 
-	// wait time related options
-	NoACKWaitTime        time.Duration `mapstructure:"no_ack_wait_time"`       // Time ack service waits to clear buffer and elect new proposer
-	CheckpointBufferTime time.Duration `mapstructure:"checkpoint_buffer_time"` // Time checkpoint is allowed to stay in buffer
+	ChildChains []ChildChainConfig `mapstructure:"child_chains"` // Additional bor chains which are checkpointed
+}
+
+// fileConfiguration represents heimdall config file, including deprecated keys of config files
+// generated by earlier versions
+type fileConfiguration struct {
+	Configuration `mapstructure:",squash"`
+
+	// Deprecated: moved to checkpoint params, values are ignored
+	ChildBlockInterval   uint64        `mapstructure:"child_chain_block_interval"`
+	AvgCheckpointLength  uint64        `mapstructure:"avg_checkpoint_length"`
+	MaxCheckpointLength  uint64        `mapstructure:"max_checkpoint_length"`
+	NoACKWaitTime        time.Duration `mapstructure:"no_ack_wait_time"`
+	CheckpointBufferTime time.Duration `mapstructure:"checkpoint_buffer_time"`
+	ConfirmationBlocks   uint64        `mapstructure:"confirmation_blocks"`
+}
 
-	ConfirmationBlocks uint64 `mapstructure:"confirmation_blocks"` // Number of blocks for confirmation
+// deprecatedConfigKeys are config keys which moved to checkpoint params
+var deprecatedConfigKeys = []string{
+	"child_chain_block_interval",
+	"avg_checkpoint_length",
+	"max_checkpoint_length",
+	"no_ack_wait_time",
+	"checkpoint_buffer_time",
+	"confirmation_blocks",
 }
 
 var conf Configuration
@@ -140,6 +159,9 @@ var mainRPCClient *rpc.Client
 var maticClient *ethclient.Client
 var maticRPCClient *rpc.Client
 
+// childChainRPCClients stores rpc clients for additional child chains
+var childChainRPCClients = make(map[string]*rpc.Client)
+
 // private key object
 var privObject secp256k1.PrivKeySecp256k1
 
@@ -194,7 +216,7 @@ func InitHeimdallConfigWith(homeDir string, heimdallConfigFilePath string) {
 		log.Fatal(err)
 	}
 
-	if err = heimdallViper.UnmarshalExact(&conf); err != nil {
+	if conf, err = unmarshalHeimdallConfig(heimdallViper); err != nil {
 		log.Fatalln("Unable to unmarshall config", "Error", err)
 	}
 
@@ -208,6 +230,16 @@ func InitHeimdallConfigWith(homeDir string, heimdallConfigFilePath string) {
 	}
 
 	maticClient = ethclient.NewClient(maticRPCClient)
+
+	// dial additional child chains
+	for _, childChain := range conf.ChildChains {
+		childChainRPCClient, err := rpc.Dial(childChain.BorRPCUrl)
+		if err != nil {
+			log.Fatalln("Unable to dial child chain", "URL=", childChain.BorRPCUrl, "chain=", childChain.BorChainID, "Error", err)
+		}
+		childChainRPCClients[childChain.BorChainID] = childChainRPCClient
+	}
+
 	// Loading genesis doc
 	genDoc, err := tmTypes.GenesisDocFromFile(filepath.Join(configDir, "genesis.json"))
 	if err != nil {
@@ -221,6 +253,22 @@ func InitHeimdallConfigWith(homeDir string, heimdallConfigFilePath string) {
 	cdc.MustUnmarshalBinaryBare(privObject.PubKey().Bytes(), &pubObject)
 }
 
+// unmarshalHeimdallConfig unmarshals heimdall config exactly, deprecated keys are ignored with a warning
+func unmarshalHeimdallConfig(heimdallViper *viper.Viper) (Configuration, error) {
+	var fileConf fileConfiguration
+	if err := heimdallViper.UnmarshalExact(&fileConf); err != nil {
+		return Configuration{}, err
+	}
+
+	for _, key := range deprecatedConfigKeys {
+		if heimdallViper.IsSet(key) {
+			Logger.Info("Deprecated config key is ignored, value is read from checkpoint params", "key", key)
+		}
+	}
+
+	return fileConf.Configuration, nil
+}
+
 // GetDefaultHeimdallConfig returns configration with default params
 func GetDefaultHeimdallConfig() Configuration {
 	return Configuration{
@@ -241,21 +289,14 @@ func GetDefaultHeimdallConfig() Configuration {
 		StateReceiverAddress: DefaultStateReceiverAddress,
 		ValidatorSetAddress:  DefaultValidatorSetAddress,
 
-		ChildBlockInterval: DefaultChildBlockInterval,
-
 		CheckpointerPollInterval: DefaultCheckpointerPollInterval,
 		SyncerPollInterval:       DefaultSyncerPollInterval,
 		NoACKPollInterval:        DefaultNoACKPollInterval,
 		ClerkPollingInterval:     DefaultClerkPollingInterval,
 		SpanPollingInterval:      DefaultSpanPollingInterval,
+		SideTxPollInterval:       DefaultSideTxPollInterval,
 
-		AvgCheckpointLength: DefaultCheckpointLength,
-		MaxCheckpointLength: MaxCheckpointLength,
-
-		NoACKWaitTime:        NoACKWaitTime,
-		CheckpointBufferTime: CheckpointBufferTime,
-
-		ConfirmationBlocks: ConfirmationBlocks,
+		InvCheckPeriod: DefaultInvCheckPeriod,
 	}
 }
 
@@ -264,6 +305,11 @@ func GetConfig() Configuration {
 	return conf
 }
 
+// SetTestConfig sets configuration object (used in tests)
+func SetTestConfig(_conf Configuration) {
+	conf = _conf
+}
+
 func GetGenesisDoc() tmTypes.GenesisDoc {
 	return GenesisDoc
 }
@@ -313,6 +359,32 @@ func GetStakeManagerAddress() common.Address {
 	return common.HexToAddress(GetConfig().StakeManagerAddress)
 }
 
+// GetChildChains returns configs of all checkpointed bor chains, default bor chain first
+func GetChildChains() []ChildChainConfig {
+	defaultChain := ChildChainConfig{
+		BorChainID:       GetConfig().BorChainID,
+		BorRPCUrl:        GetConfig().BorRPCUrl,
+		RootchainAddress: GetConfig().RootchainAddress,
+	}
+	return append([]ChildChainConfig{defaultChain}, GetConfig().ChildChains...)
+}
+
+// GetChildChainConfig returns config of bor chain
+func GetChildChainConfig(borChainID string) (ChildChainConfig, bool) {
+	for _, childChain := range GetChildChains() {
+		if childChain.BorChainID == borChainID {
+			return childChain, true
+		}
+	}
+	return ChildChainConfig{}, false
+}
+
+// GetChildChainRootChainAddress returns RootChain contract address for bor chain
+func GetChildChainRootChainAddress(borChainID string) common.Address {
+	childChain, _ := GetChildChainConfig(borChainID)
+	return common.HexToAddress(childChain.RootchainAddress)
+}
+
 // GetMaticTokenAddress
 func GetMaticTokenAddress() common.Address {
 	return common.HexToAddress(GetConfig().MaticTokenAddress)
@@ -342,6 +414,26 @@ func GetMaticRPCClient() *rpc.Client {
 	return maticRPCClient
 }
 
+// GetChildChainRPCClient returns RPC client of bor chain, nil if bor chain is not configured
+func GetChildChainRPCClient(borChainID string) *rpc.Client {
+	if borChainID == GetConfig().BorChainID {
+		return maticRPCClient
+	}
+	return childChainRPCClients[borChainID]
+}
+
+// GetChildChainClient returns eth client of bor chain, nil if bor chain is not configured
+func GetChildChainClient(borChainID string) *ethclient.Client {
+	if borChainID == GetConfig().BorChainID {
+		return maticClient
+	}
+
+	if rpcClient := childChainRPCClients[borChainID]; rpcClient != nil {
+		return ethclient.NewClient(rpcClient)
+	}
+	return nil
+}
+
 // GetPrivKey returns priv key object
 func GetPrivKey() secp256k1.PrivKeySecp256k1 {
 	return privObject
@@ -0,0 +1,50 @@
+package helper
+
+import (
+	"bytes"
+	"testing"
+	"text/template"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+// config keys written by earlier config template, now moved to checkpoint params
+const deprecatedConfigTemplate = `
+child_chain_block_interval = 10000
+avg_checkpoint_length = 256
+max_checkpoint_length = 1024
+no_ack_wait_time = "30m0s"
+checkpoint_buffer_time = "16m40s"
+confirmation_blocks = 6
+`
+
+// readTestConfig reads config rendered from config template and given extra content
+func readTestConfig(t *testing.T, extra string) *viper.Viper {
+	var buffer bytes.Buffer
+	require.NoError(t, template.Must(template.New("config").Parse(defaultConfigTemplate)).Execute(&buffer, GetDefaultHeimdallConfig()))
+	buffer.WriteString(extra)
+
+	heimdallViper := viper.New()
+	heimdallViper.SetConfigType("toml")
+	require.NoError(t, heimdallViper.ReadConfig(&buffer))
+	return heimdallViper
+}
+
+func TestUnmarshalHeimdallConfigWithDeprecatedKeys(t *testing.T) {
+	// config of earlier version still loads
+	config, err := unmarshalHeimdallConfig(readTestConfig(t, deprecatedConfigTemplate))
+	require.NoError(t, err)
+	require.Equal(t, DefaultBorRPCUrl, config.BorRPCUrl)
+	require.Equal(t, DefaultCheckpointerPollInterval, config.CheckpointerPollInterval)
+
+	// current config loads
+	config, err = unmarshalHeimdallConfig(readTestConfig(t, ""))
+	require.NoError(t, err)
+	require.Equal(t, 30*time.Second, config.SideTxPollInterval)
+
+	// unknown keys are still rejected
+	_, err = unmarshalHeimdallConfig(readTestConfig(t, "\nunknown_key = 1\n"))
+	require.Error(t, err)
+}
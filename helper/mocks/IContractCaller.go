@@ -12,7 +12,7 @@ import (
 
 	rootchain "github.com/maticnetwork/heimdall/contracts/rootchain"
 
-	stakemanager "github.com/maticnetwork/heimdall/contracts/stakemanager"
+	stakinginfo "github.com/maticnetwork/heimdall/contracts/stakinginfo"
 
 	statesender "github.com/maticnetwork/heimdall/contracts/statesender"
 
@@ -24,6 +24,20 @@ type IContractCaller struct {
 	mock.Mock
 }
 
+// ApproveTokens provides a mock function with given fields: _a0
+func (_m *IContractCaller) ApproveTokens(_a0 *big.Int) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*big.Int) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CurrentAccountStateRoot provides a mock function with given fields:
 func (_m *IContractCaller) CurrentAccountStateRoot() ([32]byte, error) {
 	ret := _m.Called()
@@ -47,20 +61,20 @@ func (_m *IContractCaller) CurrentAccountStateRoot() ([32]byte, error) {
 	return r0, r1
 }
 
-// CurrentHeaderBlock provides a mock function with given fields:
-func (_m *IContractCaller) CurrentHeaderBlock() (uint64, error) {
-	ret := _m.Called()
+// CurrentHeaderBlock provides a mock function with given fields: borChainID
+func (_m *IContractCaller) CurrentHeaderBlock(borChainID string) (uint64, error) {
+	ret := _m.Called(borChainID)
 
 	var r0 uint64
-	if rf, ok := ret.Get(0).(func() uint64); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(string) uint64); ok {
+		r0 = rf(borChainID)
 	} else {
 		r0 = ret.Get(0).(uint64)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(borChainID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -100,6 +114,29 @@ func (_m *IContractCaller) CurrentStateCounter() *big.Int {
 	return r0
 }
 
+// DecodeConfirmAuctionEvent provides a mock function with given fields: _a0, _a1
+func (_m *IContractCaller) DecodeConfirmAuctionEvent(_a0 *types.Receipt, _a1 uint64) (*stakinginfo.StakinginfoConfirmAuction, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *stakinginfo.StakinginfoConfirmAuction
+	if rf, ok := ret.Get(0).(func(*types.Receipt, uint64) *stakinginfo.StakinginfoConfirmAuction); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*stakinginfo.StakinginfoConfirmAuction)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.Receipt, uint64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DecodeNewHeaderBlockEvent provides a mock function with given fields: _a0, _a1
 func (_m *IContractCaller) DecodeNewHeaderBlockEvent(_a0 *types.Receipt, _a1 uint64) (*rootchain.RootchainNewHeaderBlock, error) {
 	ret := _m.Called(_a0, _a1)
@@ -123,16 +160,177 @@ func (_m *IContractCaller) DecodeNewHeaderBlockEvent(_a0 *types.Receipt, _a1 uin
 	return r0, r1
 }
 
+// DecodeShareBurnedEvent provides a mock function with given fields: _a0, _a1
+func (_m *IContractCaller) DecodeShareBurnedEvent(_a0 *types.Receipt, _a1 uint64) (*stakinginfo.StakinginfoShareBurned, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *stakinginfo.StakinginfoShareBurned
+	if rf, ok := ret.Get(0).(func(*types.Receipt, uint64) *stakinginfo.StakinginfoShareBurned); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*stakinginfo.StakinginfoShareBurned)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.Receipt, uint64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DecodeShareMintedEvent provides a mock function with given fields: _a0, _a1
+func (_m *IContractCaller) DecodeShareMintedEvent(_a0 *types.Receipt, _a1 uint64) (*stakinginfo.StakinginfoShareMinted, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *stakinginfo.StakinginfoShareMinted
+	if rf, ok := ret.Get(0).(func(*types.Receipt, uint64) *stakinginfo.StakinginfoShareMinted); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*stakinginfo.StakinginfoShareMinted)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.Receipt, uint64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DecodeSignerUpdateEvent provides a mock function with given fields: _a0, _a1
-func (_m *IContractCaller) DecodeSignerUpdateEvent(_a0 *types.Receipt, _a1 uint64) (*stakemanager.StakemanagerSignerChange, error) {
+func (_m *IContractCaller) DecodeSignerUpdateEvent(_a0 *types.Receipt, _a1 uint64) (*stakinginfo.StakinginfoSignerChange, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *stakinginfo.StakinginfoSignerChange
+	if rf, ok := ret.Get(0).(func(*types.Receipt, uint64) *stakinginfo.StakinginfoSignerChange); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*stakinginfo.StakinginfoSignerChange)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.Receipt, uint64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DecodeStartAuctionEvent provides a mock function with given fields: _a0, _a1
+func (_m *IContractCaller) DecodeStartAuctionEvent(_a0 *types.Receipt, _a1 uint64) (*stakinginfo.StakinginfoStartAuction, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *stakinginfo.StakinginfoStartAuction
+	if rf, ok := ret.Get(0).(func(*types.Receipt, uint64) *stakinginfo.StakinginfoStartAuction); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*stakinginfo.StakinginfoStartAuction)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.Receipt, uint64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DecodeValidatorExitEvent provides a mock function with given fields: _a0, _a1
+func (_m *IContractCaller) DecodeValidatorExitEvent(_a0 *types.Receipt, _a1 uint64) (*stakinginfo.StakinginfoUnstakeInit, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *stakinginfo.StakinginfoUnstakeInit
+	if rf, ok := ret.Get(0).(func(*types.Receipt, uint64) *stakinginfo.StakinginfoUnstakeInit); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*stakinginfo.StakinginfoUnstakeInit)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.Receipt, uint64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DecodeValidatorJailedEvent provides a mock function with given fields: _a0, _a1
+func (_m *IContractCaller) DecodeValidatorJailedEvent(_a0 *types.Receipt, _a1 uint64) (*stakinginfo.StakinginfoJailed, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *stakinginfo.StakinginfoJailed
+	if rf, ok := ret.Get(0).(func(*types.Receipt, uint64) *stakinginfo.StakinginfoJailed); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*stakinginfo.StakinginfoJailed)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.Receipt, uint64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DecodeValidatorJoinEvent provides a mock function with given fields: _a0, _a1
+func (_m *IContractCaller) DecodeValidatorJoinEvent(_a0 *types.Receipt, _a1 uint64) (*stakinginfo.StakinginfoStaked, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *stakemanager.StakemanagerSignerChange
-	if rf, ok := ret.Get(0).(func(*types.Receipt, uint64) *stakemanager.StakemanagerSignerChange); ok {
+	var r0 *stakinginfo.StakinginfoStaked
+	if rf, ok := ret.Get(0).(func(*types.Receipt, uint64) *stakinginfo.StakinginfoStaked); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*stakemanager.StakemanagerSignerChange)
+			r0 = ret.Get(0).(*stakinginfo.StakinginfoStaked)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*types.Receipt, uint64) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DecodeValidatorReStakedEvent provides a mock function with given fields: _a0, _a1
+func (_m *IContractCaller) DecodeValidatorReStakedEvent(_a0 *types.Receipt, _a1 uint64) (*stakinginfo.StakinginfoReStaked, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *stakinginfo.StakinginfoReStaked
+	if rf, ok := ret.Get(0).(func(*types.Receipt, uint64) *stakinginfo.StakinginfoReStaked); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*stakinginfo.StakinginfoReStaked)
 		}
 	}
 
@@ -147,15 +345,15 @@ func (_m *IContractCaller) DecodeSignerUpdateEvent(_a0 *types.Receipt, _a1 uint6
 }
 
 // DecodeValidatorStakeUpdateEvent provides a mock function with given fields: _a0, _a1
-func (_m *IContractCaller) DecodeValidatorStakeUpdateEvent(_a0 *types.Receipt, _a1 uint64) (*stakemanager.StakemanagerStakeUpdate, error) {
+func (_m *IContractCaller) DecodeValidatorStakeUpdateEvent(_a0 *types.Receipt, _a1 uint64) (*stakinginfo.StakinginfoStakeUpdate, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *stakemanager.StakemanagerStakeUpdate
-	if rf, ok := ret.Get(0).(func(*types.Receipt, uint64) *stakemanager.StakemanagerStakeUpdate); ok {
+	var r0 *stakinginfo.StakinginfoStakeUpdate
+	if rf, ok := ret.Get(0).(func(*types.Receipt, uint64) *stakinginfo.StakinginfoStakeUpdate); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*stakemanager.StakemanagerStakeUpdate)
+			r0 = ret.Get(0).(*stakinginfo.StakinginfoStakeUpdate)
 		}
 	}
 
@@ -170,15 +368,15 @@ func (_m *IContractCaller) DecodeValidatorStakeUpdateEvent(_a0 *types.Receipt, _
 }
 
 // DecodeValidatorTopupFeesEvent provides a mock function with given fields: _a0, _a1
-func (_m *IContractCaller) DecodeValidatorTopupFeesEvent(_a0 *types.Receipt, _a1 uint64) (*stakemanager.StakemanagerTopUpFee, error) {
+func (_m *IContractCaller) DecodeValidatorTopupFeesEvent(_a0 *types.Receipt, _a1 uint64) (*stakinginfo.StakinginfoTopUpFee, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *stakemanager.StakemanagerTopUpFee
-	if rf, ok := ret.Get(0).(func(*types.Receipt, uint64) *stakemanager.StakemanagerTopUpFee); ok {
+	var r0 *stakinginfo.StakinginfoTopUpFee
+	if rf, ok := ret.Get(0).(func(*types.Receipt, uint64) *stakinginfo.StakinginfoTopUpFee); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*stakemanager.StakemanagerTopUpFee)
+			r0 = ret.Get(0).(*stakinginfo.StakinginfoTopUpFee)
 		}
 	}
 
@@ -302,13 +500,13 @@ func (_m *IContractCaller) GetCheckpointSign(txHash common.Hash) ([]byte, []byte
 	return r0, r1, r2, r3
 }
 
-// GetConfirmedTxReceipt provides a mock function with given fields: _a0
-func (_m *IContractCaller) GetConfirmedTxReceipt(_a0 common.Hash) (*types.Receipt, error) {
-	ret := _m.Called(_a0)
+// GetConfirmedTxReceipt provides a mock function with given fields: _a0, _a1
+func (_m *IContractCaller) GetConfirmedTxReceipt(_a0 common.Hash, _a1 uint64) (*types.Receipt, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 *types.Receipt
-	if rf, ok := ret.Get(0).(func(common.Hash) *types.Receipt); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(common.Hash, uint64) *types.Receipt); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*types.Receipt)
@@ -316,8 +514,8 @@ func (_m *IContractCaller) GetConfirmedTxReceipt(_a0 common.Hash) (*types.Receip
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(common.Hash) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(common.Hash, uint64) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -325,13 +523,13 @@ func (_m *IContractCaller) GetConfirmedTxReceipt(_a0 common.Hash) (*types.Receip
 	return r0, r1
 }
 
-// GetHeaderInfo provides a mock function with given fields: headerID
-func (_m *IContractCaller) GetHeaderInfo(headerID uint64) (common.Hash, uint64, uint64, uint64, heimdalltypes.HeimdallAddress, error) {
-	ret := _m.Called(headerID)
+// GetHeaderInfo provides a mock function with given fields: borChainID, headerID
+func (_m *IContractCaller) GetHeaderInfo(borChainID string, headerID uint64) (common.Hash, uint64, uint64, uint64, heimdalltypes.HeimdallAddress, error) {
+	ret := _m.Called(borChainID, headerID)
 
 	var r0 common.Hash
-	if rf, ok := ret.Get(0).(func(uint64) common.Hash); ok {
-		r0 = rf(headerID)
+	if rf, ok := ret.Get(0).(func(string, uint64) common.Hash); ok {
+		r0 = rf(borChainID, headerID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(common.Hash)
@@ -339,29 +537,29 @@ func (_m *IContractCaller) GetHeaderInfo(headerID uint64) (common.Hash, uint64,
 	}
 
 	var r1 uint64
-	if rf, ok := ret.Get(1).(func(uint64) uint64); ok {
-		r1 = rf(headerID)
+	if rf, ok := ret.Get(1).(func(string, uint64) uint64); ok {
+		r1 = rf(borChainID, headerID)
 	} else {
 		r1 = ret.Get(1).(uint64)
 	}
 
 	var r2 uint64
-	if rf, ok := ret.Get(2).(func(uint64) uint64); ok {
-		r2 = rf(headerID)
+	if rf, ok := ret.Get(2).(func(string, uint64) uint64); ok {
+		r2 = rf(borChainID, headerID)
 	} else {
 		r2 = ret.Get(2).(uint64)
 	}
 
 	var r3 uint64
-	if rf, ok := ret.Get(3).(func(uint64) uint64); ok {
-		r3 = rf(headerID)
+	if rf, ok := ret.Get(3).(func(string, uint64) uint64); ok {
+		r3 = rf(borChainID, headerID)
 	} else {
 		r3 = ret.Get(3).(uint64)
 	}
 
 	var r4 heimdalltypes.HeimdallAddress
-	if rf, ok := ret.Get(4).(func(uint64) heimdalltypes.HeimdallAddress); ok {
-		r4 = rf(headerID)
+	if rf, ok := ret.Get(4).(func(string, uint64) heimdalltypes.HeimdallAddress); ok {
+		r4 = rf(borChainID, headerID)
 	} else {
 		if ret.Get(4) != nil {
 			r4 = ret.Get(4).(heimdalltypes.HeimdallAddress)
@@ -369,8 +567,8 @@ func (_m *IContractCaller) GetHeaderInfo(headerID uint64) (common.Hash, uint64,
 	}
 
 	var r5 error
-	if rf, ok := ret.Get(5).(func(uint64) error); ok {
-		r5 = rf(headerID)
+	if rf, ok := ret.Get(5).(func(string, uint64) error); ok {
+		r5 = rf(borChainID, headerID)
 	} else {
 		r5 = ret.Error(5)
 	}
@@ -378,20 +576,20 @@ func (_m *IContractCaller) GetHeaderInfo(headerID uint64) (common.Hash, uint64,
 	return r0, r1, r2, r3, r4, r5
 }
 
-// GetLastChildBlock provides a mock function with given fields:
-func (_m *IContractCaller) GetLastChildBlock() (uint64, error) {
-	ret := _m.Called()
+// GetLastChildBlock provides a mock function with given fields: borChainID
+func (_m *IContractCaller) GetLastChildBlock(borChainID string) (uint64, error) {
+	ret := _m.Called(borChainID)
 
 	var r0 uint64
-	if rf, ok := ret.Get(0).(func() uint64); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(string) uint64); ok {
+		r0 = rf(borChainID)
 	} else {
 		r0 = ret.Get(0).(uint64)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(borChainID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -553,13 +751,13 @@ func (_m *IContractCaller) GetValidatorInfo(valID heimdalltypes.ValidatorID) (he
 	return r0, r1
 }
 
-// IsTxConfirmed provides a mock function with given fields: _a0
-func (_m *IContractCaller) IsTxConfirmed(_a0 common.Hash) bool {
-	ret := _m.Called(_a0)
+// IsTxConfirmed provides a mock function with given fields: _a0, _a1
+func (_m *IContractCaller) IsTxConfirmed(_a0 common.Hash, _a1 uint64) bool {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 bool
-	if rf, ok := ret.Get(0).(func(common.Hash) bool); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(common.Hash, uint64) bool); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Get(0).(bool)
 	}
@@ -567,7 +765,21 @@ func (_m *IContractCaller) IsTxConfirmed(_a0 common.Hash) bool {
 	return r0
 }
 
-// SendCheckpoint provides a mock function with given fields: voteSignBytes, sigs, txData
-func (_m *IContractCaller) SendCheckpoint(voteSignBytes []byte, sigs []byte, txData []byte) {
-	_m.Called(voteSignBytes, sigs, txData)
+// SendCheckpoint provides a mock function with given fields: borChainID, voteSignBytes, sigs, txData
+func (_m *IContractCaller) SendCheckpoint(borChainID string, voteSignBytes []byte, sigs []byte, txData []byte) {
+	_m.Called(borChainID, voteSignBytes, sigs, txData)
+}
+
+// StakeFor provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *IContractCaller) StakeFor(_a0 common.Address, _a1 *big.Int, _a2 *big.Int, _a3 bool) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(common.Address, *big.Int, *big.Int, bool) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
 }
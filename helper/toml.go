@@ -53,7 +53,6 @@ validator_set_contract = "{{ .ValidatorSetAddress }}"
 
 
 ##### Intervals #####
-child_chain_block_interval = "{{ .ChildBlockInterval }}" 
 
 ## Bridge Poll Intervals 
 checkpoint_poll_interval = "{{ .CheckpointerPollInterval }}" 
@@ -61,20 +60,24 @@ syncer_poll_interval = "{{ .SyncerPollInterval }}"
 noack_poll_interval = "{{ .NoACKPollInterval }}"
 clerk_polling_interval = "{{ .ClerkPollingInterval }}" 
 span_polling_interval = "{{ .SpanPollingInterval }}" 
+sidetx_poll_interval = "{{ .SideTxPollInterval }}"
 
 
-##### Checkpoint Length Config #####
-avg_checkpoint_length = "{{ .AvgCheckpointLength }}"
-max_checkpoint_length = "{{ .MaxCheckpointLength }}"
+##### Invariants #####
 
-##### Timeout Config #####
+# Number of blocks between invariant checks in end block (node halts on broken invariant), 0 disables checks
+inv_check_period = "{{ .InvCheckPeriod }}"
 
-no_ack_wait_time = "{{ .NoACKWaitTime }}"
-checkpoint_buffer_time = "{{ .CheckpointBufferTime }}"
 
-##### Transaction Confirmations  #####
+##### Child Chains #####
 
-confirmation_blocks = "{{ .ConfirmationBlocks }}"
+# Additional bor chains which are checkpointed (bor chain id, RPC endpoint and rootchain contract on eth chain)
+{{ range .ChildChains }}
+[[child_chains]]
+bor_chain_id = "{{ .BorChainID }}"
+bor_RPC_URL = "{{ .BorRPCUrl }}"
+rootchain_contract = "{{ .RootchainAddress }}"
+{{ end }}
 
 `
 
@@ -58,9 +58,14 @@ func GenerateAuthObj(client *ethclient.Client, address common.Address, data []by
 
 // SendCheckpoint sends checkpoint to rootchain contract
 // todo return err
-func (c *ContractCaller) SendCheckpoint(voteSignBytes []byte, sigs []byte, txData []byte) {
+func (c *ContractCaller) SendCheckpoint(borChainID string, voteSignBytes []byte, sigs []byte, txData []byte) {
+	rootChainInstance, err := c.GetRootChainInstance(borChainID)
+	if err != nil {
+		return
+	}
+
 	var vote types.CanonicalRLPVote
-	err := rlp.DecodeBytes(voteSignBytes, &vote)
+	err = rlp.DecodeBytes(voteSignBytes, &vote)
 	if err != nil {
 		Logger.Error("Unable to decode vote while sending checkpoint", "vote", hex.EncodeToString(voteSignBytes), "sigs", hex.EncodeToString(sigs), "txData", hex.EncodeToString(txData))
 		return
@@ -72,7 +77,7 @@ func (c *ContractCaller) SendCheckpoint(voteSignBytes []byte, sigs []byte, txDat
 		return
 	}
 
-	rootChainAddress := GetRootChainAddress()
+	rootChainAddress := GetChildChainRootChainAddress(borChainID)
 	auth, err := GenerateAuthObj(GetMainClient(), rootChainAddress, data)
 	if err != nil {
 		Logger.Error("Unable to create auth object", "error", err)
@@ -85,7 +90,7 @@ func (c *ContractCaller) SendCheckpoint(voteSignBytes []byte, sigs []byte, txDat
 		"sigs", hex.EncodeToString(sigs),
 		"txData", hex.EncodeToString(txData))
 
-	tx, err := c.RootChainInstance.SubmitHeaderBlock(auth, voteSignBytes, sigs, txData)
+	tx, err := rootChainInstance.SubmitHeaderBlock(auth, voteSignBytes, sigs, txData)
 	if err != nil {
 		Logger.Error("Error while submitting checkpoint", "error", err)
 	} else {
@@ -648,13 +648,47 @@ func GetReceiptLogData(log *ethTypes.Log) []byte {
 // GetPowerFromAmount returns power from amount -- note that this will polute amount object
 func GetPowerFromAmount(amount *big.Int) (*big.Int, error) {
 	decimals18 := big.NewInt(10).Exp(big.NewInt(10), big.NewInt(18), nil)
-	if amount.Uint64() < decimals18.Uint64() {
+	if amount.Cmp(decimals18) < 0 {
 		return nil, errors.New("amount must be more than 1 token")
 	}
 
 	return amount.Div(amount, decimals18), nil
 }
 
+// GetPowerFromAmountWithDivisor returns voting power from amount rounded half up to nearest
+// multiple of divisor. Power of single validator is bounded by max total voting power, staking
+// keeper bounds total power of all validators so that proposer priority math doesn't overflow.
+func GetPowerFromAmountWithDivisor(amount *big.Int, divisor *big.Int) (int64, error) {
+	if divisor == nil || divisor.Sign() <= 0 {
+		return 0, errors.New("power divisor must be positive")
+	}
+
+	if amount == nil || amount.Sign() < 0 {
+		return 0, errors.New("amount must not be negative")
+	}
+
+	power, remainder := new(big.Int).QuoRem(amount, divisor, new(big.Int))
+	if new(big.Int).Lsh(remainder, 1).Cmp(divisor) >= 0 {
+		power.Add(power, big.NewInt(1))
+	}
+
+	if !power.IsInt64() || power.Int64() > types.MaxTotalVotingPower {
+		return 0, fmt.Errorf("voting power of amount %v exceeds max voting power %v", amount, types.MaxTotalVotingPower)
+	}
+
+	if power.Sign() == 0 {
+		return 0, errors.New("amount is too small for voting power")
+	}
+
+	return power.Int64(), nil
+}
+
+// GetAmountFromPower converts power to amount with 18 decimals
+func GetAmountFromPower(power int64) *big.Int {
+	decimals18 := big.NewInt(10).Exp(big.NewInt(10), big.NewInt(18), nil)
+	return new(big.Int).Mul(big.NewInt(power), decimals18)
+}
+
 // GetAmountFromString converts string to its big Int
 func GetAmountFromString(amount string) (*big.Int, error) {
 	amountInDecimals, ok := big.NewInt(0).SetString(amount, 10)
@@ -2,6 +2,7 @@ package helper
 
 import (
 	"encoding/hex"
+	"math/big"
 	"testing"
 
 	authTypes "github.com/maticnetwork/heimdall/auth/types"
@@ -41,3 +42,43 @@ func TestUnpackSigAndVotes(t *testing.T) {
 		require.Equal(t, signerAddresses[i], hex.EncodeToString(signerAddress), "Signer Address Doesn't match")
 	}
 }
+
+func TestGetPowerFromAmountWithDivisor(t *testing.T) {
+	divisor := big.NewInt(100)
+
+	// amount is rounded half up
+	power, err := GetPowerFromAmountWithDivisor(big.NewInt(149), divisor)
+	require.Nil(t, err)
+	require.Equal(t, int64(1), power)
+
+	power, err = GetPowerFromAmountWithDivisor(big.NewInt(150), divisor)
+	require.Nil(t, err)
+	require.Equal(t, int64(2), power)
+
+	// amount isn't modified
+	amount := GetAmountFromPower(7)
+	power, err = GetPowerFromAmountWithDivisor(amount, GetAmountFromPower(1))
+	require.Nil(t, err)
+	require.Equal(t, int64(7), power)
+	require.Equal(t, GetAmountFromPower(7), amount)
+
+	// power is bounded by max total voting power
+	power, err = GetPowerFromAmountWithDivisor(big.NewInt(types.MaxTotalVotingPower), big.NewInt(1))
+	require.Nil(t, err)
+	require.Equal(t, types.MaxTotalVotingPower, power)
+
+	_, err = GetPowerFromAmountWithDivisor(new(big.Int).Add(big.NewInt(types.MaxTotalVotingPower), big.NewInt(1)), big.NewInt(1))
+	require.NotNil(t, err, "Power above max total voting power should fail")
+
+	_, err = GetPowerFromAmountWithDivisor(new(big.Int).Lsh(big.NewInt(1), 200), big.NewInt(1))
+	require.NotNil(t, err, "Power above int64 should fail")
+
+	_, err = GetPowerFromAmountWithDivisor(big.NewInt(49), divisor)
+	require.NotNil(t, err, "Zero power should fail")
+
+	_, err = GetPowerFromAmountWithDivisor(big.NewInt(-100), divisor)
+	require.NotNil(t, err, "Negative amount should fail")
+
+	_, err = GetPowerFromAmountWithDivisor(big.NewInt(100), big.NewInt(0))
+	require.NotNil(t, err, "Zero divisor should fail")
+}
@@ -0,0 +1,7 @@
+package cli
+
+const (
+	FlagProposerAddress = "proposer"
+	FlagTxHash          = "tx-hash"
+	FlagVote            = "vote"
+)
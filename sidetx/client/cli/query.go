@@ -0,0 +1,124 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	hmClient "github.com/maticnetwork/heimdall/client"
+	"github.com/maticnetwork/heimdall/sidetx/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	// Group sidetx queries under a subcommand
+	queryCmds := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the sidetx module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       hmClient.ValidateCmd,
+	}
+
+	// sidetx query command
+	queryCmds.AddCommand(
+		client.GetCommands(
+			GetQueryParams(cdc),
+			GetPendingSideTxs(cdc),
+			GetPendingSideTx(cdc),
+		)...,
+	)
+
+	return queryCmds
+}
+
+// GetQueryParams implements the params query command.
+func GetQueryParams(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "show the current sidetx parameters information",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParams), nil)
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			if err := cliCtx.Codec.UnmarshalJSON(res, &params); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
+
+// GetPendingSideTxs get all side txs waiting for votes
+func GetPendingSideTxs(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "pending",
+		Args:  cobra.NoArgs,
+		Short: "show side txs waiting for validator votes",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryPendingSideTxs), nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
+
+// GetPendingSideTx get pending side tx by tx hash
+func GetPendingSideTx(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-tx",
+		Short: "show pending side tx with its votes",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			txHashStr := viper.GetString(FlagTxHash)
+			if txHashStr == "" {
+				return fmt.Errorf("tx hash cannot be empty")
+			}
+
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQuerySideTxParams(hmTypes.HexToHeimdallHash(txHashStr)))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(
+				fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryPendingSideTx),
+				queryParams,
+			)
+			if err != nil {
+				return err
+			}
+
+			if len(res) == 0 {
+				return errors.New("Pending side tx not found")
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagTxHash, "", "--tx-hash=<side-tx-hash>")
+	cmd.MarkFlagRequired(FlagTxHash)
+	return cmd
+}
@@ -0,0 +1,82 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	hmClient "github.com/maticnetwork/heimdall/client"
+	"github.com/maticnetwork/heimdall/helper"
+	sidetxTypes "github.com/maticnetwork/heimdall/sidetx/types"
+	"github.com/maticnetwork/heimdall/types"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        sidetxTypes.ModuleName,
+		Short:                      "Side tx transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       hmClient.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		client.PostCommands(
+			SendSideTxVote(cdc),
+		)...,
+	)
+	return txCmd
+}
+
+// SendSideTxVote send side tx vote transaction
+func SendSideTxVote(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote",
+		Short: "vote on pending side tx",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// get proposer
+			proposer := types.HexToHeimdallAddress(viper.GetString(FlagProposerAddress))
+			if proposer.Empty() {
+				proposer = helper.GetFromAddress(cliCtx)
+			}
+
+			// tx hash
+			txHashStr := viper.GetString(FlagTxHash)
+			if txHashStr == "" {
+				return fmt.Errorf("tx hash cannot be empty")
+			}
+
+			// vote
+			result, err := types.ParseSideTxResult(viper.GetString(FlagVote))
+			if err != nil {
+				return err
+			}
+
+			// create new side tx vote
+			msg := sidetxTypes.NewMsgSideTxVote(
+				proposer,
+				types.HexToHeimdallHash(txHashStr),
+				result,
+			)
+
+			// broadcast messages
+			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().StringP(FlagProposerAddress, "p", "", "--proposer=<proposer-address>")
+	cmd.Flags().String(FlagTxHash, "", "--tx-hash=<side-tx-hash>")
+	cmd.Flags().String(FlagVote, "", "--vote=<yes|no>")
+	cmd.MarkFlagRequired(FlagTxHash)
+	cmd.MarkFlagRequired(FlagVote)
+
+	return cmd
+}
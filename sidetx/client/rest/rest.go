@@ -0,0 +1,22 @@
+package rest
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/gorilla/mux"
+	tmLog "github.com/tendermint/tendermint/libs/log"
+
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+// RestLogger for sidetx module logger
+var RestLogger tmLog.Logger
+
+func init() {
+	RestLogger = helper.Logger.With("module", "sidetx/rest")
+}
+
+// RegisterRoutes registers sidetx-related REST handlers to a router
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	registerQueryRoutes(cliCtx, r)
+	registerTxRoutes(cliCtx, r)
+}
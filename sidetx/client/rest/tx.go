@@ -0,0 +1,60 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gorilla/mux"
+
+	restClient "github.com/maticnetwork/heimdall/client/rest"
+	sidetxTypes "github.com/maticnetwork/heimdall/sidetx/types"
+	"github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/types/rest"
+)
+
+func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc(
+		"/sidetx/vote",
+		newSideTxVoteHandler(cliCtx),
+	).Methods("POST")
+}
+
+// SideTxVoteReq side tx vote request object
+type SideTxVoteReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+
+	TxHash types.HeimdallHash `json:"tx_hash"`
+	Vote   string             `json:"vote"`
+}
+
+func newSideTxVoteHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// read req from request
+		var req SideTxVoteReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		result, err := types.ParseSideTxResult(req.Vote)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create new msg
+		msg := sidetxTypes.NewMsgSideTxVote(
+			types.HexToHeimdallAddress(req.BaseReq.From),
+			req.TxHash,
+			result,
+		)
+
+		// send response
+		restClient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
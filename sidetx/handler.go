@@ -0,0 +1,204 @@
+package sidetx
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	hmCommon "github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/sidetx/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// NewHandler creates new handler for handling messages for sidetx module
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case types.MsgSideTxVote:
+			return handleMsgSideTxVote(ctx, msg, k)
+		default:
+			return sdk.ErrTxDecode("Invalid message in sidetx module").Result()
+		}
+	}
+}
+
+// NewSideTxMsgHandler wraps module handler. Side tx messages which pass module handler
+// (state only checks) are stored as pending side txs until validators vote on them,
+// other messages are passed through. Pending side txs are keyed by tx hash, so a tx
+// can carry only one side tx msg.
+func NewSideTxMsgHandler(k Keeper, handler sdk.Handler) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		sideTxMsg, ok := msg.(hmTypes.SideTxMsg)
+		if !ok || !sideTxMsg.IsSideTxMsg() {
+			return handler(ctx, msg)
+		}
+
+		// side handlers must be registered for side tx msg
+		if k.GetSideHandlers(msg.Route()) == nil {
+			return types.ErrSideTxNoHandler(k.Codespace()).Result()
+		}
+
+		txHash := hmTypes.BytesToHeimdallHash(tmhash.Sum(ctx.TxBytes()))
+		if pendingTx, err := k.GetPendingSideTx(ctx, txHash); err == nil && pendingTx != nil {
+			// pending side tx stored in this block comes from earlier msg of same tx
+			if pendingTx.Height == ctx.BlockHeight() {
+				k.Logger(ctx).Error("Multiple side tx msgs in tx", "txHash", txHash.String(), "route", msg.Route(), "type", msg.Type())
+				return types.ErrSideTxMultipleMsgs(k.Codespace()).Result()
+			}
+			return hmCommon.ErrOldTx(k.Codespace()).Result()
+		}
+
+		// module handler only runs checks against current state
+		result := handler(ctx, msg)
+		if !result.IsOK() {
+			return result
+		}
+
+		// store pending side tx to wait for votes
+
+		if err := k.SetPendingSideTx(ctx, types.NewPendingSideTx(txHash, ctx.BlockHeight(), msg)); err != nil {
+			k.Logger(ctx).Error("Unable to store pending side tx", "error", err, "txHash", txHash.String())
+			return sdk.ErrInternal("Unable to store pending side tx").Result()
+		}
+
+		k.Logger(ctx).Debug("Side tx is waiting for votes", "txHash", txHash.String(), "route", msg.Route(), "type", msg.Type())
+
+		result.Events = result.Events.AppendEvent(
+			sdk.NewEvent(
+				types.EventTypeSideTx,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyTxHash, txHash.String()),
+				sdk.NewAttribute(types.AttributeKeyMsgRoute, msg.Route()),
+				sdk.NewAttribute(types.AttributeKeyMsgType, msg.Type()),
+			),
+		)
+
+		return result
+	}
+}
+
+// handleMsgSideTxVote records validator vote on pending side tx and applies or rejects
+// side tx once votes reach more than 2/3 voting power
+func handleMsgSideTxVote(ctx sdk.Context, msg types.MsgSideTxVote, k Keeper) sdk.Result {
+	k.Logger(ctx).Debug("Handling side tx vote", "txHash", msg.TxHash.String(), "from", msg.From.String(), "result", msg.Result.String())
+
+	// only validators in current validator set can vote
+	validatorSet := k.sk.GetValidatorSet(ctx)
+	_, validator := validatorSet.GetByAddress(msg.From.Bytes())
+	if validator == nil {
+		k.Logger(ctx).Error("Side tx vote from non-validator", "from", msg.From.String())
+		return hmCommon.ErrValIsNotCurrentVal(k.Codespace()).Result()
+	}
+
+	tx, err := k.GetPendingSideTx(ctx, msg.TxHash)
+	if err != nil || tx == nil {
+		k.Logger(ctx).Error("Pending side tx not found", "txHash", msg.TxHash.String(), "error", err)
+		return types.ErrSideTxNotFound(k.Codespace()).Result()
+	}
+
+	if tx.HasVoted(validator.ID) {
+		return types.ErrSideTxAlreadyVoted(k.Codespace()).Result()
+	}
+
+	tx.Votes = append(tx.Votes, types.SideTxVote{
+		ValidatorID: validator.ID,
+		Result:      msg.Result,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSideTxVote,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyTxHash, msg.TxHash.String()),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(validator.ID.Uint64(), 10)),
+			sdk.NewAttribute(types.AttributeKeyResult, msg.Result.String()),
+		),
+	)
+
+	yes, no, total := k.TallyVotes(ctx, *tx)
+	switch {
+	case yes*3 > total*2:
+		// more than 2/3 of voting power verified side tx
+		applySideTx(ctx, k, *tx)
+	case no*3 >= total:
+		// side tx can't get more than 2/3 of voting power anymore
+		k.RemovePendingSideTx(ctx, tx.TxHash)
+		k.Logger(ctx).Info("Side tx rejected by validators", "txHash", tx.TxHash.String(), "no", no, "total", total)
+		emitSideTxEvent(ctx, types.EventTypeSideTxRejected, *tx, "rejected by validators")
+	default:
+		if err := k.SetPendingSideTx(ctx, *tx); err != nil {
+			k.Logger(ctx).Error("Unable to store side tx vote", "error", err, "txHash", tx.TxHash.String())
+			return sdk.ErrInternal("Unable to store side tx vote").Result()
+		}
+	}
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// applySideTx runs post tx handler for approved side tx. State changes are discarded
+// if post tx handler fails (eg. state has changed since side tx was submitted).
+func applySideTx(ctx sdk.Context, k Keeper, tx types.PendingSideTx) {
+	k.RemovePendingSideTx(ctx, tx.TxHash)
+
+	handlers := k.GetSideHandlers(tx.Msg.Route())
+	if handlers == nil || handlers.PostTxHandler == nil {
+		k.Logger(ctx).Error("No post tx handler found for side tx", "txHash", tx.TxHash.String(), "route", tx.Msg.Route())
+		emitSideTxEvent(ctx, types.EventTypeSideTxRejected, tx, "no post tx handler")
+		return
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	result := handlers.PostTxHandler(cacheCtx, tx.Msg)
+	if !result.IsOK() {
+		k.Logger(ctx).Error("Approved side tx failed to apply", "txHash", tx.TxHash.String(), "log", result.Log)
+		emitSideTxEvent(ctx, types.EventTypeSideTxRejected, tx, result.Log)
+		return
+	}
+
+	writeCache()
+	k.Logger(ctx).Info("Side tx approved and applied", "txHash", tx.TxHash.String(), "route", tx.Msg.Route(), "type", tx.Msg.Type())
+
+	ctx.EventManager().EmitEvents(result.Events)
+	emitSideTxEvent(ctx, types.EventTypeSideTxApproved, tx, "")
+}
+
+// emitSideTxEvent emits side tx event with given type
+func emitSideTxEvent(ctx sdk.Context, eventType string, tx types.PendingSideTx, reason string) {
+	event := sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyTxHash, tx.TxHash.String()),
+		sdk.NewAttribute(types.AttributeKeyMsgRoute, tx.Msg.Route()),
+		sdk.NewAttribute(types.AttributeKeyMsgType, tx.Msg.Type()),
+	)
+
+	if reason != "" {
+		event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyReason, reason))
+	}
+
+	ctx.EventManager().EmitEvent(event)
+}
+
+// EndBlocker removes pending side txs which didn't get enough votes within vote window
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	voteWindow := k.GetParams(ctx).VoteWindow
+
+	var expired []types.PendingSideTx
+	k.IteratePendingSideTxsAndApplyFn(ctx, func(tx types.PendingSideTx) error {
+		if ctx.BlockHeight()-tx.Height >= int64(voteWindow) {
+			expired = append(expired, tx)
+		}
+		return nil
+	})
+
+	for _, tx := range expired {
+		k.RemovePendingSideTx(ctx, tx.TxHash)
+		k.Logger(ctx).Info("Side tx expired without enough votes", "txHash", tx.TxHash.String(), "height", tx.Height)
+		emitSideTxEvent(ctx, types.EventTypeSideTxExpired, tx, "")
+	}
+}
@@ -0,0 +1,182 @@
+package sidetx
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/maticnetwork/heimdall/sidetx/types"
+	"github.com/maticnetwork/heimdall/staking"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+var (
+	PendingSideTxPrefixKey = []byte{0x11} // prefix key for storing pending side txs
+)
+
+// Keeper stores all related data
+type Keeper struct {
+	cdc *codec.Codec
+	// staking keeper
+	sk staking.Keeper
+	// side router to get side and post handlers of modules
+	sideRouter hmTypes.SideRouter
+	// The (unexposed) keys used to access the stores from the Context.
+	storeKey sdk.StoreKey
+	// codespace
+	codespace sdk.CodespaceType
+	// param space
+	paramSpace params.Subspace
+}
+
+// NewKeeper create new keeper
+func NewKeeper(
+	cdc *codec.Codec,
+	storeKey sdk.StoreKey,
+	paramSpace params.Subspace,
+	codespace sdk.CodespaceType,
+	stakingKeeper staking.Keeper,
+	sideRouter hmTypes.SideRouter,
+) Keeper {
+	keeper := Keeper{
+		cdc:        cdc,
+		storeKey:   storeKey,
+		paramSpace: paramSpace.WithKeyTable(types.ParamKeyTable()),
+		codespace:  codespace,
+		sk:         stakingKeeper,
+		sideRouter: sideRouter,
+	}
+	return keeper
+}
+
+// Codespace returns the codespace
+func (k Keeper) Codespace() sdk.CodespaceType {
+	return k.codespace
+}
+
+// Logger returns a module-specific logger
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", types.ModuleName)
+}
+
+// GetPendingSideTxKey appends prefix to tx hash
+func GetPendingSideTxKey(txHash []byte) []byte {
+	return append(PendingSideTxPrefixKey, txHash...)
+}
+
+// SetPendingSideTx adds pending side tx to store
+func (k *Keeper) SetPendingSideTx(ctx sdk.Context, tx types.PendingSideTx) error {
+	store := ctx.KVStore(k.storeKey)
+	out, err := k.cdc.MarshalBinaryBare(tx)
+	if err != nil {
+		k.Logger(ctx).Error("Error marshalling pending side tx", "error", err)
+		return err
+	}
+
+	store.Set(GetPendingSideTxKey(tx.TxHash.Bytes()), out)
+	return nil
+}
+
+// GetPendingSideTx returns pending side tx by tx hash
+func (k *Keeper) GetPendingSideTx(ctx sdk.Context, txHash hmTypes.HeimdallHash) (*types.PendingSideTx, error) {
+	store := ctx.KVStore(k.storeKey)
+	key := GetPendingSideTxKey(txHash.Bytes())
+	if !store.Has(key) {
+		return nil, nil
+	}
+
+	var tx types.PendingSideTx
+	if err := k.cdc.UnmarshalBinaryBare(store.Get(key), &tx); err != nil {
+		return nil, err
+	}
+
+	return &tx, nil
+}
+
+// HasPendingSideTx checks if pending side tx exists
+func (k *Keeper) HasPendingSideTx(ctx sdk.Context, txHash hmTypes.HeimdallHash) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(GetPendingSideTxKey(txHash.Bytes()))
+}
+
+// RemovePendingSideTx removes pending side tx from store
+func (k *Keeper) RemovePendingSideTx(ctx sdk.Context, txHash hmTypes.HeimdallHash) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetPendingSideTxKey(txHash.Bytes()))
+}
+
+// GetPendingSideTxs returns all pending side txs
+func (k *Keeper) GetPendingSideTxs(ctx sdk.Context) (txs []types.PendingSideTx) {
+	k.IteratePendingSideTxsAndApplyFn(ctx, func(tx types.PendingSideTx) error {
+		txs = append(txs, tx)
+		return nil
+	})
+	return
+}
+
+// IteratePendingSideTxsAndApplyFn iterates pending side txs and applies the given function.
+func (k *Keeper) IteratePendingSideTxsAndApplyFn(ctx sdk.Context, f func(tx types.PendingSideTx) error) {
+	store := ctx.KVStore(k.storeKey)
+
+	// get pending side tx iterator
+	iterator := sdk.KVStorePrefixIterator(store, PendingSideTxPrefixKey)
+	defer iterator.Close()
+
+	// loop through pending side txs
+	for ; iterator.Valid(); iterator.Next() {
+		var tx types.PendingSideTx
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &tx); err != nil {
+			k.Logger(ctx).Error("Error while unmarshalling pending side tx", "error", err)
+			continue
+		}
+
+		// call function and return if required
+		if err := f(tx); err != nil {
+			return
+		}
+	}
+}
+
+// GetSideHandlers returns side handlers registered for msg route
+func (k *Keeper) GetSideHandlers(route string) *hmTypes.SideHandlers {
+	return k.sideRouter.GetRoute(route)
+}
+
+// TallyVotes returns yes, no and total voting power of current validator set for pending side tx
+func (k *Keeper) TallyVotes(ctx sdk.Context, tx types.PendingSideTx) (yes int64, no int64, total int64) {
+	validatorSet := k.sk.GetValidatorSet(ctx)
+
+	powers := make(map[hmTypes.ValidatorID]int64)
+	for _, validator := range validatorSet.Validators {
+		powers[validator.ID] = validator.VotingPower
+		total += validator.VotingPower
+	}
+
+	// only votes of validators in current validator set count
+	for _, vote := range tx.Votes {
+		switch vote.Result {
+		case hmTypes.SideTxResultYes:
+			yes += powers[vote.ValidatorID]
+		case hmTypes.SideTxResultNo:
+			no += powers[vote.ValidatorID]
+		}
+	}
+
+	return
+}
+
+//
+//  Params
+//
+
+// SetParams sets the sidetx module's parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetParams gets the sidetx module's parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return
+}
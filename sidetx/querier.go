@@ -0,0 +1,107 @@
+package sidetx
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/sidetx/types"
+)
+
+// NewQuerier creates a querier for sidetx REST endpoints
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QueryParams:
+			return handleQueryParams(ctx, req, keeper)
+		case types.QueryPendingSideTxs:
+			return handleQueryPendingSideTxs(ctx, req, keeper)
+		case types.QueryPendingSideTx:
+			return handleQueryPendingSideTx(ctx, req, keeper)
+		case types.QuerySideTxResult:
+			return handleQuerySideTxResult(ctx, req, keeper)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown sidetx query endpoint")
+		}
+	}
+}
+
+func handleQueryParams(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	bz, err := json.Marshal(keeper.GetParams(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryPendingSideTxs(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	txs := keeper.GetPendingSideTxs(ctx)
+	if txs == nil {
+		txs = make([]types.PendingSideTx, 0)
+	}
+
+	// side tx contains msg interface, use codec to marshal it
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, txs)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryPendingSideTx(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	tx, sdkErr := getPendingSideTx(ctx, req, keeper)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, tx)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+// handleQuerySideTxResult runs side tx handler against node's own RPC and returns its vote
+func handleQuerySideTxResult(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	tx, sdkErr := getPendingSideTx(ctx, req, keeper)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	handlers := keeper.GetSideHandlers(tx.Msg.Route())
+	if handlers == nil || handlers.SideTxHandler == nil {
+		return nil, types.ErrSideTxNoHandler(keeper.Codespace())
+	}
+
+	result := handlers.SideTxHandler(ctx, tx.Msg)
+	bz, err := json.Marshal(types.SideTxResultResponse{
+		TxHash: tx.TxHash,
+		Result: result,
+	})
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+// getPendingSideTx returns pending side tx for query params
+func getPendingSideTx(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (*types.PendingSideTx, sdk.Error) {
+	var params types.QuerySideTxParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	tx, err := keeper.GetPendingSideTx(ctx, params.TxHash)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not get pending side tx", err.Error()))
+	}
+
+	if tx == nil {
+		return nil, types.ErrSideTxNotFound(keeper.Codespace())
+	}
+
+	return tx, nil
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Side tx errors reserve 6400 ~ 6499.
+const (
+	CodeSideTxNotFound     sdk.CodeType = 6400
+	CodeSideTxAlreadyVoted              = 6401
+	CodeSideTxInvalidVote               = 6402
+	CodeSideTxNoHandler                 = 6403
+	CodeSideTxMultipleMsgs              = 6404
+)
+
+// ErrSideTxNotFound represents pending side tx not found error
+func ErrSideTxNotFound(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeSideTxNotFound, "Pending side tx not found")
+}
+
+// ErrSideTxAlreadyVoted represents duplicate vote error
+func ErrSideTxAlreadyVoted(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeSideTxAlreadyVoted, "Validator already voted on side tx")
+}
+
+// ErrSideTxInvalidVote represents invalid vote error
+func ErrSideTxInvalidVote(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeSideTxInvalidVote, "Side tx vote is not valid")
+}
+
+// ErrSideTxNoHandler represents missing side handler error
+func ErrSideTxNoHandler(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeSideTxNoHandler, "No side handler registered for side tx")
+}
+
+// ErrSideTxMultipleMsgs represents more than one side tx msg in a tx error
+func ErrSideTxMultipleMsgs(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeSideTxMultipleMsgs, "Only one side tx msg is allowed per tx")
+}
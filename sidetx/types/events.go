@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+var (
+	EventTypeSideTx         = "side-tx"
+	EventTypeSideTxVote     = "side-tx-vote"
+	EventTypeSideTxApproved = "side-tx-approved"
+	EventTypeSideTxRejected = "side-tx-rejected"
+	EventTypeSideTxExpired  = "side-tx-expired"
+
+	AttributeKeyTxHash      = "tx-hash"
+	AttributeKeyMsgRoute    = "msg-route"
+	AttributeKeyMsgType     = "msg-type"
+	AttributeKeyValidatorID = "validator-id"
+	AttributeKeyResult      = "result"
+	AttributeKeyReason      = "reason"
+
+	AttributeValueCategory = ModuleName
+)
+
+// GetApprovedTxHash returns hash of side tx with given route approved in tx events
+func GetApprovedTxHash(events sdk.StringEvents, route string) (hmTypes.HeimdallHash, bool) {
+	for _, event := range events {
+		if event.Type != EventTypeSideTxApproved {
+			continue
+		}
+
+		var txHash string
+		var msgRoute string
+		for _, attribute := range event.Attributes {
+			switch attribute.Key {
+			case AttributeKeyTxHash:
+				txHash = attribute.Value
+			case AttributeKeyMsgRoute:
+				msgRoute = attribute.Value
+			}
+		}
+
+		if txHash != "" && msgRoute == route {
+			return hmTypes.HexToHeimdallHash(txHash), true
+		}
+	}
+
+	return hmTypes.HeimdallHash{}, false
+}
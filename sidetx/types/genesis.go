@@ -0,0 +1,42 @@
+package types
+
+import (
+	"encoding/json"
+)
+
+// GenesisState is the sidetx state that must be provided at genesis.
+// Pending side txs are not part of genesis, side txs pending at export need to be re-submitted.
+type GenesisState struct {
+	Params Params `json:"params" yaml:"params"`
+}
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params) GenesisState {
+	return GenesisState{
+		Params: params,
+	}
+}
+
+// DefaultGenesisState returns a default genesis state
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(DefaultParams())
+}
+
+// ValidateGenesis performs basic validation of sidetx genesis data returning an
+// error for any failed validation criteria.
+func ValidateGenesis(data GenesisState) error {
+	return data.Params.Validate()
+}
+
+// GetGenesisStateFromAppState returns sidetx GenesisState given raw application genesis state
+func GetGenesisStateFromAppState(appState map[string]json.RawMessage) GenesisState {
+	var genesisState GenesisState
+	if appState[ModuleName] != nil {
+		err := json.Unmarshal(appState[ModuleName], &genesisState)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	return genesisState
+}
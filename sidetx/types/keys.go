@@ -0,0 +1,25 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "sidetx"
+
+	// StoreKey is the store key string for sidetx
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for sidetx
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for sidetx
+	QuerierRoute = ModuleName
+
+	// DefaultParamspace default name for parameter store
+	DefaultParamspace = ModuleName
+
+	// DefaultCodespace default code space
+	DefaultCodespace sdk.CodespaceType = ModuleName
+)
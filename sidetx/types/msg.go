@@ -0,0 +1,68 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmCommon "github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/types"
+)
+
+//
+// Side tx vote
+//
+
+var _ sdk.Msg = &MsgSideTxVote{}
+
+// MsgSideTxVote represents validator's vote on pending side tx
+type MsgSideTxVote struct {
+	From   types.HeimdallAddress `json:"from"`
+	TxHash types.HeimdallHash    `json:"tx_hash"`
+	Result types.SideTxResult    `json:"result"`
+}
+
+// NewMsgSideTxVote creates new side tx vote message
+func NewMsgSideTxVote(from types.HeimdallAddress, txHash types.HeimdallHash, result types.SideTxResult) MsgSideTxVote {
+	return MsgSideTxVote{
+		From:   from,
+		TxHash: txHash,
+		Result: result,
+	}
+}
+
+// Type returns message type
+func (msg MsgSideTxVote) Type() string {
+	return "side-tx-vote"
+}
+
+// Route returns message route
+func (msg MsgSideTxVote) Route() string {
+	return RouterKey
+}
+
+// GetSigners returns address of the signer
+func (msg MsgSideTxVote) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{types.HeimdallAddressToAccAddress(msg.From)}
+}
+
+// GetSignBytes returns sign bytes
+func (msg MsgSideTxVote) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// ValidateBasic validates the message
+func (msg MsgSideTxVote) ValidateBasic() sdk.Error {
+	if msg.From.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid from %v", msg.From.String())
+	}
+
+	if msg.TxHash.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid tx hash %v", msg.TxHash.String())
+	}
+
+	// validators abstain by not voting
+	if msg.Result != types.SideTxResultYes && msg.Result != types.SideTxResultNo {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid vote %v", msg.Result.String())
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/x/params/subspace"
+)
+
+// Default parameter values
+const (
+	DefaultVoteWindow uint64 = 500 // number of blocks pending side tx waits for validator votes
+)
+
+// Parameter keys
+var (
+	KeyVoteWindow = []byte("VoteWindow")
+)
+
+var _ subspace.ParamSet = &Params{}
+
+// Params defines the parameters for the sidetx module.
+type Params struct {
+	VoteWindow uint64 `json:"vote_window" yaml:"vote_window"`
+}
+
+// NewParams creates a new Params object
+func NewParams(voteWindow uint64) Params {
+	return Params{
+		VoteWindow: voteWindow,
+	}
+}
+
+// ParamKeyTable for sidetx module
+func ParamKeyTable() subspace.KeyTable {
+	return subspace.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+// pairs of sidetx module's parameters.
+// nolint
+func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
+	return subspace.ParamSetPairs{
+		{Key: KeyVoteWindow, Value: &p.VoteWindow},
+	}
+}
+
+// Equal returns a boolean determining if two Params types are identical.
+func (p Params) Equal(p2 Params) bool {
+	bz1 := ModuleCdc.MustMarshalBinaryLengthPrefixed(&p)
+	bz2 := ModuleCdc.MustMarshalBinaryLengthPrefixed(&p2)
+	return bytes.Equal(bz1, bz2)
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return NewParams(DefaultVoteWindow)
+}
+
+// String implements the stringer interface.
+func (p Params) String() string {
+	var sb strings.Builder
+	sb.WriteString("Params: \n")
+	sb.WriteString(fmt.Sprintf("VoteWindow: %d\n", p.VoteWindow))
+	return sb.String()
+}
+
+// Validate checks that the parameters have valid values.
+func (p Params) Validate() error {
+	if p.VoteWindow == 0 {
+		return errors.New("vote window should be non-zero")
+	}
+
+	return nil
+}
@@ -0,0 +1,23 @@
+package types
+
+import (
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// query endpoints supported by the sidetx Querier
+const (
+	QueryParams         = "params"
+	QueryPendingSideTxs = "pending-side-txs"
+	QueryPendingSideTx  = "pending-side-tx"
+	QuerySideTxResult   = "side-tx-result"
+)
+
+// QuerySideTxParams defines the params for querying side tx.
+type QuerySideTxParams struct {
+	TxHash hmTypes.HeimdallHash
+}
+
+// NewQuerySideTxParams creates a new instance of QuerySideTxParams.
+func NewQuerySideTxParams(txHash hmTypes.HeimdallHash) QuerySideTxParams {
+	return QuerySideTxParams{TxHash: txHash}
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// SideTxVote represents vote of a validator on pending side tx
+type SideTxVote struct {
+	ValidatorID hmTypes.ValidatorID  `json:"validator_id" yaml:"validator_id"`
+	Result      hmTypes.SideTxResult `json:"result" yaml:"result"`
+}
+
+// PendingSideTx represents side tx waiting for validator votes
+type PendingSideTx struct {
+	TxHash hmTypes.HeimdallHash `json:"tx_hash" yaml:"tx_hash"`
+	Height int64                `json:"height" yaml:"height"`
+	Msg    sdk.Msg              `json:"msg" yaml:"msg"`
+	Votes  []SideTxVote         `json:"votes" yaml:"votes"`
+}
+
+// NewPendingSideTx creates new pending side tx
+func NewPendingSideTx(txHash hmTypes.HeimdallHash, height int64, msg sdk.Msg) PendingSideTx {
+	return PendingSideTx{
+		TxHash: txHash,
+		Height: height,
+		Msg:    msg,
+		Votes:  make([]SideTxVote, 0),
+	}
+}
+
+// HasVoted checks if validator has already voted on side tx
+func (tx *PendingSideTx) HasVoted(validatorID hmTypes.ValidatorID) bool {
+	for _, vote := range tx.Votes {
+		if vote.ValidatorID == validatorID {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns the string representation of pending side tx
+func (tx *PendingSideTx) String() string {
+	return fmt.Sprintf(
+		"PendingSideTx: txHash %v, height %v, route %v, type %v, votes %v",
+		tx.TxHash.String(),
+		tx.Height,
+		tx.Msg.Route(),
+		tx.Msg.Type(),
+		len(tx.Votes),
+	)
+}
+
+// SideTxResultResponse represents side tx result computed by a node against its own RPC
+type SideTxResultResponse struct {
+	TxHash hmTypes.HeimdallHash `json:"tx_hash" yaml:"tx_hash"`
+	Result hmTypes.SideTxResult `json:"result" yaml:"result"`
+}
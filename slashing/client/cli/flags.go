@@ -0,0 +1,5 @@
+package cli
+
+const (
+	FlagValidatorID = "id"
+)
@@ -0,0 +1,132 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	hmClient "github.com/maticnetwork/heimdall/client"
+	"github.com/maticnetwork/heimdall/slashing/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	// Group slashing queries under a subcommand
+	queryCmds := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the slashing module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       hmClient.ValidateCmd,
+	}
+
+	// slashing query command
+	queryCmds.AddCommand(
+		client.GetCommands(
+			GetQueryParams(cdc),
+			GetSlashes(cdc),
+			GetSigningInfo(cdc),
+		)...,
+	)
+
+	return queryCmds
+}
+
+// GetQueryParams implements the params query command.
+func GetQueryParams(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "show the current slashing parameters information",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParams), nil)
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			if err := cliCtx.Codec.UnmarshalJSON(res, &params); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
+
+// GetSlashes get slashes of all validators or of validator with given id
+func GetSlashes(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slashes",
+		Args:  cobra.NoArgs,
+		Short: "show validator slashes",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySlashes)
+			var queryParams []byte
+
+			if cmd.Flags().Changed(FlagValidatorID) {
+				bz, err := cliCtx.Codec.MarshalJSON(types.NewQueryValidatorSlashesParams(hmTypes.ValidatorID(viper.GetUint64(FlagValidatorID))))
+				if err != nil {
+					return err
+				}
+
+				route = fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorSlashes)
+				queryParams = bz
+			}
+
+			res, _, err := cliCtx.QueryWithData(route, queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagValidatorID, 0, "--id=<validator ID here>")
+	return cmd
+}
+
+// GetSigningInfo get signing info of validator with given id
+func GetSigningInfo(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signing-info",
+		Args:  cobra.NoArgs,
+		Short: "show validator signing info",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			validatorID := viper.GetUint64(FlagValidatorID)
+			if validatorID == 0 {
+				return fmt.Errorf("Validator ID cannot be zero")
+			}
+
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQuerySigningInfoParams(hmTypes.ValidatorID(validatorID)))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySigningInfo), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagValidatorID, 0, "--id=<validator ID here>")
+	cmd.MarkFlagRequired(FlagValidatorID)
+	return cmd
+}
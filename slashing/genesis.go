@@ -0,0 +1,55 @@
+package slashing
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/slashing/types"
+)
+
+// InitGenesis sets slashing information for genesis.
+func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
+	keeper.SetParams(ctx, data.Params)
+
+	for _, slash := range data.Slashes {
+		if err := keeper.SetValidatorSlash(ctx, slash); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, info := range data.SigningInfos {
+		if err := keeper.SetValidatorSigningInfo(ctx, info); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, missed := range data.MissedBlocks {
+		for _, index := range missed.MissedBlocks {
+			keeper.SetValidatorMissedBlock(ctx, missed.ValidatorID, index, true)
+		}
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
+	signingInfos := keeper.GetAllValidatorSigningInfos(ctx)
+
+	missedBlocks := make([]types.ValidatorMissedBlocks, 0, len(signingInfos))
+	for _, info := range signingInfos {
+		indices := keeper.GetValidatorMissedBlocks(ctx, info.ValidatorID)
+		if len(indices) == 0 {
+			continue
+		}
+
+		missedBlocks = append(missedBlocks, types.ValidatorMissedBlocks{
+			ValidatorID:  info.ValidatorID,
+			MissedBlocks: indices,
+		})
+	}
+
+	return types.NewGenesisState(
+		keeper.GetParams(ctx),
+		keeper.GetAllValidatorSlashes(ctx),
+		signingInfos,
+		missedBlocks,
+	)
+}
@@ -0,0 +1,98 @@
+package slashing
+
+import (
+	"bytes"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+
+	hmCommon "github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/slashing/types"
+)
+
+// NewHandler returns a handler for "slashing" type messages.
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case types.MsgUnjail:
+			return handleMsgUnjail(ctx, msg, k)
+		default:
+			return sdk.ErrTxDecode("Invalid message in slashing module").Result()
+		}
+	}
+}
+
+// handleMsgUnjail unjails validator once downtime jail duration is over
+func handleMsgUnjail(ctx sdk.Context, msg types.MsgUnjail, k Keeper) sdk.Result {
+	validator, ok := k.sk.GetValidatorFromValID(ctx, msg.ID)
+	if !ok {
+		k.Logger(ctx).Error("Fetching of validator from store failed", "validatorID", msg.ID)
+		return hmCommon.ErrNoValidator(k.Codespace()).Result()
+	}
+
+	if !bytes.Equal(validator.Signer.Bytes(), msg.From.Bytes()) {
+		k.Logger(ctx).Error("Unjail not sent by validator signer", "validatorID", msg.ID, "signer", validator.Signer.String(), "from", msg.From.String())
+		return types.ErrValidatorSignerMismatch(k.Codespace()).Result()
+	}
+
+	if !validator.Jailed {
+		return types.ErrValidatorNotJailed(k.Codespace()).Result()
+	}
+
+	if signInfo, found := k.GetValidatorSigningInfo(ctx, validator.ID); found {
+		if signInfo.Tombstoned {
+			return types.ErrValidatorTombstoned(k.Codespace()).Result()
+		}
+
+		if ctx.BlockHeader().Time.Before(signInfo.JailedUntil) {
+			k.Logger(ctx).Error("Validator is still jailed", "validatorID", msg.ID, "jailedUntil", signInfo.JailedUntil)
+			return types.ErrValidatorJailed(k.Codespace()).Result()
+		}
+	}
+
+	if err := k.sk.Unjail(ctx, validator.Signer.Bytes()); err != nil {
+		return hmCommon.ErrValidatorSave(k.Codespace()).Result()
+	}
+
+	// liveness of unjailed validator is tracked from current height
+	signInfo := types.NewValidatorSigningInfo(validator.ID, ctx.BlockHeight())
+	if err := k.SetValidatorSigningInfo(ctx, signInfo); err != nil {
+		k.Logger(ctx).Error("Unable to store validator signing info", "validatorID", validator.ID, "error", err)
+	}
+
+	k.Logger(ctx).Info("Validator unjailed", "validatorID", validator.ID)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnjail,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(validator.ID.Uint64(), 10)),
+			sdk.NewAttribute(types.AttributeKeySigner, validator.Signer.String()),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// BeginBlocker tracks liveness of validators and slashes validators for evidence
+// of misbehaviour submitted by tendermint
+func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k Keeper) {
+	for _, vote := range req.LastCommitInfo.GetVotes() {
+		k.HandleValidatorSignature(ctx, vote.Validator.Address, vote.SignedLastBlock)
+	}
+
+	for _, evidence := range req.ByzantineValidators {
+		switch evidence.Type {
+		case tmTypes.ABCIEvidenceTypeDuplicateVote:
+			k.HandleDoubleSign(ctx, evidence.Validator.Address, evidence.Height)
+		default:
+			k.Logger(ctx).Error("Ignored unknown evidence type", "type", evidence.Type)
+		}
+	}
+}
@@ -0,0 +1,404 @@
+package slashing
+
+import (
+	"math/big"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/maticnetwork/heimdall/helper"
+	"github.com/maticnetwork/heimdall/slashing/types"
+	"github.com/maticnetwork/heimdall/staking"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+var (
+	DefaultValue = []byte{0x01} // Value to store for missed block
+
+	ValidatorSlashPrefixKey       = []byte{0x11} // prefix key for storing validator slashes
+	ValidatorSigningInfoPrefixKey = []byte{0x12} // prefix key for storing validator signing info
+	ValidatorMissedBlockPrefixKey = []byte{0x13} // prefix key for storing missed blocks bit array of validator
+)
+
+// Keeper stores all related data
+type Keeper struct {
+	cdc *codec.Codec
+	// staking keeper
+	sk staking.Keeper
+	// The (unexposed) keys used to access the stores from the Context.
+	storeKey sdk.StoreKey
+	// codespace
+	codespace sdk.CodespaceType
+	// param space
+	paramSpace params.Subspace
+}
+
+// NewKeeper create new keeper
+func NewKeeper(
+	cdc *codec.Codec,
+	storeKey sdk.StoreKey,
+	paramSpace params.Subspace,
+	codespace sdk.CodespaceType,
+	stakingKeeper staking.Keeper,
+) Keeper {
+	keeper := Keeper{
+		cdc:        cdc,
+		storeKey:   storeKey,
+		paramSpace: paramSpace.WithKeyTable(types.ParamKeyTable()),
+		codespace:  codespace,
+		sk:         stakingKeeper,
+	}
+	return keeper
+}
+
+// Codespace returns the codespace
+func (k Keeper) Codespace() sdk.CodespaceType {
+	return k.codespace
+}
+
+// Logger returns a module-specific logger
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", types.ModuleName)
+}
+
+// GetValidatorSlashPrefixKey returns prefix key for slashes of validator
+func GetValidatorSlashPrefixKey(validatorID hmTypes.ValidatorID) []byte {
+	return append(append(ValidatorSlashPrefixKey, validatorID.Bytes()...), ':')
+}
+
+// GetValidatorSlashKey returns key for slash of validator at infraction height
+func GetValidatorSlashKey(validatorID hmTypes.ValidatorID, infractionHeight int64) []byte {
+	return append(GetValidatorSlashPrefixKey(validatorID), []byte(strconv.FormatInt(infractionHeight, 10))...)
+}
+
+// SetValidatorSlash stores slash record
+func (k *Keeper) SetValidatorSlash(ctx sdk.Context, slash types.ValidatorSlash) error {
+	store := ctx.KVStore(k.storeKey)
+	out, err := k.cdc.MarshalBinaryBare(slash)
+	if err != nil {
+		k.Logger(ctx).Error("Error marshalling validator slash", "error", err)
+		return err
+	}
+
+	store.Set(GetValidatorSlashKey(slash.ValidatorID, slash.InfractionHeight), out)
+	return nil
+}
+
+// HasValidatorSlash checks if validator is already slashed for infraction height
+func (k *Keeper) HasValidatorSlash(ctx sdk.Context, validatorID hmTypes.ValidatorID, infractionHeight int64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(GetValidatorSlashKey(validatorID, infractionHeight))
+}
+
+// GetValidatorSlashes returns all slashes of validator
+func (k *Keeper) GetValidatorSlashes(ctx sdk.Context, validatorID hmTypes.ValidatorID) []types.ValidatorSlash {
+	return k.getSlashesByPrefix(ctx, GetValidatorSlashPrefixKey(validatorID))
+}
+
+// GetAllValidatorSlashes returns slashes of all validators
+func (k *Keeper) GetAllValidatorSlashes(ctx sdk.Context) []types.ValidatorSlash {
+	return k.getSlashesByPrefix(ctx, ValidatorSlashPrefixKey)
+}
+
+func (k *Keeper) getSlashesByPrefix(ctx sdk.Context, prefix []byte) (slashes []types.ValidatorSlash) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var slash types.ValidatorSlash
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &slash); err != nil {
+			k.Logger(ctx).Error("Error unmarshalling validator slash", "error", err)
+			continue
+		}
+		slashes = append(slashes, slash)
+	}
+
+	return
+}
+
+// GetValidatorSigningInfoKey returns key for signing info of validator
+func GetValidatorSigningInfoKey(validatorID hmTypes.ValidatorID) []byte {
+	return append(ValidatorSigningInfoPrefixKey, validatorID.Bytes()...)
+}
+
+// GetValidatorMissedBlockPrefixKey returns prefix key for missed blocks bit array of validator
+func GetValidatorMissedBlockPrefixKey(validatorID hmTypes.ValidatorID) []byte {
+	return append(append(ValidatorMissedBlockPrefixKey, validatorID.Bytes()...), ':')
+}
+
+// GetValidatorMissedBlockKey returns key for missed block of validator at index in window
+func GetValidatorMissedBlockKey(validatorID hmTypes.ValidatorID, index int64) []byte {
+	return append(GetValidatorMissedBlockPrefixKey(validatorID), []byte(strconv.FormatInt(index, 10))...)
+}
+
+// SetValidatorSigningInfo stores signing info of validator
+func (k *Keeper) SetValidatorSigningInfo(ctx sdk.Context, info types.ValidatorSigningInfo) error {
+	store := ctx.KVStore(k.storeKey)
+	out, err := k.cdc.MarshalBinaryBare(info)
+	if err != nil {
+		k.Logger(ctx).Error("Error marshalling validator signing info", "error", err)
+		return err
+	}
+
+	store.Set(GetValidatorSigningInfoKey(info.ValidatorID), out)
+	return nil
+}
+
+// GetValidatorSigningInfo returns signing info of validator
+func (k *Keeper) GetValidatorSigningInfo(ctx sdk.Context, validatorID hmTypes.ValidatorID) (info types.ValidatorSigningInfo, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetValidatorSigningInfoKey(validatorID))
+	if bz == nil {
+		return info, false
+	}
+
+	if err := k.cdc.UnmarshalBinaryBare(bz, &info); err != nil {
+		k.Logger(ctx).Error("Error unmarshalling validator signing info", "error", err)
+		return info, false
+	}
+
+	return info, true
+}
+
+// GetAllValidatorSigningInfos returns signing info of all validators
+func (k *Keeper) GetAllValidatorSigningInfos(ctx sdk.Context) (infos []types.ValidatorSigningInfo) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, ValidatorSigningInfoPrefixKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var info types.ValidatorSigningInfo
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &info); err != nil {
+			k.Logger(ctx).Error("Error unmarshalling validator signing info", "error", err)
+			continue
+		}
+		infos = append(infos, info)
+	}
+
+	return
+}
+
+// SetValidatorMissedBlock marks block at index in window as missed or signed
+func (k *Keeper) SetValidatorMissedBlock(ctx sdk.Context, validatorID hmTypes.ValidatorID, index int64, missed bool) {
+	store := ctx.KVStore(k.storeKey)
+	if missed {
+		store.Set(GetValidatorMissedBlockKey(validatorID, index), DefaultValue)
+	} else {
+		store.Delete(GetValidatorMissedBlockKey(validatorID, index))
+	}
+}
+
+// GetValidatorMissedBlock checks if block at index in window is missed
+func (k *Keeper) GetValidatorMissedBlock(ctx sdk.Context, validatorID hmTypes.ValidatorID, index int64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(GetValidatorMissedBlockKey(validatorID, index))
+}
+
+// GetValidatorMissedBlocks returns indices of missed blocks in window
+func (k *Keeper) GetValidatorMissedBlocks(ctx sdk.Context, validatorID hmTypes.ValidatorID) (indices []int64) {
+	prefix := GetValidatorMissedBlockPrefixKey(validatorID)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		index, err := strconv.ParseInt(string(iterator.Key()[len(prefix):]), 10, 64)
+		if err != nil {
+			continue
+		}
+		indices = append(indices, index)
+	}
+
+	return
+}
+
+// clearValidatorMissedBlocks removes all missed blocks of validator
+func (k *Keeper) clearValidatorMissedBlocks(ctx sdk.Context, validatorID hmTypes.ValidatorID) {
+	for _, index := range k.GetValidatorMissedBlocks(ctx, validatorID) {
+		k.SetValidatorMissedBlock(ctx, validatorID, index, false)
+	}
+}
+
+// AddSlashToDividendAccount adds slashed amount to dividend account, it is included in account root on checkpoint
+func (k *Keeper) AddSlashToDividendAccount(ctx sdk.Context, valID hmTypes.ValidatorID, amount *big.Int) {
+	// Get or create dividend account
+	var dividendAccount hmTypes.DividendAccount
+
+	if k.sk.CheckIfDividendAccountExists(ctx, hmTypes.DividendAccountID(valID)) {
+		dividendAccount, _ = k.sk.GetDividendAccountByID(ctx, hmTypes.DividendAccountID(valID))
+	} else {
+		dividendAccount = hmTypes.DividendAccount{
+			ID:            hmTypes.DividendAccountID(valID),
+			FeeAmount:     big.NewInt(0).String(),
+			SlashedAmount: big.NewInt(0).String(),
+		}
+	}
+
+	// update slashed amount
+	oldSlashedAmount, _ := big.NewInt(0).SetString(dividendAccount.SlashedAmount, 10)
+	dividendAccount.SlashedAmount = big.NewInt(0).Add(oldSlashedAmount, amount).String()
+
+	k.Logger(ctx).Info("Dividend Account slashed amount of validator", "ID", dividendAccount.ID, "SlashedAmount", dividendAccount.SlashedAmount)
+	k.sk.AddDividendAccount(ctx, dividendAccount)
+}
+
+// HandleDoubleSign slashes and jails validator for double sign evidence
+func (k *Keeper) HandleDoubleSign(ctx sdk.Context, address []byte, infractionHeight int64) {
+	params := k.GetParams(ctx)
+	signer := hmTypes.BytesToHeimdallAddress(address)
+
+	// ignore evidence older than max age
+	if age := ctx.BlockHeight() - infractionHeight; age > params.MaxEvidenceAge {
+		k.Logger(ctx).Info("Ignoring double sign evidence older than max age", "signer", signer.String(), "infractionHeight", infractionHeight, "age", age)
+		return
+	}
+
+	// map tendermint address to validator
+	validator, err := k.sk.GetValidatorInfo(ctx, address)
+	if err != nil {
+		k.Logger(ctx).Error("Ignoring double sign evidence of unknown validator", "signer", signer.String(), "error", err)
+		return
+	}
+
+	// validator is slashed once per infraction
+	if k.HasValidatorSlash(ctx, validator.ID, infractionHeight) {
+		k.Logger(ctx).Info("Validator already slashed for infraction", "validatorID", validator.ID, "infractionHeight", infractionHeight)
+		return
+	}
+
+	// slash fraction of validator stake, validators without tracked amount fall back to voting power
+	stake := helper.GetAmountFromPower(validator.VotingPower)
+	if validator.Amount.I != nil {
+		stake = validator.Amount.BigInt()
+	}
+	amount := sdk.NewDecFromBigInt(stake).Mul(params.SlashFractionDoubleSign).TruncateInt().BigInt()
+	k.AddSlashToDividendAccount(ctx, validator.ID, amount)
+
+	// jail validator, it is removed from validator set
+	if err := k.sk.Jail(ctx, address); err != nil {
+		k.Logger(ctx).Error("Unable to jail validator", "validatorID", validator.ID, "error", err)
+	}
+
+	// validator jailed for double sign can't unjail
+	signInfo, found := k.GetValidatorSigningInfo(ctx, validator.ID)
+	if !found {
+		signInfo = types.NewValidatorSigningInfo(validator.ID, ctx.BlockHeight())
+	}
+	signInfo.Tombstoned = true
+	if err := k.SetValidatorSigningInfo(ctx, signInfo); err != nil {
+		k.Logger(ctx).Error("Unable to store validator signing info", "validatorID", validator.ID, "error", err)
+	}
+
+	slash := types.NewValidatorSlash(validator.ID, validator.Signer, amount.String(), infractionHeight, ctx.BlockHeight(), types.SlashReasonDoubleSign)
+	if err := k.SetValidatorSlash(ctx, slash); err != nil {
+		k.Logger(ctx).Error("Unable to store validator slash", "slash", slash.String(), "error", err)
+	}
+
+	k.Logger(ctx).Info("Validator slashed for double sign", "slash", slash.String())
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSlash,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(validator.ID.Uint64(), 10)),
+			sdk.NewAttribute(types.AttributeKeySigner, validator.Signer.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyInfractionHeight, strconv.FormatInt(infractionHeight, 10)),
+			sdk.NewAttribute(types.AttributeKeyReason, types.SlashReasonDoubleSign),
+			sdk.NewAttribute(types.AttributeKeyJailed, "true"),
+		),
+	})
+}
+
+// HandleValidatorSignature tracks liveness of validator and jails validator which misses
+// more than allowed blocks in signed blocks window
+func (k *Keeper) HandleValidatorSignature(ctx sdk.Context, address []byte, signed bool) {
+	params := k.GetParams(ctx)
+	height := ctx.BlockHeight()
+
+	validator, err := k.sk.GetValidatorInfo(ctx, address)
+	if err != nil {
+		k.Logger(ctx).Error("Ignoring signature of unknown validator", "signer", hmTypes.BytesToHeimdallAddress(address).String(), "error", err)
+		return
+	}
+
+	// jailed validator leaves validator set, stop tracking its liveness
+	if validator.Jailed {
+		return
+	}
+
+	signInfo, found := k.GetValidatorSigningInfo(ctx, validator.ID)
+	if !found {
+		signInfo = types.NewValidatorSigningInfo(validator.ID, height)
+	}
+
+	// update missed blocks bit array of sliding window
+	index := signInfo.IndexOffset % params.SignedBlocksWindow
+	signInfo.IndexOffset++
+
+	previouslyMissed := k.GetValidatorMissedBlock(ctx, validator.ID, index)
+	missed := !signed
+	switch {
+	case !previouslyMissed && missed:
+		k.SetValidatorMissedBlock(ctx, validator.ID, index, true)
+		signInfo.MissedBlocksCounter++
+	case previouslyMissed && !missed:
+		k.SetValidatorMissedBlock(ctx, validator.ID, index, false)
+		signInfo.MissedBlocksCounter--
+	}
+
+	if missed {
+		k.Logger(ctx).Debug("Validator missed block", "validatorID", validator.ID, "height", height, "missed", signInfo.MissedBlocksCounter)
+	}
+
+	// validator is jailed only after full window is tracked
+	minHeight := signInfo.StartHeight + params.SignedBlocksWindow
+	if height > minHeight && signInfo.MissedBlocksCounter > params.MaxMissedBlocksPerWindow() {
+		if err := k.sk.Jail(ctx, address); err != nil {
+			k.Logger(ctx).Error("Unable to jail validator", "validatorID", validator.ID, "error", err)
+		} else {
+			k.Logger(ctx).Info("Validator jailed for missing blocks", "validatorID", validator.ID, "missed", signInfo.MissedBlocksCounter, "window", params.SignedBlocksWindow)
+
+			ctx.EventManager().EmitEvents(sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeLiveness,
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+					sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(validator.ID.Uint64(), 10)),
+					sdk.NewAttribute(types.AttributeKeySigner, validator.Signer.String()),
+					sdk.NewAttribute(types.AttributeKeyMissedBlocks, strconv.FormatInt(signInfo.MissedBlocksCounter, 10)),
+					sdk.NewAttribute(types.AttributeKeyJailed, "true"),
+				),
+			})
+
+			// reset window, validator starts from scratch once unjailed
+			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(params.DowntimeJailDuration)
+			signInfo.MissedBlocksCounter = 0
+			signInfo.IndexOffset = 0
+			k.clearValidatorMissedBlocks(ctx, validator.ID)
+		}
+	}
+
+	if err := k.SetValidatorSigningInfo(ctx, signInfo); err != nil {
+		k.Logger(ctx).Error("Unable to store validator signing info", "validatorID", validator.ID, "error", err)
+	}
+}
+
+//
+//  Params
+//
+
+// SetParams sets the slashing module's parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetParams gets the slashing module's parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return
+}
@@ -0,0 +1,86 @@
+package slashing
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/slashing/types"
+)
+
+// NewQuerier creates a querier for slashing REST endpoints
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QueryParams:
+			return handleQueryParams(ctx, req, keeper)
+		case types.QuerySlashes:
+			return handleQuerySlashes(ctx, req, keeper)
+		case types.QueryValidatorSlashes:
+			return handleQueryValidatorSlashes(ctx, req, keeper)
+		case types.QuerySigningInfo:
+			return handleQuerySigningInfo(ctx, req, keeper)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown slashing query endpoint")
+		}
+	}
+}
+
+func handleQueryParams(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	bz, err := json.Marshal(keeper.GetParams(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQuerySlashes(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	slashes := keeper.GetAllValidatorSlashes(ctx)
+	if slashes == nil {
+		slashes = make([]types.ValidatorSlash, 0)
+	}
+
+	bz, err := json.Marshal(slashes)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryValidatorSlashes(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryValidatorSlashesParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	slashes := keeper.GetValidatorSlashes(ctx, params.ValidatorID)
+	if slashes == nil {
+		slashes = make([]types.ValidatorSlash, 0)
+	}
+
+	bz, err := json.Marshal(slashes)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQuerySigningInfo(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QuerySigningInfoParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	signingInfo, found := keeper.GetValidatorSigningInfo(ctx, params.ValidatorID)
+	if !found {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("No signing info found for validator %v", params.ValidatorID))
+	}
+
+	bz, err := json.Marshal(signingInfo)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Slashing errors reserve 6500 ~ 6599.
+const (
+	CodeValidatorNotJailed      sdk.CodeType = 6500
+	CodeValidatorJailed                      = 6501
+	CodeValidatorTombstoned                  = 6502
+	CodeValidatorSignerMismatch              = 6503
+)
+
+// ErrValidatorNotJailed represents unjail of validator which is not jailed
+func ErrValidatorNotJailed(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeValidatorNotJailed, "Validator is not jailed")
+}
+
+// ErrValidatorJailed represents unjail before jail duration is over
+func ErrValidatorJailed(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeValidatorJailed, "Validator is still jailed")
+}
+
+// ErrValidatorTombstoned represents unjail of validator jailed for double sign
+func ErrValidatorTombstoned(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeValidatorTombstoned, "Validator is jailed for double sign and can't be unjailed")
+}
+
+// ErrValidatorSignerMismatch represents unjail sent by address other than validator signer
+func ErrValidatorSignerMismatch(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeValidatorSignerMismatch, "Unjail must be sent by validator signer")
+}
@@ -0,0 +1,17 @@
+package types
+
+var (
+	EventTypeSlash    = "slash"
+	EventTypeLiveness = "liveness"
+	EventTypeUnjail   = "unjail"
+
+	AttributeKeyValidatorID      = "validator-id"
+	AttributeKeySigner           = "signer"
+	AttributeKeyAmount           = "amount"
+	AttributeKeyInfractionHeight = "infraction-height"
+	AttributeKeyReason           = "reason"
+	AttributeKeyJailed           = "jailed"
+	AttributeKeyMissedBlocks     = "missed-blocks"
+
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+)
+
+// GenesisState is the slashing state that must be provided at genesis.
+type GenesisState struct {
+	Params       Params                  `json:"params" yaml:"params"`
+	Slashes      []ValidatorSlash        `json:"slashes" yaml:"slashes"`
+	SigningInfos []ValidatorSigningInfo  `json:"signing_infos" yaml:"signing_infos"`
+	MissedBlocks []ValidatorMissedBlocks `json:"missed_blocks" yaml:"missed_blocks"`
+}
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(
+	params Params,
+	slashes []ValidatorSlash,
+	signingInfos []ValidatorSigningInfo,
+	missedBlocks []ValidatorMissedBlocks,
+) GenesisState {
+	return GenesisState{
+		Params:       params,
+		Slashes:      slashes,
+		SigningInfos: signingInfos,
+		MissedBlocks: missedBlocks,
+	}
+}
+
+// DefaultGenesisState returns a default genesis state
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(
+		DefaultParams(),
+		make([]ValidatorSlash, 0),
+		make([]ValidatorSigningInfo, 0),
+		make([]ValidatorMissedBlocks, 0),
+	)
+}
+
+// ValidateGenesis performs basic validation of slashing genesis data returning an
+// error for any failed validation criteria.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	for _, slash := range data.Slashes {
+		if _, ok := big.NewInt(0).SetString(slash.Amount, 10); !ok {
+			return fmt.Errorf("invalid slash amount %v for validator %v", slash.Amount, slash.ValidatorID)
+		}
+	}
+
+	for _, info := range data.SigningInfos {
+		if info.MissedBlocksCounter < 0 || info.IndexOffset < 0 {
+			return fmt.Errorf("invalid signing info for validator %v", info.ValidatorID)
+		}
+	}
+
+	return nil
+}
+
+// GetGenesisStateFromAppState returns slashing GenesisState given raw application genesis state
+func GetGenesisStateFromAppState(appState map[string]json.RawMessage) GenesisState {
+	var genesisState GenesisState
+	if appState[ModuleName] != nil {
+		err := json.Unmarshal(appState[ModuleName], &genesisState)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	return genesisState
+}
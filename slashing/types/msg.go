@@ -0,0 +1,61 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmCommon "github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/types"
+)
+
+//
+// Unjail
+//
+
+var _ sdk.Msg = &MsgUnjail{}
+
+// MsgUnjail represents validator's request to rejoin validator set after being jailed
+type MsgUnjail struct {
+	From types.HeimdallAddress `json:"from"`
+	ID   types.ValidatorID     `json:"id"`
+}
+
+// NewMsgUnjail creates new unjail message
+func NewMsgUnjail(from types.HeimdallAddress, id uint64) MsgUnjail {
+	return MsgUnjail{
+		From: from,
+		ID:   types.NewValidatorID(id),
+	}
+}
+
+// Type returns message type
+func (msg MsgUnjail) Type() string {
+	return "unjail"
+}
+
+// Route returns message route
+func (msg MsgUnjail) Route() string {
+	return RouterKey
+}
+
+// GetSigners returns address of the signer
+func (msg MsgUnjail) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{types.HeimdallAddressToAccAddress(msg.From)}
+}
+
+// GetSignBytes returns sign bytes
+func (msg MsgUnjail) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// ValidateBasic validates the message
+func (msg MsgUnjail) ValidateBasic() sdk.Error {
+	if msg.From.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid from %v", msg.From.String())
+	}
+
+	if msg.ID == 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid validator ID %v", msg.ID)
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params/subspace"
+)
+
+// Default parameter values
+const (
+	DefaultMaxEvidenceAge       int64         = 100000           // number of blocks evidence is accepted for
+	DefaultSignedBlocksWindow   int64         = 100              // number of blocks liveness of validator is tracked for
+	DefaultDowntimeJailDuration time.Duration = 10 * time.Minute // time validator stays jailed for missing blocks
+)
+
+var (
+	// DefaultSlashFractionDoubleSign is fraction of stake slashed for double sign (5%)
+	DefaultSlashFractionDoubleSign = sdk.NewDecWithPrec(5, 2)
+	// DefaultMinSignedPerWindow is minimum ratio of blocks validator has to sign in window (50%)
+	DefaultMinSignedPerWindow = sdk.NewDecWithPrec(5, 1)
+)
+
+// Parameter keys
+var (
+	KeyMaxEvidenceAge          = []byte("MaxEvidenceAge")
+	KeySlashFractionDoubleSign = []byte("SlashFractionDoubleSign")
+	KeySignedBlocksWindow      = []byte("SignedBlocksWindow")
+	KeyMinSignedPerWindow      = []byte("MinSignedPerWindow")
+	KeyDowntimeJailDuration    = []byte("DowntimeJailDuration")
+)
+
+var _ subspace.ParamSet = &Params{}
+
+// Params defines the parameters for the slashing module.
+type Params struct {
+	MaxEvidenceAge          int64         `json:"max_evidence_age" yaml:"max_evidence_age"`
+	SlashFractionDoubleSign sdk.Dec       `json:"slash_fraction_double_sign" yaml:"slash_fraction_double_sign"`
+	SignedBlocksWindow      int64         `json:"signed_blocks_window" yaml:"signed_blocks_window"`
+	MinSignedPerWindow      sdk.Dec       `json:"min_signed_per_window" yaml:"min_signed_per_window"`
+	DowntimeJailDuration    time.Duration `json:"downtime_jail_duration" yaml:"downtime_jail_duration"`
+}
+
+// NewParams creates a new Params object
+func NewParams(
+	maxEvidenceAge int64,
+	slashFractionDoubleSign sdk.Dec,
+	signedBlocksWindow int64,
+	minSignedPerWindow sdk.Dec,
+	downtimeJailDuration time.Duration,
+) Params {
+	return Params{
+		MaxEvidenceAge:          maxEvidenceAge,
+		SlashFractionDoubleSign: slashFractionDoubleSign,
+		SignedBlocksWindow:      signedBlocksWindow,
+		MinSignedPerWindow:      minSignedPerWindow,
+		DowntimeJailDuration:    downtimeJailDuration,
+	}
+}
+
+// ParamKeyTable for slashing module
+func ParamKeyTable() subspace.KeyTable {
+	return subspace.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+// pairs of slashing module's parameters.
+// nolint
+func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
+	return subspace.ParamSetPairs{
+		{Key: KeyMaxEvidenceAge, Value: &p.MaxEvidenceAge},
+		{Key: KeySlashFractionDoubleSign, Value: &p.SlashFractionDoubleSign},
+		{Key: KeySignedBlocksWindow, Value: &p.SignedBlocksWindow},
+		{Key: KeyMinSignedPerWindow, Value: &p.MinSignedPerWindow},
+		{Key: KeyDowntimeJailDuration, Value: &p.DowntimeJailDuration},
+	}
+}
+
+// Equal returns a boolean determining if two Params types are identical.
+func (p Params) Equal(p2 Params) bool {
+	bz1 := ModuleCdc.MustMarshalBinaryLengthPrefixed(&p)
+	bz2 := ModuleCdc.MustMarshalBinaryLengthPrefixed(&p2)
+	return bytes.Equal(bz1, bz2)
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return NewParams(
+		DefaultMaxEvidenceAge,
+		DefaultSlashFractionDoubleSign,
+		DefaultSignedBlocksWindow,
+		DefaultMinSignedPerWindow,
+		DefaultDowntimeJailDuration,
+	)
+}
+
+// String implements the stringer interface.
+func (p Params) String() string {
+	var sb strings.Builder
+	sb.WriteString("Params: \n")
+	sb.WriteString(fmt.Sprintf("MaxEvidenceAge: %d\n", p.MaxEvidenceAge))
+	sb.WriteString(fmt.Sprintf("SlashFractionDoubleSign: %s\n", p.SlashFractionDoubleSign))
+	sb.WriteString(fmt.Sprintf("SignedBlocksWindow: %d\n", p.SignedBlocksWindow))
+	sb.WriteString(fmt.Sprintf("MinSignedPerWindow: %s\n", p.MinSignedPerWindow))
+	sb.WriteString(fmt.Sprintf("DowntimeJailDuration: %s\n", p.DowntimeJailDuration))
+	return sb.String()
+}
+
+// MaxMissedBlocksPerWindow returns number of blocks validator can miss in window before being jailed
+func (p Params) MaxMissedBlocksPerWindow() int64 {
+	minSigned := p.MinSignedPerWindow.MulInt64(p.SignedBlocksWindow).RoundInt64()
+	return p.SignedBlocksWindow - minSigned
+}
+
+// Validate checks that the parameters have valid values.
+func (p Params) Validate() error {
+	if p.MaxEvidenceAge <= 0 {
+		return errors.New("max evidence age should be positive")
+	}
+
+	if p.SlashFractionDoubleSign.IsNil() || p.SlashFractionDoubleSign.IsNegative() || p.SlashFractionDoubleSign.GT(sdk.OneDec()) {
+		return fmt.Errorf("slash fraction for double sign should be between 0 and 1: %s", p.SlashFractionDoubleSign)
+	}
+
+	if p.SignedBlocksWindow <= 0 {
+		return errors.New("signed blocks window should be positive")
+	}
+
+	if p.MinSignedPerWindow.IsNil() || p.MinSignedPerWindow.IsNegative() || p.MinSignedPerWindow.GT(sdk.OneDec()) {
+		return fmt.Errorf("min signed per window should be between 0 and 1: %s", p.MinSignedPerWindow)
+	}
+
+	if p.DowntimeJailDuration <= 0 {
+		return errors.New("downtime jail duration should be positive")
+	}
+
+	return nil
+}
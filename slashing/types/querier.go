@@ -0,0 +1,33 @@
+package types
+
+import (
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// query endpoints supported by the slashing Querier
+const (
+	QueryParams           = "params"
+	QuerySlashes          = "slashes"
+	QueryValidatorSlashes = "validator-slashes"
+	QuerySigningInfo      = "signing-info"
+)
+
+// QueryValidatorSlashesParams defines the params for querying slashes of validator.
+type QueryValidatorSlashesParams struct {
+	ValidatorID hmTypes.ValidatorID `json:"validator_id"`
+}
+
+// NewQueryValidatorSlashesParams creates a new instance of QueryValidatorSlashesParams.
+func NewQueryValidatorSlashesParams(validatorID hmTypes.ValidatorID) QueryValidatorSlashesParams {
+	return QueryValidatorSlashesParams{ValidatorID: validatorID}
+}
+
+// QuerySigningInfoParams defines the params for querying signing info of validator.
+type QuerySigningInfoParams struct {
+	ValidatorID hmTypes.ValidatorID `json:"validator_id"`
+}
+
+// NewQuerySigningInfoParams creates a new instance of QuerySigningInfoParams.
+func NewQuerySigningInfoParams(validatorID hmTypes.ValidatorID) QuerySigningInfoParams {
+	return QuerySigningInfoParams{ValidatorID: validatorID}
+}
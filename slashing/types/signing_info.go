@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// ValidatorSigningInfo tracks liveness of validator over signed blocks window
+type ValidatorSigningInfo struct {
+	ValidatorID         hmTypes.ValidatorID `json:"validator_id"`
+	StartHeight         int64               `json:"start_height"`          // height from which liveness is tracked
+	IndexOffset         int64               `json:"index_offset"`          // index in missed blocks bit array
+	MissedBlocksCounter int64               `json:"missed_blocks_counter"` // missed blocks in current window
+	JailedUntil         time.Time           `json:"jailed_until"`          // validator can't unjail before this time
+	Tombstoned          bool                `json:"tombstoned"`            // validator is jailed for double sign and can't unjail
+}
+
+// NewValidatorSigningInfo creates new signing info
+func NewValidatorSigningInfo(validatorID hmTypes.ValidatorID, startHeight int64) ValidatorSigningInfo {
+	return ValidatorSigningInfo{
+		ValidatorID: validatorID,
+		StartHeight: startHeight,
+	}
+}
+
+// String returns the string representation of signing info
+func (i ValidatorSigningInfo) String() string {
+	return fmt.Sprintf(
+		"ValidatorSigningInfo{%v %v %v %v %v %v}",
+		i.ValidatorID,
+		i.StartHeight,
+		i.IndexOffset,
+		i.MissedBlocksCounter,
+		i.JailedUntil,
+		i.Tombstoned,
+	)
+}
+
+// ValidatorMissedBlocks holds indices of missed blocks of validator in signed blocks window
+type ValidatorMissedBlocks struct {
+	ValidatorID  hmTypes.ValidatorID `json:"validator_id"`
+	MissedBlocks []int64             `json:"missed_blocks"`
+}
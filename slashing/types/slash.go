@@ -0,0 +1,54 @@
+package types
+
+import (
+	"fmt"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// Slash reasons
+const (
+	SlashReasonDoubleSign = "double-sign"
+)
+
+// ValidatorSlash is record of validator slashed for an infraction
+type ValidatorSlash struct {
+	ValidatorID      hmTypes.ValidatorID     `json:"validator_id"`
+	Signer           hmTypes.HeimdallAddress `json:"signer"`
+	Amount           string                  `json:"amount"` // string representation of big.Int
+	InfractionHeight int64                   `json:"infraction_height"`
+	SlashedHeight    int64                   `json:"slashed_height"`
+	Reason           string                  `json:"reason"`
+}
+
+// NewValidatorSlash creates new validator slash record
+func NewValidatorSlash(
+	validatorID hmTypes.ValidatorID,
+	signer hmTypes.HeimdallAddress,
+	amount string,
+	infractionHeight int64,
+	slashedHeight int64,
+	reason string,
+) ValidatorSlash {
+	return ValidatorSlash{
+		ValidatorID:      validatorID,
+		Signer:           signer,
+		Amount:           amount,
+		InfractionHeight: infractionHeight,
+		SlashedHeight:    slashedHeight,
+		Reason:           reason,
+	}
+}
+
+// String returns the string representation of slash
+func (s ValidatorSlash) String() string {
+	return fmt.Sprintf(
+		"ValidatorSlash{%v %v %v %v %v %v}",
+		s.ValidatorID,
+		s.Signer.String(),
+		s.Amount,
+		s.InfractionHeight,
+		s.SlashedHeight,
+		s.Reason,
+	)
+}
@@ -3,6 +3,7 @@ package cli
 const (
 	FlagProposerAddress  = "proposer"
 	FlagValidatorAddress = "validator"
+	FlagDelegatorAddress = "delegator"
 	FlagValidatorID      = "id"
 	FlagSignerAddress    = "signer"
 	FlagSignerPubkey     = "signer-pubkey"
@@ -15,4 +16,13 @@ const (
 
 	FlagStartEpoch = "start-epoch"
 	FlagEndEpoch   = "end-epoch"
+	FlagEpoch      = "epoch"
+
+	FlagHeimdallHeight = "heimdall-height"
+	FlagSteps          = "steps"
+
+	FlagMoniker  = "moniker"
+	FlagWebsite  = "website"
+	FlagContact  = "contact"
+	FlagLogoHash = "logo-hash"
 )
@@ -31,6 +31,10 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 		client.GetCommands(
 			GetValidatorInfo(cdc),
 			GetCurrentValSet(cdc),
+			GetDelegations(cdc),
+			GetAuctions(cdc),
+			GetHistoricalValSet(cdc),
+			GetProposerSchedule(cdc),
 		)...,
 	)
 
@@ -104,3 +108,158 @@ func GetCurrentValSet(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// GetHistoricalValSet validator set snapshot via epoch or heimdall height
+func GetHistoricalValSet(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "historical-validator-set",
+		Short: "show validator set of epoch via epoch or heimdall height",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryHistoricalValidatorSet)
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryHistoricalValidatorSetParams(viper.GetUint64(FlagEpoch)))
+			if err != nil {
+				return err
+			}
+
+			if height := viper.GetInt64(FlagHeimdallHeight); height != 0 {
+				route = fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryHistoricalValidatorSetByHeight)
+				queryParams, err = cliCtx.Codec.MarshalJSON(types.NewQueryHeightParams(height))
+				if err != nil {
+					return err
+				}
+			}
+
+			res, _, err := cliCtx.QueryWithData(route, queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagEpoch, 0, "--epoch=<ack count here>")
+	cmd.Flags().Int64(FlagHeimdallHeight, 0, "--heimdall-height=<heimdall block height here>")
+	return cmd
+}
+
+// GetProposerSchedule checkpoint proposer schedule or next proposer turn of validator
+func GetProposerSchedule(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposer-schedule",
+		Short: "show checkpoint proposers for next ack/no-ack steps or next proposer turn of validator via id",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryProposerSchedule)
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryProposerScheduleParams(viper.GetUint64(FlagSteps)))
+			if err != nil {
+				return err
+			}
+
+			if validatorID := viper.GetInt64(FlagValidatorID); validatorID != 0 {
+				route = fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryProposerTurn)
+				queryParams, err = cliCtx.Codec.MarshalJSON(types.NewQueryValidatorParams(hmTypes.ValidatorID(validatorID)))
+				if err != nil {
+					return err
+				}
+			}
+
+			res, _, err := cliCtx.QueryWithData(route, queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagSteps, 10, "--steps=<number of ack/no-ack steps>")
+	cmd.Flags().Int(FlagValidatorID, 0, "--id=<validator ID here>")
+	return cmd
+}
+
+// GetDelegations delegations to validator via id or of delegator via address
+func GetDelegations(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegations",
+		Short: "show delegations to validator via validator id or of delegator via address",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			validatorID := viper.GetInt64(FlagValidatorID)
+			delegatorStr := viper.GetString(FlagDelegatorAddress)
+			if validatorID == 0 && delegatorStr == "" {
+				return fmt.Errorf("validator ID or delegator address required")
+			}
+
+			var queryParams []byte
+			var err error
+			var t string
+			if delegatorStr != "" {
+				queryParams, err = cliCtx.Codec.MarshalJSON(types.NewQueryDelegatorParams(hmTypes.HexToHeimdallAddress(delegatorStr)))
+				if err != nil {
+					return err
+				}
+				t = types.QueryDelegatorDelegations
+			} else {
+				queryParams, err = cliCtx.Codec.MarshalJSON(types.NewQueryValidatorParams(hmTypes.ValidatorID(validatorID)))
+				if err != nil {
+					return err
+				}
+				t = types.QueryValidatorDelegations
+			}
+
+			// get delegations
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, t), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Int(FlagValidatorID, 0, "--id=<validator ID here>")
+	cmd.Flags().String(FlagDelegatorAddress, "", "--delegator=<delegator address here>")
+	return cmd
+}
+
+// GetAuctions open auctions of all validator slots or of validator with given id
+func GetAuctions(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auctions",
+		Short: "show open auctions of validator slots",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAuctions)
+			var queryParams []byte
+
+			if validatorID := viper.GetInt64(FlagValidatorID); validatorID != 0 {
+				bz, err := cliCtx.Codec.MarshalJSON(types.NewQueryValidatorParams(hmTypes.ValidatorID(validatorID)))
+				if err != nil {
+					return err
+				}
+
+				route = fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAuction)
+				queryParams = bz
+			}
+
+			res, _, err := cliCtx.QueryWithData(route, queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Int(FlagValidatorID, 0, "--id=<validator ID here>")
+	return cmd
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/maticnetwork/bor/common"
+	checkpointCli "github.com/maticnetwork/heimdall/checkpoint/client/cli"
 	hmClient "github.com/maticnetwork/heimdall/client"
 	"github.com/maticnetwork/heimdall/contracts/stakinginfo"
 	"github.com/maticnetwork/heimdall/helper"
@@ -37,6 +38,9 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 			SendValidatorUpdateTx(cdc),
 			SendValidatorExitTx(cdc),
 			SendValidatorStakeUpdateTx(cdc),
+			SendValidatorRestakeTx(cdc),
+			SendValidatorJailedTx(cdc),
+			SendEditValidatorMetadataTx(cdc),
 		)...,
 	)
 	return txCmd
@@ -77,8 +81,14 @@ func SendValidatorJoinTx(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
+			// confirmations required on main chain are checkpoint param
+			confirmationBlocks, err := checkpointCli.GetConfirmationBlocks(cliCtx)
+			if err != nil {
+				return err
+			}
+
 			// get main tx receipt
-			receipt, err := contractCallerObj.GetConfirmedTxReceipt(hmTypes.HexToHeimdallHash(txhash).EthHash())
+			receipt, err := contractCallerObj.GetConfirmedTxReceipt(hmTypes.HexToHeimdallHash(txhash).EthHash(), confirmationBlocks)
 			if err != nil || receipt == nil {
 				return errors.New("Transaction is not confirmed yet. Please for sometime and try again")
 			}
@@ -87,7 +97,7 @@ func SendValidatorJoinTx(cdc *codec.Codec) *cobra.Command {
 			eventName := "Staked"
 			event := new(stakinginfo.StakinginfoStaked)
 			logIndex := -1
-			for i, vLog := range receipt.Logs {
+			for _, vLog := range receipt.Logs {
 				topic := vLog.Topics[0].Bytes()
 				selectedEvent := helper.EventByID(abiObject, topic)
 				if selectedEvent != nil && selectedEvent.Name == eventName {
@@ -95,7 +105,7 @@ func SendValidatorJoinTx(cdc *codec.Codec) *cobra.Command {
 						return err
 					}
 
-					logIndex = i
+					logIndex = int(vLog.Index)
 					break
 				}
 			}
@@ -112,6 +122,8 @@ func SendValidatorJoinTx(cdc *codec.Codec) *cobra.Command {
 			msg := types.NewMsgValidatorJoin(
 				proposer,
 				event.ValidatorId.Uint64(),
+				event.ActivationEpoch.Uint64(),
+				hmTypes.NewIntFromBigInt(event.Amount),
 				pubkey,
 				hmTypes.HexToHeimdallHash(txhash),
 				uint64(logIndex),
@@ -154,12 +166,36 @@ func SendValidatorExitTx(cdc *codec.Codec) *cobra.Command {
 				return fmt.Errorf("transaction hash has to be supplied")
 			}
 
+			contractCallerObj, err := helper.NewContractCaller()
+			if err != nil {
+				return err
+			}
+
+			// confirmations required on main chain are checkpoint param
+			confirmationBlocks, err := checkpointCli.GetConfirmationBlocks(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			// get main tx receipt
+			receipt, err := contractCallerObj.GetConfirmedTxReceipt(hmTypes.HexToHeimdallHash(txhash).EthHash(), confirmationBlocks)
+			if err != nil || receipt == nil {
+				return errors.New("Transaction is not confirmed yet. Please for sometime and try again")
+			}
+
+			logIndex := uint64(viper.GetInt64(FlagLogIndex))
+			event, err := contractCallerObj.DecodeValidatorExitEvent(receipt, logIndex)
+			if err != nil {
+				return err
+			}
+
 			// draf msg
 			msg := types.NewMsgValidatorExit(
 				proposer,
 				uint64(validator),
+				event.DeactivationEpoch.Uint64(),
 				hmTypes.HexToHeimdallHash(txhash),
-				uint64(viper.GetInt64(FlagLogIndex)),
+				logIndex,
 			)
 
 			// broadcast messages
@@ -213,12 +249,30 @@ func SendValidatorUpdateTx(cdc *codec.Codec) *cobra.Command {
 				return fmt.Errorf("transaction hash has to be supplied")
 			}
 
+			contractCallerObj, err := helper.NewContractCaller()
+			if err != nil {
+				return err
+			}
+
+			// confirmations required on main chain are checkpoint param
+			confirmationBlocks, err := checkpointCli.GetConfirmationBlocks(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			// get main tx receipt
+			receipt, err := contractCallerObj.GetConfirmedTxReceipt(hmTypes.HexToHeimdallHash(txhash).EthHash(), confirmationBlocks)
+			if err != nil || receipt == nil {
+				return errors.New("Transaction is not confirmed yet. Please for sometime and try again")
+			}
+
 			msg := types.NewMsgSignerUpdate(
 				proposer,
 				uint64(validator),
 				pubkey,
 				hmTypes.HexToHeimdallHash(txhash),
 				uint64(viper.GetInt64(FlagLogIndex)),
+				receipt.BlockNumber.Uint64(),
 			)
 
 			// broadcast messages
@@ -262,11 +316,36 @@ func SendValidatorStakeUpdateTx(cdc *codec.Codec) *cobra.Command {
 				return fmt.Errorf("transaction hash has to be supplied")
 			}
 
+			contractCallerObj, err := helper.NewContractCaller()
+			if err != nil {
+				return err
+			}
+
+			// confirmations required on main chain are checkpoint param
+			confirmationBlocks, err := checkpointCli.GetConfirmationBlocks(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			// get main tx receipt
+			receipt, err := contractCallerObj.GetConfirmedTxReceipt(hmTypes.HexToHeimdallHash(txhash).EthHash(), confirmationBlocks)
+			if err != nil || receipt == nil {
+				return errors.New("Transaction is not confirmed yet. Please for sometime and try again")
+			}
+
+			logIndex := uint64(viper.GetInt64(FlagLogIndex))
+			event, err := contractCallerObj.DecodeValidatorStakeUpdateEvent(receipt, logIndex)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgStakeUpdate(
 				proposer,
 				uint64(validator),
+				hmTypes.NewIntFromBigInt(event.NewAmount),
 				hmTypes.HexToHeimdallHash(txhash),
-				uint64(viper.GetInt64(FlagLogIndex)),
+				logIndex,
+				receipt.BlockNumber.Uint64(),
 			)
 
 			// broadcast messages
@@ -283,3 +362,182 @@ func SendValidatorStakeUpdateTx(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// SendValidatorRestakeTx send validator restake transaction
+func SendValidatorRestakeTx(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restake",
+		Short: "Update stake of validator restaked on root chain",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// get proposer
+			proposer := hmTypes.HexToHeimdallAddress(viper.GetString(FlagProposerAddress))
+			if proposer.Empty() {
+				proposer = helper.GetFromAddress(cliCtx)
+			}
+
+			validator := viper.GetInt64(FlagValidatorID)
+			if validator == 0 {
+				return fmt.Errorf("validator ID cannot be 0")
+			}
+
+			txhash := viper.GetString(FlagTxHash)
+			if txhash == "" {
+				return fmt.Errorf("transaction hash has to be supplied")
+			}
+
+			contractCallerObj, err := helper.NewContractCaller()
+			if err != nil {
+				return err
+			}
+
+			// confirmations required on main chain are checkpoint param
+			confirmationBlocks, err := checkpointCli.GetConfirmationBlocks(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			// get main tx receipt
+			receipt, err := contractCallerObj.GetConfirmedTxReceipt(hmTypes.HexToHeimdallHash(txhash).EthHash(), confirmationBlocks)
+			if err != nil || receipt == nil {
+				return errors.New("Transaction is not confirmed yet. Please for sometime and try again")
+			}
+
+			logIndex := uint64(viper.GetInt64(FlagLogIndex))
+			event, err := contractCallerObj.DecodeValidatorReStakedEvent(receipt, logIndex)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgValidatorRestake(
+				proposer,
+				uint64(validator),
+				hmTypes.NewIntFromBigInt(event.Total),
+				hmTypes.HexToHeimdallHash(txhash),
+				logIndex,
+				receipt.BlockNumber.Uint64(),
+			)
+
+			// broadcast messages
+			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().StringP(FlagProposerAddress, "p", "", "--proposer=<proposer-address>")
+	cmd.Flags().Int(FlagValidatorID, 0, "--id=<validator-id>")
+	cmd.Flags().String(FlagTxHash, "", "--tx-hash=<transaction-hash>")
+	cmd.Flags().String(FlagLogIndex, "", "--log-index=<log-index>")
+	cmd.MarkFlagRequired(FlagTxHash)
+	cmd.MarkFlagRequired(FlagLogIndex)
+
+	return cmd
+}
+
+// SendValidatorJailedTx send validator jailed transaction
+func SendValidatorJailedTx(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-jailed",
+		Short: "Set exit epoch of validator jailed on root chain",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// get proposer
+			proposer := hmTypes.HexToHeimdallAddress(viper.GetString(FlagProposerAddress))
+			if proposer.Empty() {
+				proposer = helper.GetFromAddress(cliCtx)
+			}
+
+			validator := viper.GetInt64(FlagValidatorID)
+			if validator == 0 {
+				return fmt.Errorf("validator ID cannot be 0")
+			}
+
+			txhash := viper.GetString(FlagTxHash)
+			if txhash == "" {
+				return fmt.Errorf("transaction hash has to be supplied")
+			}
+
+			contractCallerObj, err := helper.NewContractCaller()
+			if err != nil {
+				return err
+			}
+
+			// confirmations required on main chain are checkpoint param
+			confirmationBlocks, err := checkpointCli.GetConfirmationBlocks(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			// get main tx receipt
+			receipt, err := contractCallerObj.GetConfirmedTxReceipt(hmTypes.HexToHeimdallHash(txhash).EthHash(), confirmationBlocks)
+			if err != nil || receipt == nil {
+				return errors.New("Transaction is not confirmed yet. Please for sometime and try again")
+			}
+
+			logIndex := uint64(viper.GetInt64(FlagLogIndex))
+			event, err := contractCallerObj.DecodeValidatorJailedEvent(receipt, logIndex)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgValidatorJailed(
+				proposer,
+				uint64(validator),
+				event.ExitEpoch.Uint64(),
+				hmTypes.HexToHeimdallHash(txhash),
+				logIndex,
+				receipt.BlockNumber.Uint64(),
+			)
+
+			// broadcast messages
+			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().StringP(FlagProposerAddress, "p", "", "--proposer=<proposer-address>")
+	cmd.Flags().Int(FlagValidatorID, 0, "--id=<validator-id>")
+	cmd.Flags().String(FlagTxHash, "", "--tx-hash=<transaction-hash>")
+	cmd.Flags().String(FlagLogIndex, "", "--log-index=<log-index>")
+	cmd.MarkFlagRequired(FlagTxHash)
+	cmd.MarkFlagRequired(FlagLogIndex)
+
+	return cmd
+}
+
+// SendEditValidatorMetadataTx send edit validator metadata transaction
+func SendEditValidatorMetadataTx(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "edit-validator-metadata",
+		Short: "Edit descriptive metadata of validator (signed by current signer)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			validator := viper.GetInt64(FlagValidatorID)
+			if validator == 0 {
+				return fmt.Errorf("validator ID cannot be 0")
+			}
+
+			msg := types.NewMsgEditValidatorMetadata(
+				helper.GetFromAddress(cliCtx),
+				uint64(validator),
+				viper.GetString(FlagMoniker),
+				viper.GetString(FlagWebsite),
+				viper.GetString(FlagContact),
+				viper.GetString(FlagLogoHash),
+			)
+
+			// broadcast messages
+			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Int(FlagValidatorID, 0, "--id=<validator-id>")
+	cmd.Flags().String(FlagMoniker, "", "--moniker=<moniker>")
+	cmd.Flags().String(FlagWebsite, "", "--website=<website>")
+	cmd.Flags().String(FlagContact, "", "--contact=<contact>")
+	cmd.Flags().String(FlagLogoHash, "", "--logo-hash=<logo-hash>")
+	cmd.MarkFlagRequired(FlagValidatorID)
+
+	return cmd
+}
@@ -4,8 +4,8 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
-	"math/big"
 	"net/http"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/types/rest"
@@ -30,21 +30,49 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		"/staking/validator/{id}",
 		validatorByIDHandlerFn(cliCtx),
 	).Methods("GET")
+	r.HandleFunc(
+		"/staking/validator/{id}/delegations",
+		validatorDelegationsHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/staking/delegator/{address}",
+		delegatorDelegationsHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/staking/auctions",
+		auctionsHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/staking/auction/{id}",
+		auctionByIDHandlerFn(cliCtx),
+	).Methods("GET")
 	r.HandleFunc(
 		"/staking/validator-set",
 		validatorSetHandlerFn(cliCtx),
 	).Methods("GET")
+	r.HandleFunc(
+		"/staking/validator-set/{epoch}",
+		historicalValidatorSetHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/staking/params",
+		paramsHandlerFn(cliCtx),
+	).Methods("GET")
 	r.HandleFunc(
 		"/staking/proposer/{times}",
 		proposerHandlerFn(cliCtx),
 	).Methods("GET")
 	r.HandleFunc(
-		"/staking/current-proposer",
-		currentProposerHandlerFn(cliCtx),
+		"/staking/proposer-schedule/{steps}",
+		proposerScheduleHandlerFn(cliCtx),
 	).Methods("GET")
 	r.HandleFunc(
-		"/staking/slash-validator",
-		slashValidatorHandlerFn(cliCtx),
+		"/staking/validator/{id}/proposer-turn",
+		proposerTurnHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/staking/current-proposer",
+		currentProposerHandlerFn(cliCtx),
 	).Methods("GET")
 	r.HandleFunc(
 		"/staking/dividend-account/{id}",
@@ -193,9 +221,15 @@ func validatorByIDHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
-// get current validator set
+// get current validator set, or validator set snapshot active at given height
 func validatorSetHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		// snapshots are looked up in latest state, which works on pruned nodes too
+		if heightStr := r.FormValue("height"); heightStr != "" {
+			historicalValidatorSetByHeight(w, cliCtx, heightStr)
+			return
+		}
+
 		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
 		if !ok {
 			return
@@ -260,22 +294,37 @@ func proposerHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
-// currentProposerHandlerFn get proposer for current validator set
-func currentProposerHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+// proposerScheduleHandlerFn projects checkpoint proposers for next ack/no-ack steps
+func proposerScheduleHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
 		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
 		if !ok {
 			return
 		}
 
-		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCurrentProposer), nil)
+		// get steps
+		steps, ok := rest.ParseUint64OrReturnBadRequest(w, vars["steps"])
+		if !ok {
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryProposerScheduleParams(steps))
 		if err != nil {
-			RestLogger.Error("Error while fetching current proposer ", "Error", err.Error())
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryProposerSchedule), queryParams)
+		if err != nil {
+			RestLogger.Error("Error while fetching proposer schedule", "Error", err.Error())
 			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 
-		// error if no checkpoint found
+		// error if no proposer found
 		if ok := hmRest.ReturnNotFoundIfNoContent(w, res, "No proposer found"); !ok {
 			return
 		}
@@ -286,48 +335,64 @@ func currentProposerHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
-func slashValidatorHandlerFn(
-	cliCtx context.CLIContext,
-) http.HandlerFunc {
+// proposerTurnHandlerFn returns ack/no-ack steps after which validator becomes proposer
+func proposerTurnHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
 
 		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
 		if !ok {
 			return
 		}
 
-		params := r.URL.Query()
-		valID, ok := rest.ParseUint64OrReturnBadRequest(w, params.Get("val_id"))
+		// get id
+		id, ok := rest.ParseUint64OrReturnBadRequest(w, vars["id"])
 		if !ok {
 			return
 		}
 
-		slashAmount, ok := big.NewInt(0).SetString(params.Get("slash_amount"), 10)
-		if !ok {
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryValidatorParams(hmTypes.ValidatorID(id)))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
 
-		RestLogger.Info("Slashing validator - ", "valID", valID, "slashAmount", slashAmount)
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryProposerTurn), queryParams)
+		if err != nil {
+			RestLogger.Error("Error while fetching proposer turn", "Error", err.Error())
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
 
-		slashingParams := types.NewValidatorSlashParams(hmTypes.ValidatorID(valID), slashAmount)
+		// return result
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
 
-		bz, err := cliCtx.Codec.MarshalJSON(slashingParams)
-		if err != nil {
-			RestLogger.Info("Error marshallingSlashing Params - ", "err", err)
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+// currentProposerHandlerFn get proposer for current validator set
+func currentProposerHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
 			return
 		}
 
-		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySlashValidator), bz)
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCurrentProposer), nil)
 		if err != nil {
-			RestLogger.Info("Error query data - ", "err", err)
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			RestLogger.Error("Error while fetching current proposer ", "Error", err.Error())
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 
-		RestLogger.Info("Slashed validator successfully ", "res", res)
+		// error if no checkpoint found
+		if ok := hmRest.ReturnNotFoundIfNoContent(w, res, "No proposer found"); !ok {
+			return
+		}
 
-		cliCtx.WithHeight(height)
+		// return result
+		cliCtx = cliCtx.WithHeight(height)
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
@@ -545,3 +610,229 @@ func VerifyAccountProofHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 
 	}
 }
+
+// Returns delegations to validator by val ID
+func validatorDelegationsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get id
+		id, ok := rest.ParseUint64OrReturnBadRequest(w, vars["id"])
+		if !ok {
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryValidatorParams(hmTypes.ValidatorID(id)))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorDelegations), queryParams)
+		if err != nil {
+			RestLogger.Error("Error while fetching validator delegations", "Error", err.Error())
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// return result
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// Returns delegations of delegator by address
+func delegatorDelegationsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		delegator := hmTypes.HexToHeimdallAddress(vars["address"])
+		if delegator.Empty() {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, "Invalid delegator address")
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryDelegatorParams(delegator))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDelegatorDelegations), queryParams)
+		if err != nil {
+			RestLogger.Error("Error while fetching delegator delegations", "Error", err.Error())
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// return result
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// Returns all open auctions
+func auctionsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAuctions), nil)
+		if err != nil {
+			RestLogger.Error("Error while fetching auctions", "Error", err.Error())
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// return result
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// Returns open auction for validator slot by val ID
+func auctionByIDHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get id
+		id, ok := rest.ParseUint64OrReturnBadRequest(w, vars["id"])
+		if !ok {
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryValidatorParams(hmTypes.ValidatorID(id)))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAuction), queryParams)
+		if err != nil {
+			RestLogger.Error("Error while fetching auction", "Error", err.Error())
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// error if no auction found
+		if ok := hmRest.ReturnNotFoundIfNoContent(w, res, "No auction found"); !ok {
+			return
+		}
+
+		// return result
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// Returns validator set snapshot which was active at heimdall height
+func historicalValidatorSetByHeight(w http.ResponseWriter, cliCtx context.CLIContext, heightStr string) {
+	height, err := strconv.ParseInt(heightStr, 10, 64)
+	if err != nil || height <= 0 {
+		hmRest.WriteErrorResponse(w, http.StatusBadRequest, "height must be a positive integer")
+		return
+	}
+
+	// get query params
+	queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryHeightParams(height))
+	if err != nil {
+		hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	res, resHeight, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryHistoricalValidatorSetByHeight), queryParams)
+	if err != nil {
+		RestLogger.Error("Error while fetching historical validator set", "Error", err.Error())
+		hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// error if no validator set found
+	if ok := hmRest.ReturnNotFoundIfNoContent(w, res, "No validator set found"); !ok {
+		return
+	}
+
+	// return result
+	cliCtx = cliCtx.WithHeight(resHeight)
+	rest.PostProcessResponse(w, cliCtx, res)
+}
+
+// Returns validator set snapshot of epoch (ack count)
+func historicalValidatorSetHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get epoch
+		epoch, ok := rest.ParseUint64OrReturnBadRequest(w, vars["epoch"])
+		if !ok {
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryHistoricalValidatorSetParams(epoch))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryHistoricalValidatorSet), queryParams)
+		if err != nil {
+			RestLogger.Error("Error while fetching historical validator set", "Error", err.Error())
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// error if no validator set found
+		if ok := hmRest.ReturnNotFoundIfNoContent(w, res, "No validator set found"); !ok {
+			return
+		}
+
+		// return result
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// Returns staking params
+func paramsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParams), nil)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
@@ -21,6 +21,7 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/staking/validators/stake", newValidatorStakeUpdateHandler(cliCtx)).Methods("PUT")
 	r.HandleFunc("/staking/validators", newValidatorUpdateHandler(cliCtx)).Methods("PUT")
 	r.HandleFunc("/staking/validators", newValidatorExitHandler(cliCtx)).Methods("DELETE")
+	r.HandleFunc("/staking/validators/metadata", newEditValidatorMetadataHandler(cliCtx)).Methods("PUT")
 }
 
 type (
@@ -28,10 +29,12 @@ type (
 	AddValidatorReq struct {
 		BaseReq rest.BaseReq `json:"base_req"`
 
-		ID           uint64         `json:"ID"`
-		SignerPubKey hmTypes.PubKey `json:"pubKey"`
-		TxHash       string         `json:"tx_hash"`
-		LogIndex     uint64         `json:"log_index"`
+		ID              uint64         `json:"ID"`
+		ActivationEpoch uint64         `json:"activationEpoch"`
+		Amount          string         `json:"amount"`
+		SignerPubKey    hmTypes.PubKey `json:"pubKey"`
+		TxHash          string         `json:"tx_hash"`
+		LogIndex        uint64         `json:"log_index"`
 	}
 
 	// UpdateSignerReq update validator signer request object
@@ -42,24 +45,39 @@ type (
 		NewSignerPubKey hmTypes.PubKey `json:"pubKey"`
 		TxHash          string         `json:"tx_hash"`
 		LogIndex        uint64         `json:"log_index"`
+		BlockNumber     uint64         `json:"block_number"`
 	}
 
 	// UpdateValidatorStakeReq update validator stake request object
 	UpdateValidatorStakeReq struct {
 		BaseReq rest.BaseReq `json:"base_req"`
 
-		ID       uint64 `json:"ID"`
-		TxHash   string `json:"tx_hash"`
-		LogIndex uint64 `json:"log_index"`
+		ID          uint64 `json:"ID"`
+		NewAmount   string `json:"amount"`
+		TxHash      string `json:"tx_hash"`
+		LogIndex    uint64 `json:"log_index"`
+		BlockNumber uint64 `json:"block_number"`
 	}
 
 	// RemoveValidatorReq remove validator request object
 	RemoveValidatorReq struct {
 		BaseReq rest.BaseReq `json:"base_req"`
 
+		ID                uint64 `json:"ID"`
+		DeactivationEpoch uint64 `json:"deactivationEpoch"`
+		TxHash            string `json:"tx_hash"`
+		LogIndex          uint64 `json:"log_index"`
+	}
+
+	// EditValidatorMetadataReq edit validator metadata request object
+	EditValidatorMetadataReq struct {
+		BaseReq rest.BaseReq `json:"base_req"`
+
 		ID       uint64 `json:"ID"`
-		TxHash   string `json:"tx_hash"`
-		LogIndex uint64 `json:"log_index"`
+		Moniker  string `json:"moniker"`
+		Website  string `json:"website"`
+		Contact  string `json:"contact"`
+		LogoHash string `json:"logo_hash"`
 	}
 )
 
@@ -76,10 +94,18 @@ func newValidatorJoinHandler(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
+		amount, ok := hmTypes.NewIntFromString(req.Amount)
+		if !ok {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "invalid amount")
+			return
+		}
+
 		// create new msg
 		msg := types.NewMsgValidatorJoin(
 			hmTypes.HexToHeimdallAddress(req.BaseReq.From),
 			req.ID,
+			req.ActivationEpoch,
+			amount,
 			req.SignerPubKey,
 			hmTypes.HexToHeimdallHash(req.TxHash),
 			req.LogIndex,
@@ -107,6 +133,7 @@ func newValidatorExitHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		msg := types.NewMsgValidatorExit(
 			hmTypes.HexToHeimdallAddress(req.BaseReq.From),
 			req.ID,
+			req.DeactivationEpoch,
 			hmTypes.HexToHeimdallHash(req.TxHash),
 			req.LogIndex,
 		)
@@ -136,6 +163,7 @@ func newValidatorUpdateHandler(cliCtx context.CLIContext) http.HandlerFunc {
 			req.NewSignerPubKey,
 			hmTypes.HexToHeimdallHash(req.TxHash),
 			req.LogIndex,
+			req.BlockNumber,
 		)
 
 		// send response
@@ -156,12 +184,48 @@ func newValidatorStakeUpdateHandler(cliCtx context.CLIContext) http.HandlerFunc
 			return
 		}
 
+		newAmount, ok := hmTypes.NewIntFromString(req.NewAmount)
+		if !ok {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "invalid amount")
+			return
+		}
+
 		// create msg validator update
 		msg := types.NewMsgStakeUpdate(
 			hmTypes.HexToHeimdallAddress(req.BaseReq.From),
 			req.ID,
+			newAmount,
 			hmTypes.HexToHeimdallHash(req.TxHash),
 			req.LogIndex,
+			req.BlockNumber,
+		)
+
+		// send response
+		restClient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func newEditValidatorMetadataHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// read req from request
+		var req EditValidatorMetadataReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// draft new msg
+		msg := types.NewMsgEditValidatorMetadata(
+			hmTypes.HexToHeimdallAddress(req.BaseReq.From),
+			req.ID,
+			req.Moniker,
+			req.Website,
+			req.Contact,
+			req.LogoHash,
 		)
 
 		// send response
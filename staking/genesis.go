@@ -1,6 +1,8 @@
 package staking
 
 import (
+	"math/big"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/maticnetwork/heimdall/staking/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
@@ -8,6 +10,8 @@ import (
 
 // InitGenesis sets distribution information for genesis.
 func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
+	keeper.SetParams(ctx, data.Params)
+
 	// get current val set
 	var vals []*hmTypes.Validator
 	if len(data.CurrentValSet.Validators) == 0 {
@@ -16,6 +20,14 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 		vals = data.CurrentValSet.Validators
 	}
 
+	// validators exported before staked amount was tracked get amount from voting power
+	powerDivisor := data.Params.PowerDivisor.BigInt()
+	for _, validator := range vals {
+		if validator.Amount.I == nil {
+			validator.Amount = hmTypes.NewIntFromBigInt(new(big.Int).Mul(big.NewInt(validator.VotingPower), powerDivisor))
+		}
+	}
+
 	// result
 	resultValSet := hmTypes.NewValidatorSet(vals)
 
@@ -38,6 +50,34 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 		}
 	}
 
+	// Add genesis delegations
+	for _, delegation := range data.Delegations {
+		if err := keeper.SetDelegation(ctx, delegation); err != nil {
+			panic(err)
+		}
+	}
+
+	// Add genesis auctions
+	for _, auction := range data.Auctions {
+		if err := keeper.SetAuction(ctx, auction); err != nil {
+			panic(err)
+		}
+	}
+
+	// Add genesis validator set snapshots
+	for _, historical := range data.HistoricalValidatorSets {
+		if err := keeper.SetHistoricalValidatorSet(ctx, historical); err != nil {
+			panic(err)
+		}
+	}
+
+	// Add genesis validator metadata
+	for _, metadata := range data.ValidatorMetadata {
+		if err := keeper.SetValidatorMetadata(ctx, metadata); err != nil {
+			panic(err)
+		}
+	}
+
 	// increament accum if init validator set
 	if len(data.CurrentValSet.Validators) == 0 {
 		keeper.IncrementAccum(ctx, 1)
@@ -48,8 +88,13 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 	// return new genesis state
 	return types.NewGenesisState(
+		keeper.GetParams(ctx),
 		keeper.GetAllValidators(ctx),
 		keeper.GetValidatorSet(ctx),
 		keeper.GetAllDividendAccounts(ctx),
+		keeper.GetAllDelegations(ctx),
+		keeper.GetAllAuctions(ctx),
+		keeper.GetAllHistoricalValidatorSets(ctx),
+		keeper.GetAllValidatorMetadata(ctx),
 	)
 }
@@ -5,6 +5,7 @@ import (
 	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
 
 	hmCommon "github.com/maticnetwork/heimdall/common"
 	"github.com/maticnetwork/heimdall/helper"
@@ -19,169 +20,186 @@ func NewHandler(k Keeper, contractCaller helper.IContractCaller) sdk.Handler {
 
 		switch msg := msg.(type) {
 		case types.MsgValidatorJoin:
-			return HandleMsgValidatorJoin(ctx, msg, k, contractCaller)
+			return HandleMsgValidatorJoin(ctx, msg, k)
 		case types.MsgValidatorExit:
-			return HandleMsgValidatorExit(ctx, msg, k, contractCaller)
+			return HandleMsgValidatorExit(ctx, msg, k)
 		case types.MsgSignerUpdate:
-			return HandleMsgSignerUpdate(ctx, msg, k, contractCaller)
+			return HandleMsgSignerUpdate(ctx, msg, k)
 		case types.MsgStakeUpdate:
-			return HandleMsgStakeUpdate(ctx, msg, k, contractCaller)
+			return HandleMsgStakeUpdate(ctx, msg, k)
+		case types.MsgValidatorRestake:
+			return HandleMsgValidatorRestake(ctx, msg, k)
+		case types.MsgValidatorJailed:
+			return HandleMsgValidatorJailed(ctx, msg, k)
+		case types.MsgDelegate:
+			return HandleMsgDelegate(ctx, msg, k)
+		case types.MsgUndelegate:
+			return HandleMsgUndelegate(ctx, msg, k)
+		case types.MsgStartAuction:
+			return HandleMsgStartAuction(ctx, msg, k)
+		case types.MsgConfirmAuction:
+			return HandleMsgConfirmAuction(ctx, msg, k)
+		case types.MsgEditValidatorMetadata:
+			return HandleMsgEditValidatorMetadata(ctx, msg, k)
 		default:
 			return sdk.ErrTxDecode("Invalid message in checkpoint module").Result()
 		}
 	}
 }
 
-// HandleMsgValidatorJoin msg validator join
-func HandleMsgValidatorJoin(ctx sdk.Context, msg types.MsgValidatorJoin, k Keeper, contractCaller helper.IContractCaller) sdk.Result {
+// HandleMsgValidatorJoin msg validator join.
+// Only state checks are done here, validator is added once side tx is approved.
+func HandleMsgValidatorJoin(ctx sdk.Context, msg types.MsgValidatorJoin, k Keeper) sdk.Result {
 	k.Logger(ctx).Info("Handing new validator join", "msg", msg)
 
-	if confirmed := contractCaller.IsTxConfirmed(msg.TxHash.EthHash()); !confirmed {
-		return hmCommon.ErrWaitForConfirmation(k.Codespace()).Result()
+	if err := validateValidatorJoin(ctx, msg, k); err != nil {
+		return err.Result()
 	}
 
-	// fetch validator from mainchain
-	validator, err := contractCaller.GetValidatorInfo(msg.ID)
-	if err != nil {
-		k.Logger(ctx).Error(
-			"Unable to fetch validator from rootchain",
-			"error", err,
-		)
-		return hmCommon.ErrNoValidator(k.Codespace()).Result()
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
 	}
+}
 
-	if bytes.Equal(validator.Signer.Bytes(), helper.ZeroAddress.Bytes()) {
-		k.Logger(ctx).Error(
-			"No validator signer found",
-			"msgValidator", msg.ID,
-		)
-		return hmCommon.ErrNoValidator(k.Codespace()).Result()
+// HandleMsgStakeUpdate handles stake update message
+func HandleMsgStakeUpdate(ctx sdk.Context, msg types.MsgStakeUpdate, k Keeper) sdk.Result {
+	k.Logger(ctx).Debug("Handling stake update", "Validator", msg.ID)
+
+	if _, err := validateStakingSequence(ctx, msg.ID, msg.BlockNumber, msg.LogIndex, k); err != nil {
+		return err.Result()
 	}
 
-	k.Logger(ctx).Debug("Fetched validator from rootchain successfully", "validator", validator.String())
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
 
-	// Generate PubKey from Pubkey in message and signer
-	pubkey := msg.SignerPubKey
-	signer := pubkey.Address()
+// HandleMsgSignerUpdate handles signer update message
+func HandleMsgSignerUpdate(ctx sdk.Context, msg types.MsgSignerUpdate, k Keeper) sdk.Result {
+	k.Logger(ctx).Debug("Handling signer update", "Validator", msg.ID, "Signer", msg.NewSignerPubKey.Address())
 
-	// check signer in message corresponds
-	if !bytes.Equal(signer.Bytes(), validator.Signer.Bytes()) {
-		k.Logger(ctx).Error(
-			"Signer Address does not match",
-			"msgValidator", signer.String(),
-			"mainchainValidator", validator.Signer.String(),
-		)
-		return hmCommon.ErrNoValidator(k.Codespace()).Result()
+	if _, err := validateStakingSequence(ctx, msg.ID, msg.BlockNumber, msg.LogIndex, k); err != nil {
+		return err.Result()
 	}
 
-	// Check if validator has been validator before
-	if _, ok := k.GetSignerFromValidatorID(ctx, msg.ID); ok {
-		k.Logger(ctx).Error("Validator has been validator before, cannot join with same ID", "validatorId", msg.ID)
-		return hmCommon.ErrValidatorAlreadyJoined(k.Codespace()).Result()
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
 	}
+}
 
-	// get validator by signer
-	checkVal, err := k.GetValidatorInfo(ctx, signer.Bytes())
-	if err == nil || bytes.Equal(checkVal.Signer.Bytes(), signer.Bytes()) {
-		return hmCommon.ErrValidatorAlreadyJoined(k.Codespace()).Result()
+// HandleMsgValidatorRestake handles validator restake message
+func HandleMsgValidatorRestake(ctx sdk.Context, msg types.MsgValidatorRestake, k Keeper) sdk.Result {
+	k.Logger(ctx).Debug("Handling validator restake", "Validator", msg.ID, "NewAmount", msg.NewAmount)
+
+	if _, err := validateStakingSequence(ctx, msg.ID, msg.BlockNumber, msg.LogIndex, k); err != nil {
+		return err.Result()
 	}
 
-	// create new validator
-	newValidator := hmTypes.Validator{
-		ID:          validator.ID,
-		StartEpoch:  validator.StartEpoch,
-		EndEpoch:    validator.EndEpoch,
-		VotingPower: validator.VotingPower,
-		PubKey:      pubkey,
-		Signer:      validator.Signer,
-		LastUpdated: 0,
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
 	}
+}
 
-	// add validator to store
-	k.Logger(ctx).Debug("Adding new validator to state", "validator", newValidator.String())
-	err = k.AddValidator(ctx, newValidator)
-	if err != nil {
-		k.Logger(ctx).Error("Unable to add validator to state", "error", err, "validator", newValidator.String())
-		return hmCommon.ErrValidatorSave(k.Codespace()).Result()
+// HandleMsgValidatorJailed handles validator jailed message
+func HandleMsgValidatorJailed(ctx sdk.Context, msg types.MsgValidatorJailed, k Keeper) sdk.Result {
+	k.Logger(ctx).Debug("Handling validator jailed", "Validator", msg.ID, "ExitEpoch", msg.ExitEpoch)
+
+	if _, err := validateStakingSequence(ctx, msg.ID, msg.BlockNumber, msg.LogIndex, k); err != nil {
+		return err.Result()
 	}
 
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeValidatorJoin,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-			sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(newValidator.ID.Uint64(), 10)),
-			sdk.NewAttribute(types.AttributeKeySigner, newValidator.Signer.String()),
-		),
-	})
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// HandleMsgDelegate handles delegate message
+func HandleMsgDelegate(ctx sdk.Context, msg types.MsgDelegate, k Keeper) sdk.Result {
+	k.Logger(ctx).Debug("Handling delegate", "Validator", msg.ID, "Delegator", msg.Delegator)
+
+	if _, err := validateStakingSequence(ctx, msg.ID, msg.BlockNumber, msg.LogIndex, k); err != nil {
+		return err.Result()
+	}
 
 	return sdk.Result{
 		Events: ctx.EventManager().Events(),
 	}
 }
 
-// HandleMsgStakeUpdate handles stake update message
-func HandleMsgStakeUpdate(ctx sdk.Context, msg types.MsgStakeUpdate, k Keeper, contractCaller helper.IContractCaller) sdk.Result {
-	k.Logger(ctx).Debug("Handling stake update", "Validator", msg.ID)
+// HandleMsgUndelegate handles undelegate message
+func HandleMsgUndelegate(ctx sdk.Context, msg types.MsgUndelegate, k Keeper) sdk.Result {
+	k.Logger(ctx).Debug("Handling undelegate", "Validator", msg.ID, "Delegator", msg.Delegator)
 
-	// get main tx receipt
-	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash())
-	if err != nil || receipt == nil {
-		return hmCommon.ErrWaitForConfirmation(k.Codespace()).Result()
+	if _, _, err := validateUndelegate(ctx, msg, k); err != nil {
+		return err.Result()
 	}
 
-	eventLog, err := contractCaller.DecodeValidatorStakeUpdateEvent(receipt, msg.LogIndex)
-	if err != nil || eventLog == nil {
-		k.Logger(ctx).Error("Error fetching log from txhash")
-		return hmCommon.ErrInvalidMsg(k.Codespace(), "Unable to fetch logs for txHash").Result()
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
 	}
+}
+
+// HandleMsgStartAuction handles start auction message
+func HandleMsgStartAuction(ctx sdk.Context, msg types.MsgStartAuction, k Keeper) sdk.Result {
+	k.Logger(ctx).Debug("Handling start auction", "Validator", msg.ID, "AuctionAmount", msg.AuctionAmount)
 
-	if eventLog.ValidatorId.Uint64() != msg.ID.Uint64() {
-		k.Logger(ctx).Error("ID in message doesnt match id in logs", "MsgID", msg.ID, "IdFromTx", eventLog.ValidatorId)
-		return hmCommon.ErrInvalidMsg(k.Codespace(), "Invalid txhash, id's dont match. Id from tx hash is %v", eventLog.ValidatorId.Uint64()).Result()
+	if _, err := validateStakingSequence(ctx, msg.ID, msg.BlockNumber, msg.LogIndex, k); err != nil {
+		return err.Result()
 	}
 
-	// pull validator from store
-	validator, ok := k.GetValidatorFromValID(ctx, msg.ID)
-	if !ok {
-		k.Logger(ctx).Error("Fetching of validator from store failed", "validatorId", msg.ID)
-		return hmCommon.ErrNoValidator(k.Codespace()).Result()
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
 	}
+}
 
-	// sequence id
-	sequence := (receipt.BlockNumber.Uint64() * hmTypes.DefaultLogIndexUnit) + msg.LogIndex
+// HandleMsgConfirmAuction handles confirm auction message
+func HandleMsgConfirmAuction(ctx sdk.Context, msg types.MsgConfirmAuction, k Keeper) sdk.Result {
+	k.Logger(ctx).Debug("Handling confirm auction", "OldValidator", msg.OldID, "NewValidator", msg.NewID)
 
-	// check if incoming tx is older
-	if k.HasStakingSequence(ctx, sequence) {
-		k.Logger(ctx).Error("Older invalid tx found")
-		return hmCommon.ErrOldTx(k.Codespace()).Result()
+	if _, err := validateConfirmAuction(ctx, msg, k); err != nil {
+		return err.Result()
 	}
 
-	// update last updated
-	validator.LastUpdated = sequence
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
 
-	// set validator amount
-	p, err := helper.GetPowerFromAmount(eventLog.NewAmount)
-	if err != nil {
-		return hmCommon.ErrInvalidMsg(k.Codespace(), "Invalid amount for validator: %v", msg.ID).Result()
+// HandleMsgEditValidatorMetadata sets descriptive metadata of validator.
+// Metadata isn't part of root chain state, so it is applied directly without side tx.
+func HandleMsgEditValidatorMetadata(ctx sdk.Context, msg types.MsgEditValidatorMetadata, k Keeper) sdk.Result {
+	k.Logger(ctx).Debug("Handling edit validator metadata", "Validator", msg.ID)
+
+	validator, ok := k.GetValidatorFromValID(ctx, msg.ID)
+	if !ok {
+		k.Logger(ctx).Error("Validator not found", "validatorId", msg.ID)
+		return hmCommon.ErrNoValidator(k.Codespace()).Result()
 	}
-	validator.VotingPower = p.Int64()
 
-	// save validator
-	err = k.AddValidator(ctx, validator)
-	if err != nil {
-		k.Logger(ctx).Error("Unable to update signer", "error", err, "ValidatorID", validator.ID)
-		return hmCommon.ErrSignerUpdateError(k.Codespace()).Result()
+	// only current signer can edit metadata
+	if !bytes.Equal(validator.Signer.Bytes(), msg.From.Bytes()) {
+		k.Logger(ctx).Error("Metadata edit not signed by validator signer", "validatorId", msg.ID, "from", msg.From.String())
+		return hmCommon.ErrValSignerMismatch(k.Codespace()).Result()
 	}
 
-	// save staking sequence
-	k.SetStakingSequence(ctx, sequence)
+	metadata := types.ValidatorMetadata{
+		ValidatorID: validator.ID,
+		Moniker:     msg.Moniker,
+		Website:     msg.Website,
+		Contact:     msg.Contact,
+		LogoHash:    msg.LogoHash,
+	}
+	if err := k.SetValidatorMetadata(ctx, metadata); err != nil {
+		k.Logger(ctx).Error("Unable to store validator metadata", "error", err, "metadata", metadata.String())
+		return hmCommon.ErrValidatorSave(k.Codespace()).Result()
+	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
-			types.EventTypeStakeUpdate,
+			types.EventTypeEditMetadata,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 			sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(validator.ID.Uint64(), 10)),
-			sdk.NewAttribute(types.AttributeKeyUpdatedAt, strconv.FormatUint(validator.LastUpdated, 10)),
+			sdk.NewAttribute(types.AttributeKeyMoniker, metadata.Moniker),
 		),
 	})
 
@@ -190,147 +208,168 @@ func HandleMsgStakeUpdate(ctx sdk.Context, msg types.MsgStakeUpdate, k Keeper, c
 	}
 }
 
-// HandleMsgSignerUpdate handles signer update message
-func HandleMsgSignerUpdate(ctx sdk.Context, msg types.MsgSignerUpdate, k Keeper, contractCaller helper.IContractCaller) sdk.Result {
-	k.Logger(ctx).Debug("Handling signer update", "Validator", msg.ID, "Signer", msg.NewSignerPubKey.Address())
+// HandleMsgValidatorExit handle msg validator exit
+func HandleMsgValidatorExit(ctx sdk.Context, msg types.MsgValidatorExit, k Keeper) sdk.Result {
+	k.Logger(ctx).Info("Handling validator exit", "ValidatorID", msg.ID)
 
-	// get main tx receipt
-	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash())
-	if err != nil || receipt == nil {
-		return hmCommon.ErrWaitForConfirmation(k.Codespace()).Result()
+	if _, err := validateValidatorExit(ctx, msg, k); err != nil {
+		return err.Result()
 	}
 
-	newPubKey := msg.NewSignerPubKey
-	newSigner := newPubKey.Address()
-
-	eventLog, err := contractCaller.DecodeSignerUpdateEvent(receipt, msg.LogIndex)
-	if err != nil || eventLog == nil {
-		k.Logger(ctx).Error("Error fetching log from txhash")
-		return hmCommon.ErrInvalidMsg(k.Codespace(), "Unable to fetch signer update log for txHash").Result()
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
 	}
+}
+
+// validateValidatorJoin checks validator hasn't joined before
+func validateValidatorJoin(ctx sdk.Context, msg types.MsgValidatorJoin, k Keeper) sdk.Error {
+	return validateNewValidator(ctx, msg.ID, msg.SignerPubKey, k)
+}
+
+// validateNewValidator checks validator ID and signer are not used by any validator
+func validateNewValidator(ctx sdk.Context, id hmTypes.ValidatorID, pubkey hmTypes.PubKey, k Keeper) sdk.Error {
+	signer := pubkey.Address()
 
-	if eventLog.ValidatorId.Uint64() != msg.ID.Uint64() {
-		k.Logger(ctx).Error("ID in message doesnt match id in logs", "MsgID", msg.ID, "IdFromTx", eventLog.ValidatorId.Uint64())
-		return hmCommon.ErrInvalidMsg(k.Codespace(), "Invalid txhash, id's dont match. Id from tx hash is %v", eventLog.ValidatorId.Uint64()).Result()
+	// Check if validator has been validator before
+	if _, ok := k.GetSignerFromValidatorID(ctx, id); ok {
+		k.Logger(ctx).Error("Validator has been validator before, cannot join with same ID", "validatorId", id)
+		return hmCommon.ErrValidatorAlreadyJoined(k.Codespace())
 	}
 
-	if bytes.Compare(eventLog.NewSigner.Bytes(), newSigner.Bytes()) != 0 {
-		k.Logger(ctx).Error("Signer in txhash and msg dont match", "MsgSigner", newSigner.String(), "SignerTx", eventLog.NewSigner.String())
-		return hmCommon.ErrInvalidMsg(k.Codespace(), "Signer in txhash and msg dont match").Result()
+	// get validator by signer
+	checkVal, err := k.GetValidatorInfo(ctx, signer.Bytes())
+	if err == nil || bytes.Equal(checkVal.Signer.Bytes(), signer.Bytes()) {
+		return hmCommon.ErrValidatorAlreadyJoined(k.Codespace())
 	}
 
-	// pull validator from store
+	return nil
+}
+
+// validateValidatorExit checks validator exists and is not unbonded already
+func validateValidatorExit(ctx sdk.Context, msg types.MsgValidatorExit, k Keeper) (hmTypes.Validator, sdk.Error) {
 	validator, ok := k.GetValidatorFromValID(ctx, msg.ID)
 	if !ok {
-		k.Logger(ctx).Error("Fetching of validator from store failed", "validatorId", msg.ID)
-		return hmCommon.ErrNoValidator(k.Codespace()).Result()
+		k.Logger(ctx).Error("Fetching of validator from store failed", "validatorID", msg.ID)
+		return validator, hmCommon.ErrNoValidator(k.Codespace())
 	}
-	oldValidator := validator.Copy()
 
-	// sequence id
-	sequence := (receipt.BlockNumber.Uint64() * hmTypes.DefaultLogIndexUnit) + msg.LogIndex
+	k.Logger(ctx).Debug("validator in store", "validator", validator)
+	// check if validator deactivation period is set
+	if validator.EndEpoch != 0 {
+		k.Logger(ctx).Error("Validator already unbonded")
+		return validator, hmCommon.ErrValUnbonded(k.Codespace())
+	}
+
+	return validator, nil
+}
+
+// validateStakingSequence checks validator exists and staking event has not been processed yet
+func validateStakingSequence(ctx sdk.Context, id hmTypes.ValidatorID, blockNumber uint64, logIndex uint64, k Keeper) (hmTypes.Validator, sdk.Error) {
+	// pull validator from store
+	validator, ok := k.GetValidatorFromValID(ctx, id)
+	if !ok {
+		k.Logger(ctx).Error("Fetching of validator from store failed", "validatorId", id)
+		return validator, hmCommon.ErrNoValidator(k.Codespace())
+	}
 
 	// check if incoming tx is older
-	if k.HasStakingSequence(ctx, sequence) {
+	if k.HasStakingSequence(ctx, getStakingSequence(blockNumber, logIndex)) {
 		k.Logger(ctx).Error("Older invalid tx found")
-		return hmCommon.ErrOldTx(k.Codespace()).Result()
+		return validator, hmCommon.ErrOldTx(k.Codespace())
 	}
 
-	// update last udpated
-	validator.LastUpdated = sequence
+	return validator, nil
+}
 
-	// check if we are actually updating signer
-	if !bytes.Equal(newSigner.Bytes(), validator.Signer.Bytes()) {
-		// Update signer in prev Validator
-		validator.Signer = hmTypes.HeimdallAddress(newSigner)
-		validator.PubKey = newPubKey
-		k.Logger(ctx).Debug("Updating new signer", "signer", newSigner.String(), "oldSigner", oldValidator.Signer.String(), "validatorID", msg.ID)
+// validateUndelegate checks delegator holds shares being burned
+func validateUndelegate(ctx sdk.Context, msg types.MsgUndelegate, k Keeper) (hmTypes.Validator, types.Delegation, sdk.Error) {
+	validator, err := validateStakingSequence(ctx, msg.ID, msg.BlockNumber, msg.LogIndex, k)
+	if err != nil {
+		return validator, types.Delegation{}, err
 	}
 
-	k.Logger(ctx).Debug("Removing old validator", "validator", oldValidator.String())
-
-	// remove old validator from HM
-	oldValidator.EndEpoch = k.ackRetriever.GetACKCount(ctx)
-
-	// remove old validator from TM
-	oldValidator.VotingPower = 0
-	// updated last
-	oldValidator.LastUpdated = sequence
+	delegation, found := k.GetDelegation(ctx, msg.ID, msg.Delegator)
+	if !found {
+		k.Logger(ctx).Error("No delegation found", "validatorId", msg.ID, "delegator", msg.Delegator.String())
+		return validator, delegation, hmCommon.ErrInvalidMsg(k.Codespace(), "No delegation of %v to validator %v", msg.Delegator.String(), msg.ID)
+	}
 
-	// save old validator
-	if err := k.AddValidator(ctx, *oldValidator); err != nil {
-		k.Logger(ctx).Error("Unable to update signer", "error", err, "validatorId", validator.ID)
-		return hmCommon.ErrSignerUpdateError(k.Codespace()).Result()
+	if delegation.Shares.LT(msg.Shares) {
+		k.Logger(ctx).Error("Burned shares exceed delegation", "shares", delegation.Shares, "burned", msg.Shares)
+		return validator, delegation, hmCommon.ErrInvalidMsg(k.Codespace(), "Delegator %v holds only %v shares", msg.Delegator.String(), delegation.Shares)
 	}
 
-	// adding new validator
-	k.Logger(ctx).Debug("Adding new validator", "validator", validator.String())
+	return validator, delegation, nil
+}
 
-	// save validator
-	err = k.AddValidator(ctx, validator)
+// validateConfirmAuction checks old validator is active and auction winner hasn't joined before
+func validateConfirmAuction(ctx sdk.Context, msg types.MsgConfirmAuction, k Keeper) (hmTypes.Validator, sdk.Error) {
+	validator, err := validateStakingSequence(ctx, msg.OldID, msg.BlockNumber, msg.LogIndex, k)
 	if err != nil {
-		k.Logger(ctx).Error("Unable to update signer", "error", err, "ValidatorID", validator.ID)
-		return hmCommon.ErrSignerUpdateError(k.Codespace()).Result()
+		return validator, err
 	}
-	// save staking sequence
-	k.SetStakingSequence(ctx, sequence)
 
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeSignerUpdate,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-			sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(validator.ID.Uint64(), 10)),
-			sdk.NewAttribute(types.AttributeKeyUpdatedAt, strconv.FormatUint(validator.LastUpdated, 10)),
-		),
-	})
+	if validator.EndEpoch != 0 {
+		k.Logger(ctx).Error("Validator already unbonded", "validatorId", msg.OldID)
+		return validator, hmCommon.ErrValUnbonded(k.Codespace())
+	}
 
-	return sdk.Result{
-		Events: ctx.EventManager().Events(),
+	// auction winner joins as new validator
+	if err := validateNewValidator(ctx, msg.NewID, msg.SignerPubKey, k); err != nil {
+		return validator, err
 	}
+
+	return validator, nil
 }
 
-// HandleMsgValidatorExit handle msg validator exit
-func HandleMsgValidatorExit(ctx sdk.Context, msg types.MsgValidatorExit, k Keeper, contractCaller helper.IContractCaller) sdk.Result {
-	k.Logger(ctx).Info("Handling validator exit", "ValidatorID", msg.ID)
+// getStakingSequence returns sequence id for root chain log
+func getStakingSequence(blockNumber uint64, logIndex uint64) uint64 {
+	return (blockNumber * hmTypes.DefaultLogIndexUnit) + logIndex
+}
 
-	if confirmed := contractCaller.IsTxConfirmed(msg.TxHash.EthHash()); !confirmed {
-		return hmCommon.ErrWaitForConfirmation(k.Codespace()).Result()
-	}
-	validator, ok := k.GetValidatorFromValID(ctx, msg.ID)
-	if !ok {
-		k.Logger(ctx).Error("Fetching of validator from store failed", "validatorID", msg.ID)
-		return hmCommon.ErrNoValidator(k.Codespace()).Result()
-	}
+// EndBlocker updates validator set whenever ack count or any validator has changed, regardless of
+// block having txs, and returns validator updates for tendermint
+func EndBlocker(ctx sdk.Context, k Keeper) (tmValUpdates []abci.ValidatorUpdate) {
+	ackCount := k.ackRetriever.GetACKCount(ctx)
 
-	k.Logger(ctx).Debug("validator in store", "validator", validator)
-	// check if validator deactivation period is set
-	if validator.EndEpoch != 0 {
-		k.Logger(ctx).Error("Validator already unbonded")
-		return hmCommon.ErrValUnbonded(k.Codespace()).Result()
+	// validators join and exit only when ack count moves past their start and end epochs
+	if lastEpoch, ok := k.GetLastValidatorSetEpoch(ctx); ok && lastEpoch == ackCount && !k.HasValidatorSetChange(ctx) {
+		return nil
 	}
 
-	// get validator from mainchain
-	updatedVal, err := contractCaller.GetValidatorInfo(validator.ID)
-	if err != nil {
-		k.Logger(ctx).Error("Cannot fetch validator info while unstaking", "Error", err, "validatorID", validator.ID)
-		return hmCommon.ErrNoValidator(k.Codespace()).Result()
+	currentValidatorSet := k.GetValidatorSet(ctx)
+	allValidators := k.GetAllValidators(ctx)
+
+	// get validator updates
+	setUpdates := helper.GetUpdatedValidators(
+		&currentValidatorSet, // pointer to current validator set -- UpdateValidators will modify it
+		allValidators,        // All validators
+		ackCount,             // ack count
+	)
+
+	// create new validator set
+	if err := currentValidatorSet.UpdateWithChangeSet(setUpdates); err != nil {
+		// return with nothing, update is retried in next block
+		k.Logger(ctx).Error("Unable to update current validator set", "Error", err)
+		return nil
 	}
 
-	// Add deactivation time for validator
-	if err := k.AddDeactivationEpoch(ctx, validator, updatedVal); err != nil {
-		k.Logger(ctx).Error("Error while setting deactivation epoch to validator", "error", err, "validatorID", validator.ID)
-		return hmCommon.ErrValidatorNotDeactivated(k.Codespace()).Result()
+	// save set in store
+	if err := k.UpdateValidatorSetInStore(ctx, currentValidatorSet); err != nil {
+		// return with nothing, update is retried in next block
+		k.Logger(ctx).Error("Unable to update current validator set in state", "Error", err)
+		return nil
 	}
 
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeValidatorExit,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-			sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(validator.ID.Uint64(), 10)),
-		),
-	})
+	k.SetLastValidatorSetEpoch(ctx, ackCount)
 
-	return sdk.Result{
-		Events: ctx.EventManager().Events(),
+	// convert updates from map to array
+	for _, v := range setUpdates {
+		tmValUpdates = append(tmValUpdates, abci.ValidatorUpdate{
+			Power:  int64(v.VotingPower),
+			PubKey: v.PubKey.ABCIPubKey(),
+		})
 	}
+
+	return tmValUpdates
 }
@@ -5,33 +5,48 @@ import (
 	"testing"
 
 	ethTypes "github.com/maticnetwork/bor/core/types"
-	"github.com/maticnetwork/heimdall/contracts/stakemanager"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/contracts/stakinginfo"
 	"github.com/maticnetwork/heimdall/helper"
 	"github.com/maticnetwork/heimdall/helper/mocks"
 	"github.com/maticnetwork/heimdall/staking"
 	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
 	cmn "github.com/maticnetwork/heimdall/test"
 	"github.com/maticnetwork/heimdall/types"
-	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
 func TestHandleMsgValidatorJoin(t *testing.T) {
+	helper.SetTestConfig(helper.GetDefaultHeimdallConfig())
 	contractCallerObj := mocks.IContractCaller{}
-	ctx, keeper, _ := cmn.CreateTestInput(t, false)
+	ctx, keeper, checkpointKeeper := cmn.CreateTestInput(t, false)
+	keeper.SetParams(ctx, stakingTypes.DefaultParams())
+	checkpointKeeper.SetParams(ctx, checkpointTypes.DefaultParams())
 	mockVals := cmn.GenRandomVal(1, 0, 10, 10, false, 1)
 	// select first validator from slice
 	mockVal := mockVals[0]
 	t.Log("Inserting ===>", "Validator", mockVal.Signer.String())
-	contractCallerObj.On("GetValidatorInfo", mock.Anything).Return(mockVal, nil)
 	// insert new validator
 	// msgTxHash := types.HeimdallHash("123")
 	msgTxHash := types.HexToHeimdallHash("123")
-	contractCallerObj.On("IsTxConfirmed", msgTxHash.EthHash()).Return(true)
-	msgValJoin := stakingTypes.NewMsgValidatorJoin(mockVal.Signer, uint64(mockVal.ID), mockVal.PubKey, msgTxHash, 0)
+	amount := types.NewIntFromBigInt(new(big.Int).Mul(big.NewInt(mockVal.VotingPower), new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)))
+	txreceipt := &ethTypes.Receipt{BlockNumber: big.NewInt(10)}
+	contractCallerObj.On("GetConfirmedTxReceipt", msgTxHash.EthHash(), checkpointKeeper.GetConfirmationBlocks(ctx)).Return(txreceipt, nil)
+	stakedEvent := &stakinginfo.StakinginfoStaked{
+		Signer:          mockVal.Signer.EthAddress(),
+		ValidatorId:     new(big.Int).SetUint64(mockVal.ID.Uint64()),
+		ActivationEpoch: new(big.Int).SetUint64(mockVal.StartEpoch),
+		Amount:          amount.BigInt(),
+	}
+	contractCallerObj.On("DecodeValidatorJoinEvent", txreceipt, uint64(0)).Return(stakedEvent, nil)
+	msgValJoin := stakingTypes.NewMsgValidatorJoin(mockVal.Signer, uint64(mockVal.ID), mockVal.StartEpoch, amount, mockVal.PubKey, msgTxHash, 0)
 	t.Log("msg val join", msgValJoin)
-	got := staking.HandleMsgValidatorJoin(ctx, msgValJoin, keeper, &contractCallerObj)
+	got := staking.HandleMsgValidatorJoin(ctx, msgValJoin, keeper)
 	require.True(t, got.IsOK(), "expected validator join to be ok, got %v", got)
+	sideResult := staking.SideHandleMsgValidatorJoin(ctx, msgValJoin, keeper, &contractCallerObj)
+	require.Equal(t, types.SideTxResultYes, sideResult, "expected validator join to be verified")
+	got = staking.PostHandleMsgValidatorJoin(ctx, msgValJoin, keeper)
+	require.True(t, got.IsOK(), "expected validator join to be applied, got %v", got)
 	// validator is stored properly and signer is created properly
 	storedVal, err := keeper.GetValidatorInfo(ctx, mockVal.Signer.Bytes())
 	require.Empty(t, err, "Unable to get validator info from val address,ValAddr:%v Error:%v ", mockVal.Signer.String(), err)
@@ -43,14 +58,17 @@ func TestHandleMsgValidatorJoin(t *testing.T) {
 	require.Equal(t, mockVal.Signer.Bytes(), storedSigner.Bytes(), "Signer address in signer=>validator map should be same")
 	t.Log("Mapped validator ID and Signer ===>", "ID", mockVal.ID, "Signer", storedSigner.String())
 	// insert validator again
-	got = staking.HandleMsgValidatorJoin(ctx, msgValJoin, keeper, &contractCallerObj)
+	got = staking.HandleMsgValidatorJoin(ctx, msgValJoin, keeper)
 	require.True(t, !got.IsOK(), "expected validator join to be not-ok, got %v", got)
 	// check if new validator gets added in validator set
 }
 
 func TestHandleMsgValidatorUpdate(t *testing.T) {
+	helper.SetTestConfig(helper.GetDefaultHeimdallConfig())
 	contractCallerObj := mocks.IContractCaller{}
-	ctx, keeper, _ := cmn.CreateTestInput(t, false)
+	ctx, keeper, checkpointKeeper := cmn.CreateTestInput(t, false)
+	keeper.SetParams(ctx, stakingTypes.DefaultParams())
+	checkpointKeeper.SetParams(ctx, checkpointTypes.DefaultParams())
 
 	// pass 0 as time alive to generate non de-activated validators
 	cmn.LoadValidatorSet(4, t, keeper, ctx, false, 0)
@@ -64,18 +82,22 @@ func TestHandleMsgValidatorUpdate(t *testing.T) {
 	t.Log("To be Updated ===>", "Validator", newSigner[0].String())
 	// gen msg
 	msgTxHash := types.HexToHeimdallHash("123")
-	msg := stakingTypes.NewMsgSignerUpdate(newSigner[0].Signer, uint64(newSigner[0].ID), newSigner[0].PubKey, msgTxHash, 0)
+	msg := stakingTypes.NewMsgSignerUpdate(newSigner[0].Signer, uint64(newSigner[0].ID), newSigner[0].PubKey, msgTxHash, 0, 10)
 	txreceipt := &ethTypes.Receipt{BlockNumber: big.NewInt(10)}
-	contractCallerObj.On("GetConfirmedTxReceipt", msgTxHash.EthHash()).Return(txreceipt, nil)
-	signerUpdateEvent := &stakemanager.StakemanagerSignerChange{
+	contractCallerObj.On("GetConfirmedTxReceipt", msgTxHash.EthHash(), checkpointKeeper.GetConfirmationBlocks(ctx)).Return(txreceipt, nil)
+	signerUpdateEvent := &stakinginfo.StakinginfoSignerChange{
 		ValidatorId: new(big.Int).SetUint64(oldSigner.ID.Uint64()),
 		OldSigner:   oldSigner.Signer.EthAddress(),
 		NewSigner:   newSigner[0].Signer.EthAddress(),
 	}
 	contractCallerObj.On("DecodeSignerUpdateEvent", txreceipt, uint64(0)).Return(signerUpdateEvent, nil)
 
-	got := staking.HandleMsgSignerUpdate(ctx, msg, keeper, &contractCallerObj)
+	got := staking.HandleMsgSignerUpdate(ctx, msg, keeper)
 	require.True(t, got.IsOK(), "expected validator update to be ok, got %v", got)
+	sideResult := staking.SideHandleMsgSignerUpdate(ctx, msg, keeper, &contractCallerObj)
+	require.Equal(t, types.SideTxResultYes, sideResult, "expected validator update to be verified")
+	got = staking.PostHandleMsgSignerUpdate(ctx, msg, keeper)
+	require.True(t, got.IsOK(), "expected validator update to be applied, got %v", got)
 	newValidators := keeper.GetCurrentValidators(ctx)
 	require.Equal(t, len(oldValSet.Validators), len(newValidators), "Number of current validators should be equal")
 	// apply updates
@@ -100,38 +122,54 @@ func TestHandleMsgValidatorUpdate(t *testing.T) {
 }
 
 func TestHandleMsgValidatorExit(t *testing.T) {
+	helper.SetTestConfig(helper.GetDefaultHeimdallConfig())
 	contractCallerObj := mocks.IContractCaller{}
-	ctx, keeper, checkpointkeeper := cmn.CreateTestInput(t, false)
+	ctx, keeper, checkpointKeeper := cmn.CreateTestInput(t, false)
+	keeper.SetParams(ctx, stakingTypes.DefaultParams())
+	checkpointKeeper.SetParams(ctx, checkpointTypes.DefaultParams())
 	// pass 0 as time alive to generate non de-activated validators
 	cmn.LoadValidatorSet(4, t, keeper, ctx, false, 0)
 	validators := keeper.GetCurrentValidators(ctx)
 	msgTxHash := types.HexToHeimdallHash("123")
-	contractCallerObj.On("IsTxConfirmed", msgTxHash.EthHash()).Return(true)
+	txreceipt := &ethTypes.Receipt{BlockNumber: big.NewInt(10)}
+	contractCallerObj.On("GetConfirmedTxReceipt", msgTxHash.EthHash(), checkpointKeeper.GetConfirmationBlocks(ctx)).Return(txreceipt, nil)
 
 	validators[0].EndEpoch = 10
-	msg := stakingTypes.NewMsgValidatorExit(validators[0].Signer, uint64(validators[0].ID), msgTxHash, 0)
-	contractCallerObj.On("GetValidatorInfo", validators[0].ID).Return(validators[0], nil)
-	got := staking.HandleMsgValidatorExit(ctx, msg, keeper, &contractCallerObj)
+	msg := stakingTypes.NewMsgValidatorExit(validators[0].Signer, uint64(validators[0].ID), validators[0].EndEpoch, msgTxHash, 0)
+	unstakeInitEvent := &stakinginfo.StakinginfoUnstakeInit{
+		User:              validators[0].Signer.EthAddress(),
+		ValidatorId:       new(big.Int).SetUint64(validators[0].ID.Uint64()),
+		DeactivationEpoch: new(big.Int).SetUint64(validators[0].EndEpoch),
+	}
+	contractCallerObj.On("DecodeValidatorExitEvent", txreceipt, uint64(0)).Return(unstakeInitEvent, nil)
+	got := staking.HandleMsgValidatorExit(ctx, msg, keeper)
 	require.True(t, got.IsOK(), "expected validator exit to be ok, got %v", got)
+	sideResult := staking.SideHandleMsgValidatorExit(ctx, msg, keeper, &contractCallerObj)
+	require.Equal(t, types.SideTxResultYes, sideResult, "expected validator exit to be verified")
+	got = staking.PostHandleMsgValidatorExit(ctx, msg, keeper)
+	require.True(t, got.IsOK(), "expected validator exit to be applied, got %v", got)
 	updatedValInfo, err := keeper.GetValidatorInfo(ctx, validators[0].Signer.Bytes())
 	require.Empty(t, err, "Unable to get validator info from val address,ValAddr:%v Error:%v ", validators[0].Signer.String(), err)
 	require.Equal(t, updatedValInfo.EndEpoch, validators[0].EndEpoch, "deactivation epoch should be set correctly")
 	_, found := keeper.GetValidatorFromValID(ctx, validators[0].ID)
 	require.True(t, found, "Validator should be present even after deactivation")
-	got = staking.HandleMsgValidatorExit(ctx, msg, keeper, &contractCallerObj)
+	got = staking.HandleMsgValidatorExit(ctx, msg, keeper)
 	require.True(t, !got.IsOK(), "validator already exited. cannot exit again")
 
 	currentVals := keeper.GetCurrentValidators(ctx)
 	require.Equal(t, 4, len(currentVals), "No of current validators should exist before epoch passes")
 
-	checkpointkeeper.UpdateACKCountWithValue(ctx, 20)
+	checkpointKeeper.UpdateACKCountWithValue(ctx, checkpointKeeper.GetPrimaryBorChainID(ctx), 20)
 	currentVals = keeper.GetCurrentValidators(ctx)
 	require.Equal(t, 3, len(currentVals), "No of current validators should reduce after epoch passes")
 }
 
 func TestHandleMsgStakeUpdate(t *testing.T) {
+	helper.SetTestConfig(helper.GetDefaultHeimdallConfig())
 	contractCallerObj := mocks.IContractCaller{}
-	ctx, keeper, _ := cmn.CreateTestInput(t, false)
+	ctx, keeper, checkpointKeeper := cmn.CreateTestInput(t, false)
+	keeper.SetParams(ctx, stakingTypes.DefaultParams())
+	checkpointKeeper.SetParams(ctx, checkpointTypes.DefaultParams())
 
 	// pass 0 as time alive to generate non de-activated validators
 	cmn.LoadValidatorSet(4, t, keeper, ctx, false, 0)
@@ -141,21 +179,28 @@ func TestHandleMsgStakeUpdate(t *testing.T) {
 	t.Log("To be Updated ===>", "Validator", oldVal.String())
 	// gen msg
 	msgTxHash := types.HexToHeimdallHash("123")
-	msg := stakingTypes.NewMsgStakeUpdate(oldVal.Signer, oldVal.ID.Uint64(), msgTxHash, 0)
+	newAmount := types.NewInt(2000000000000000000)
+	msg := stakingTypes.NewMsgStakeUpdate(oldVal.Signer, oldVal.ID.Uint64(), newAmount, msgTxHash, 0, 10)
 	txreceipt := &ethTypes.Receipt{BlockNumber: big.NewInt(10)}
-	contractCallerObj.On("GetConfirmedTxReceipt", msgTxHash.EthHash()).Return(txreceipt, nil)
-	stakeUpdateEvent := &stakemanager.StakemanagerStakeUpdate{
+	contractCallerObj.On("GetConfirmedTxReceipt", msgTxHash.EthHash(), checkpointKeeper.GetConfirmationBlocks(ctx)).Return(txreceipt, nil)
+	stakeUpdateEvent := &stakinginfo.StakinginfoStakeUpdate{
 		ValidatorId: new(big.Int).SetUint64(oldVal.ID.Uint64()),
-		OldAmount:   new(big.Int).SetInt64(oldVal.VotingPower),
-		NewAmount:   new(big.Int).SetInt64(2000000000000000000),
+		NewAmount:   newAmount.BigInt(),
 	}
 
 	contractCallerObj.On("DecodeValidatorStakeUpdateEvent", txreceipt, uint64(0)).Return(stakeUpdateEvent, nil)
 
-	got := staking.HandleMsgStakeUpdate(ctx, msg, keeper, &contractCallerObj)
+	got := staking.HandleMsgStakeUpdate(ctx, msg, keeper)
 	require.True(t, got.IsOK(), "expected validator stake update to be ok, got %v", got)
+	sideResult := staking.SideHandleMsgStakeUpdate(ctx, msg, keeper, &contractCallerObj)
+	require.Equal(t, types.SideTxResultYes, sideResult, "expected validator stake update to be verified")
+	got = staking.PostHandleMsgStakeUpdate(ctx, msg, keeper)
+	require.True(t, got.IsOK(), "expected validator stake update to be applied, got %v", got)
 	updatedVal, err := keeper.GetValidatorInfo(ctx, oldVal.Signer.Bytes())
 	require.Empty(t, err, "unable to fetch validator info %v-", err)
-	require.Equal(t, stakeUpdateEvent.NewAmount.Int64(), updatedVal.VotingPower, "Validator VotingPower should be updated to %v", stakeUpdateEvent.NewAmount.Uint64())
+	require.Equal(t, newAmount, updatedVal.Amount, "Validator stake should be updated to %v", stakeUpdateEvent.NewAmount)
+	expectedPower, err := keeper.GetPowerFromAmount(ctx, stakeUpdateEvent.NewAmount)
+	require.NoError(t, err)
+	require.Equal(t, expectedPower, updatedVal.VotingPower, "Validator VotingPower should be updated to %v", expectedPower)
 
 }
@@ -0,0 +1,100 @@
+package staking
+
+import (
+	"bytes"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/staking/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// RegisterInvariants registers all staking invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "validator-set", ValidatorSetInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "validator-map", ValidatorMapInvariant(k))
+}
+
+// AllInvariants runs all invariants of the staking module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		if res, stop := ValidatorSetInvariant(k)(ctx); stop {
+			return res, stop
+		}
+		return ValidatorMapInvariant(k)(ctx)
+	}
+}
+
+// ValidatorSetInvariant checks that validator set in store consists of current validators with their power
+func ValidatorSetInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		validatorSet := k.GetValidatorSet(ctx)
+
+		var msg string
+		var totalPower int64
+		current := make(map[hmTypes.ValidatorID]int64)
+		for _, validator := range k.GetCurrentValidators(ctx) {
+			current[validator.ID] = validator.VotingPower
+			totalPower += validator.VotingPower
+		}
+
+		for _, validator := range validatorSet.Validators {
+			power, ok := current[validator.ID]
+			if !ok {
+				msg += fmt.Sprintf("\tvalidator %v is in validator set but is not current validator\n", validator.ID)
+				continue
+			}
+			if power != validator.VotingPower {
+				msg += fmt.Sprintf("\tvalidator %v has power %v in validator set but %v in store\n", validator.ID, validator.VotingPower, power)
+			}
+			delete(current, validator.ID)
+		}
+
+		for id := range current {
+			msg += fmt.Sprintf("\tcurrent validator %v is missing from validator set\n", id)
+		}
+
+		if len(validatorSet.Validators) != 0 && validatorSet.TotalVotingPower() != totalPower {
+			msg += fmt.Sprintf("\tvalidator set power %v doesn't match sum of current validators' power %v\n", validatorSet.TotalVotingPower(), totalPower)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "validator set", msg), msg != ""
+	}
+}
+
+// ValidatorMapInvariant checks that validator ID => signer map and stored validators point to each other
+func ValidatorMapInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+
+		// every stored validator is mapped, records of replaced signers have no power
+		k.IterateValidatorsAndApplyFn(ctx, func(validator hmTypes.Validator) error {
+			signer, ok := k.GetSignerFromValidatorID(ctx, validator.ID)
+			if !ok {
+				msg += fmt.Sprintf("\tvalidator %v has no signer in validator map\n", validator.ID)
+			} else if !bytes.Equal(signer.Bytes(), validator.Signer.Bytes()) && validator.VotingPower != 0 {
+				msg += fmt.Sprintf("\tvalidator %v is mapped to signer %v but record of signer %v has power\n", validator.ID, signer.Hex(), validator.Signer.String())
+			}
+			return nil
+		})
+
+		// every mapped signer has validator record with same id
+		store := ctx.KVStore(k.storeKey)
+		iterator := sdk.KVStorePrefixIterator(store, ValidatorMapKey)
+		defer iterator.Close()
+
+		for ; iterator.Valid(); iterator.Next() {
+			validator, err := k.GetValidatorInfo(ctx, iterator.Value())
+			if err != nil {
+				msg += fmt.Sprintf("\tvalidator map key %X points to unknown signer %X\n", iterator.Key(), iterator.Value())
+				continue
+			}
+			if !bytes.Equal(iterator.Key(), GetValidatorMapKey(validator.ID.Bytes())) {
+				msg += fmt.Sprintf("\tvalidator map key %X points to signer of validator %v\n", iterator.Key(), validator.ID)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "validator map", msg), msg != ""
+	}
+}
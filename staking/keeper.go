@@ -1,8 +1,11 @@
 package staking
 
 import (
+	"encoding/binary"
 	"encoding/hex"
 	"errors"
+	"fmt"
+	"math/big"
 	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -25,10 +28,19 @@ var (
 	PrevDividendAccountMapKey = []byte{0x41} // store for dividend accounts before checkpoint ack.
 	DividendAccountMapKey     = []byte{0x42} // prefix for each key for Dividend Account Map
 	StakingSequenceKey        = []byte{0x24} // prefix for each key for staking sequence map
+	DelegationKey             = []byte{0x25} // prefix for each key for delegation by validator and delegator
+	DelegatorIndexKey         = []byte{0x26} // prefix for each key for delegator to validator index
+	AuctionKey                = []byte{0x27} // prefix for each key for open auction of validator slot
+	HistoricalValidatorSetKey = []byte{0x28} // prefix for each key for validator set snapshot by epoch
+	ValidatorSetChangeKey     = []byte{0x29} // Key to store flag for validator state change since last validator set update
+	LastValidatorSetEpochKey  = []byte{0x30} // Key to store ack count of last validator set update
+	ValidatorMetadataKey      = []byte{0x31} // prefix for each key for descriptive metadata of validator
 )
 
+// AckRetriever retrieves checkpoint ack count and confirmations required for root chain txs
 type AckRetriever interface {
 	GetACKCount(ctx sdk.Context) uint64
+	GetConfirmationBlocks(ctx sdk.Context) uint64
 }
 
 // Keeper stores all related data
@@ -72,6 +84,11 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", types.ModuleName)
 }
 
+// GetConfirmationBlocks returns number of main chain blocks required to confirm root chain tx
+func (k Keeper) GetConfirmationBlocks(ctx sdk.Context) uint64 {
+	return k.ackRetriever.GetConfirmationBlocks(ctx)
+}
+
 // GetValidatorKey drafts the validator key for addresses
 func GetValidatorKey(address []byte) []byte {
 	return append(ValidatorsKey, address...)
@@ -108,6 +125,9 @@ func (k *Keeper) AddValidator(ctx sdk.Context, validator hmTypes.Validator) erro
 	// add validator to validator ID => SignerAddress map
 	k.SetValidatorIDToSignerAddr(ctx, validator.ID, validator.Signer)
 
+	// validator set has to be updated at end of block
+	store.Set(ValidatorSetChangeKey, DefaultValue)
+
 	return nil
 }
 
@@ -226,6 +246,34 @@ func (k *Keeper) AddDeactivationEpoch(ctx sdk.Context, validator hmTypes.Validat
 	return errors.New("Deactivation period not set")
 }
 
+// Jail marks validator as jailed, jailed validator is removed from validator set on next update
+func (k *Keeper) Jail(ctx sdk.Context, address []byte) error {
+	validator, err := k.GetValidatorInfo(ctx, address)
+	if err != nil {
+		k.Logger(ctx).Error("Unable to fetch validator from store", "address", hmTypes.BytesToHeimdallAddress(address).String())
+		return err
+	}
+
+	if validator.Jailed {
+		return nil
+	}
+
+	validator.Jailed = true
+	return k.AddValidator(ctx, validator)
+}
+
+// Unjail removes jail of validator, validator joins validator set on next update
+func (k *Keeper) Unjail(ctx sdk.Context, address []byte) error {
+	validator, err := k.GetValidatorInfo(ctx, address)
+	if err != nil {
+		k.Logger(ctx).Error("Unable to fetch validator from store", "address", hmTypes.BytesToHeimdallAddress(address).String())
+		return err
+	}
+
+	validator.Jailed = false
+	return k.AddValidator(ctx, validator)
+}
+
 // UpdateSigner updates validator with signer and pubkey + validator => signer map
 func (k *Keeper) UpdateSigner(ctx sdk.Context, newSigner hmTypes.HeimdallAddress, newPubkey hmTypes.PubKey, prevSigner hmTypes.HeimdallAddress) error {
 	// get old validator from state and make power 0
@@ -280,6 +328,30 @@ func (k *Keeper) GetValidatorSet(ctx sdk.Context) (validatorSet hmTypes.Validato
 	return validatorSet
 }
 
+// HasValidatorSetChange checks if any validator has changed since last validator set update
+func (k *Keeper) HasValidatorSetChange(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(ValidatorSetChangeKey)
+}
+
+// GetLastValidatorSetEpoch returns ack count validator set was last updated at
+func (k *Keeper) GetLastValidatorSetEpoch(ctx sdk.Context) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(LastValidatorSetEpochKey)
+	if bz == nil {
+		return 0, false
+	}
+
+	return binary.BigEndian.Uint64(bz), true
+}
+
+// SetLastValidatorSetEpoch records ack count validator set was updated at and clears change flag
+func (k *Keeper) SetLastValidatorSetEpoch(ctx sdk.Context, epoch uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(LastValidatorSetEpochKey, sdk.Uint64ToBigEndian(epoch))
+	store.Delete(ValidatorSetChangeKey)
+}
+
 // IncrementAccum increments accum for validator set by n times and replace validator set in store
 func (k *Keeper) IncrementAccum(ctx sdk.Context, times int) {
 	// get validator set
@@ -313,6 +385,62 @@ func (k *Keeper) GetCurrentProposer(ctx sdk.Context) *hmTypes.Validator {
 	return validatorSet.GetProposer()
 }
 
+// GetProposerSchedule projects checkpoint proposers for next ack/no-ack steps, as every ack or no-ack
+// rotates proposer once. Step 0 is current proposer. Validator set changes are not projected.
+func (k *Keeper) GetProposerSchedule(ctx sdk.Context, steps uint64) (schedule []types.ScheduledProposer) {
+	validatorSet := k.GetValidatorSet(ctx)
+	if validatorSet.IsNilOrEmpty() {
+		return schedule
+	}
+
+	projected := &validatorSet
+	for step := uint64(0); step <= steps; step++ {
+		if step > 0 {
+			projected = projected.CopyIncrementProposerPriority(1)
+		}
+
+		schedule = append(schedule, types.ScheduledProposer{
+			Step:     step,
+			Proposer: *projected.GetProposer(),
+		})
+	}
+
+	return schedule
+}
+
+// GetProposerTurn returns number of ack/no-ack steps after which validator becomes checkpoint proposer
+func (k *Keeper) GetProposerTurn(ctx sdk.Context, valID hmTypes.ValidatorID, maxSteps uint64) (uint64, bool) {
+	validatorSet := k.GetValidatorSet(ctx)
+	if validatorSet.IsNilOrEmpty() {
+		return 0, false
+	}
+
+	// validator must be part of current validator set
+	found := false
+	for _, validator := range validatorSet.Validators {
+		if validator.ID == valID {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return 0, false
+	}
+
+	projected := &validatorSet
+	for step := uint64(0); step <= maxSteps; step++ {
+		if step > 0 {
+			projected = projected.CopyIncrementProposerPriority(1)
+		}
+
+		if projected.GetProposer().ID == valID {
+			return step, true
+		}
+	}
+
+	return 0, false
+}
+
 // SetValidatorIDToSignerAddr sets mapping for validator ID to signer address
 func (k *Keeper) SetValidatorIDToSignerAddr(ctx sdk.Context, valID hmTypes.ValidatorID, signerAddr hmTypes.HeimdallAddress) {
 	store := ctx.KVStore(k.storeKey)
@@ -456,3 +584,431 @@ func (k *Keeper) HasStakingSequence(ctx sdk.Context, sequence uint64) bool {
 	store := ctx.KVStore(k.storeKey)
 	return store.Has(GetStakingSequenceKey(sequence))
 }
+
+//
+// Delegation
+//
+
+// GetValidatorDelegationsKey returns prefix of delegations to validator
+func GetValidatorDelegationsKey(valID hmTypes.ValidatorID) []byte {
+	return append(DelegationKey, []byte(strconv.FormatUint(valID.Uint64(), 10)+":")...)
+}
+
+// GetDelegationKey returns key of delegation to validator by delegator
+func GetDelegationKey(valID hmTypes.ValidatorID, delegator hmTypes.HeimdallAddress) []byte {
+	return append(GetValidatorDelegationsKey(valID), delegator.Bytes()...)
+}
+
+// GetDelegatorIndexPrefixKey returns prefix of validators delegator has delegated to
+func GetDelegatorIndexPrefixKey(delegator hmTypes.HeimdallAddress) []byte {
+	return append(DelegatorIndexKey, delegator.Bytes()...)
+}
+
+// GetDelegatorIndexKey returns delegator to validator index key
+func GetDelegatorIndexKey(delegator hmTypes.HeimdallAddress, valID hmTypes.ValidatorID) []byte {
+	return append(GetDelegatorIndexPrefixKey(delegator), []byte(strconv.FormatUint(valID.Uint64(), 10))...)
+}
+
+// SetDelegation stores delegation, delegation without shares is removed
+func (k *Keeper) SetDelegation(ctx sdk.Context, delegation types.Delegation) error {
+	store := ctx.KVStore(k.storeKey)
+
+	if delegation.Shares.I == nil || !delegation.Shares.IsPositive() {
+		store.Delete(GetDelegationKey(delegation.ValidatorID, delegation.Delegator))
+		store.Delete(GetDelegatorIndexKey(delegation.Delegator, delegation.ValidatorID))
+		return nil
+	}
+
+	bz, err := k.cdc.MarshalBinaryBare(delegation)
+	if err != nil {
+		return err
+	}
+
+	store.Set(GetDelegationKey(delegation.ValidatorID, delegation.Delegator), bz)
+	store.Set(GetDelegatorIndexKey(delegation.Delegator, delegation.ValidatorID), DefaultValue)
+	return nil
+}
+
+// GetDelegation returns delegation of delegator to validator
+func (k *Keeper) GetDelegation(ctx sdk.Context, valID hmTypes.ValidatorID, delegator hmTypes.HeimdallAddress) (delegation types.Delegation, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetDelegationKey(valID, delegator))
+	if bz == nil {
+		return delegation, false
+	}
+
+	if err := k.cdc.UnmarshalBinaryBare(bz, &delegation); err != nil {
+		k.Logger(ctx).Error("Error unmarshalling delegation", "error", err)
+		return delegation, false
+	}
+
+	return delegation, true
+}
+
+// GetValidatorDelegations returns all delegations to validator
+func (k *Keeper) GetValidatorDelegations(ctx sdk.Context, valID hmTypes.ValidatorID) []types.Delegation {
+	return k.getDelegationsByPrefix(ctx, GetValidatorDelegationsKey(valID))
+}
+
+// GetAllDelegations returns all delegations
+func (k *Keeper) GetAllDelegations(ctx sdk.Context) []types.Delegation {
+	return k.getDelegationsByPrefix(ctx, DelegationKey)
+}
+
+func (k *Keeper) getDelegationsByPrefix(ctx sdk.Context, prefix []byte) (delegations []types.Delegation) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var delegation types.Delegation
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &delegation); err != nil {
+			k.Logger(ctx).Error("Error unmarshalling delegation", "error", err)
+			continue
+		}
+		delegations = append(delegations, delegation)
+	}
+
+	return
+}
+
+// GetDelegatorDelegations returns all delegations of delegator
+func (k *Keeper) GetDelegatorDelegations(ctx sdk.Context, delegator hmTypes.HeimdallAddress) (delegations []types.Delegation) {
+	prefix := GetDelegatorIndexPrefixKey(delegator)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		valID, err := strconv.ParseUint(string(iterator.Key()[len(prefix):]), 10, 64)
+		if err != nil {
+			continue
+		}
+
+		if delegation, found := k.GetDelegation(ctx, hmTypes.NewValidatorID(valID), delegator); found {
+			delegations = append(delegations, delegation)
+		}
+	}
+
+	return
+}
+
+// GetDelegatedAmount returns total amount delegated to validator
+func (k *Keeper) GetDelegatedAmount(ctx sdk.Context, valID hmTypes.ValidatorID) *big.Int {
+	total := big.NewInt(0)
+	for _, delegation := range k.GetValidatorDelegations(ctx, valID) {
+		total.Add(total, delegation.Amount.BigInt())
+	}
+	return total
+}
+
+// GetPowerFromAmount returns voting power of amount using power divisor param
+func (k *Keeper) GetPowerFromAmount(ctx sdk.Context, amount *big.Int) (int64, error) {
+	return helper.GetPowerFromAmountWithDivisor(amount, k.GetParams(ctx).PowerDivisor.BigInt())
+}
+
+// GetValidatorPower returns voting power from amount staked by validator and total amount delegated to it
+func (k *Keeper) GetValidatorPower(ctx sdk.Context, validator hmTypes.Validator) (int64, error) {
+	total := k.GetDelegatedAmount(ctx, validator.ID)
+	if validator.Amount.I != nil {
+		total.Add(total, validator.Amount.I)
+	}
+
+	power, err := k.GetPowerFromAmount(ctx, total)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := k.ValidateTotalPower(ctx, validator.ID, power); err != nil {
+		return 0, err
+	}
+	return power, nil
+}
+
+// ValidateTotalPower checks total voting power of validators which haven't unstaked stays within
+// max total voting power once validator has given power. Power of validator and of replaced
+// validators is left out of current total. Bound is only enforced when power increases, so
+// validators can always lower their stake.
+func (k *Keeper) ValidateTotalPower(ctx sdk.Context, valID hmTypes.ValidatorID, power int64, replacedIDs ...hmTypes.ValidatorID) error {
+	if validator, ok := k.GetValidatorFromValID(ctx, valID); ok && power <= validator.VotingPower {
+		return nil
+	}
+
+	excluded := map[hmTypes.ValidatorID]bool{valID: true}
+	for _, replacedID := range replacedIDs {
+		excluded[replacedID] = true
+	}
+
+	total := power
+	for _, validator := range k.GetAllValidators(ctx) {
+		if excluded[validator.ID] || validator.EndEpoch != 0 {
+			continue
+		}
+
+		if validator.VotingPower > hmTypes.MaxTotalVotingPower-total {
+			return fmt.Errorf("total voting power exceeds max total voting power %v", hmTypes.MaxTotalVotingPower)
+		}
+		total += validator.VotingPower
+	}
+
+	return nil
+}
+
+//
+// Auction
+//
+
+// GetAuctionKey returns key of auction for validator slot
+func GetAuctionKey(valID hmTypes.ValidatorID) []byte {
+	return append(AuctionKey, []byte(strconv.FormatUint(valID.Uint64(), 10))...)
+}
+
+// SetAuction stores open auction for validator slot
+func (k *Keeper) SetAuction(ctx sdk.Context, auction types.Auction) error {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.cdc.MarshalBinaryBare(auction)
+	if err != nil {
+		return err
+	}
+
+	store.Set(GetAuctionKey(auction.ValidatorID), bz)
+	return nil
+}
+
+// GetAuction returns open auction for validator slot
+func (k *Keeper) GetAuction(ctx sdk.Context, valID hmTypes.ValidatorID) (auction types.Auction, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetAuctionKey(valID))
+	if bz == nil {
+		return auction, false
+	}
+
+	if err := k.cdc.UnmarshalBinaryBare(bz, &auction); err != nil {
+		k.Logger(ctx).Error("Error unmarshalling auction", "error", err)
+		return auction, false
+	}
+
+	return auction, true
+}
+
+// RemoveAuction closes auction for validator slot
+func (k *Keeper) RemoveAuction(ctx sdk.Context, valID hmTypes.ValidatorID) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetAuctionKey(valID))
+}
+
+// GetAllAuctions returns all open auctions
+func (k *Keeper) GetAllAuctions(ctx sdk.Context) (auctions []types.Auction) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, AuctionKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var auction types.Auction
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &auction); err != nil {
+			k.Logger(ctx).Error("Error unmarshalling auction", "error", err)
+			continue
+		}
+		auctions = append(auctions, auction)
+	}
+
+	return
+}
+
+//
+// Validator metadata
+//
+
+// GetValidatorMetadataKey returns key of validator metadata
+func GetValidatorMetadataKey(valID hmTypes.ValidatorID) []byte {
+	return append(ValidatorMetadataKey, valID.Bytes()...)
+}
+
+// SetValidatorMetadata stores descriptive metadata of validator
+func (k *Keeper) SetValidatorMetadata(ctx sdk.Context, metadata types.ValidatorMetadata) error {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.cdc.MarshalBinaryBare(metadata)
+	if err != nil {
+		return err
+	}
+
+	store.Set(GetValidatorMetadataKey(metadata.ValidatorID), bz)
+	return nil
+}
+
+// GetValidatorMetadata returns descriptive metadata of validator
+func (k *Keeper) GetValidatorMetadata(ctx sdk.Context, valID hmTypes.ValidatorID) (metadata types.ValidatorMetadata, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetValidatorMetadataKey(valID))
+	if bz == nil {
+		return metadata, false
+	}
+
+	if err := k.cdc.UnmarshalBinaryBare(bz, &metadata); err != nil {
+		k.Logger(ctx).Error("Error unmarshalling validator metadata", "error", err)
+		return metadata, false
+	}
+
+	return metadata, true
+}
+
+// GetAllValidatorMetadata returns descriptive metadata of all validators
+func (k *Keeper) GetAllValidatorMetadata(ctx sdk.Context) (metadatas []types.ValidatorMetadata) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, ValidatorMetadataKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var metadata types.ValidatorMetadata
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &metadata); err != nil {
+			k.Logger(ctx).Error("Error unmarshalling validator metadata", "error", err)
+			continue
+		}
+		metadatas = append(metadatas, metadata)
+	}
+
+	return
+}
+
+// GetValidatorInfoWithMetadata returns validator along with its metadata
+func (k *Keeper) GetValidatorInfoWithMetadata(ctx sdk.Context, validator hmTypes.Validator) types.ValidatorInfo {
+	info := types.ValidatorInfo{Validator: validator}
+	if metadata, found := k.GetValidatorMetadata(ctx, validator.ID); found {
+		info.Metadata = &metadata
+	}
+	return info
+}
+
+//
+// Historical validator set
+//
+
+// GetHistoricalValidatorSetKey returns key of validator set snapshot for epoch
+func GetHistoricalValidatorSetKey(epoch uint64) []byte {
+	return append(HistoricalValidatorSetKey, sdk.Uint64ToBigEndian(epoch)...)
+}
+
+// SetHistoricalValidatorSet stores validator set snapshot
+func (k *Keeper) SetHistoricalValidatorSet(ctx sdk.Context, historical types.HistoricalValidatorSet) error {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.cdc.MarshalBinaryBare(historical)
+	if err != nil {
+		return err
+	}
+
+	store.Set(GetHistoricalValidatorSetKey(historical.Epoch), bz)
+	return nil
+}
+
+// GetHistoricalValidatorSet returns validator set snapshot for epoch
+func (k *Keeper) GetHistoricalValidatorSet(ctx sdk.Context, epoch uint64) (historical types.HistoricalValidatorSet, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetHistoricalValidatorSetKey(epoch))
+	if bz == nil {
+		return historical, false
+	}
+
+	if err := k.cdc.UnmarshalBinaryBare(bz, &historical); err != nil {
+		k.Logger(ctx).Error("Error unmarshalling historical validator set", "error", err)
+		return historical, false
+	}
+
+	return historical, true
+}
+
+// GetHistoricalValidatorSetByHeight returns validator set snapshot which was active at height
+func (k *Keeper) GetHistoricalValidatorSetByHeight(ctx sdk.Context, height int64) (historical types.HistoricalValidatorSet, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStoreReversePrefixIterator(store, HistoricalValidatorSetKey)
+	defer iterator.Close()
+
+	// snapshots are ordered by epoch, latest snapshot taken at or before height wins
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot types.HistoricalValidatorSet
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &snapshot); err != nil {
+			k.Logger(ctx).Error("Error unmarshalling historical validator set", "error", err)
+			continue
+		}
+
+		if snapshot.Height <= height {
+			return snapshot, true
+		}
+	}
+
+	return historical, false
+}
+
+// GetAllHistoricalValidatorSets returns all validator set snapshots ordered by epoch
+func (k *Keeper) GetAllHistoricalValidatorSets(ctx sdk.Context) (historicals []types.HistoricalValidatorSet) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, HistoricalValidatorSetKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var historical types.HistoricalValidatorSet
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &historical); err != nil {
+			k.Logger(ctx).Error("Error unmarshalling historical validator set", "error", err)
+			continue
+		}
+		historicals = append(historicals, historical)
+	}
+
+	return
+}
+
+// TrackHistoricalValidatorSet snapshots current validator set once per epoch and prunes old snapshots
+func (k *Keeper) TrackHistoricalValidatorSet(ctx sdk.Context, epoch uint64) error {
+	store := ctx.KVStore(k.storeKey)
+	if store.Has(GetHistoricalValidatorSetKey(epoch)) {
+		return nil
+	}
+
+	historical := types.HistoricalValidatorSet{
+		Epoch:        epoch,
+		Height:       ctx.BlockHeight(),
+		ValidatorSet: k.GetValidatorSet(ctx),
+	}
+	if err := k.SetHistoricalValidatorSet(ctx, historical); err != nil {
+		return err
+	}
+
+	// keep snapshots of last `HistoricalEpochs` epochs only
+	historicalEpochs := k.GetParams(ctx).HistoricalEpochs
+	if historicalEpochs == 0 || epoch < historicalEpochs {
+		return nil
+	}
+
+	pruneBefore := epoch - historicalEpochs + 1
+	iterator := sdk.KVStorePrefixIterator(store, HistoricalValidatorSetKey)
+	defer iterator.Close()
+
+	var expired [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		if binary.BigEndian.Uint64(iterator.Key()[len(HistoricalValidatorSetKey):]) >= pruneBefore {
+			break
+		}
+		expired = append(expired, iterator.Key())
+	}
+
+	for _, key := range expired {
+		store.Delete(key)
+	}
+
+	return nil
+}
+
+//
+//  Params
+//
+
+// SetParams sets the staking module's parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetParams gets the staking module's parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return
+}
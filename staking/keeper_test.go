@@ -125,7 +125,7 @@ func TestCurrentValidator(t *testing.T) {
 			}
 			// check current validator
 			stakingKeeper.AddValidator(ctx, newVal)
-			checkpointKeeper.UpdateACKCountWithValue(ctx, item.ackcount)
+			checkpointKeeper.UpdateACKCountWithValue(ctx, checkpointKeeper.GetPrimaryBorChainID(ctx), item.ackcount)
 			t.Log("Ack count - ", checkpointKeeper.GetACKCount(ctx))
 			isCurrentVal := stakingKeeper.IsCurrentValidatorByAddress(ctx, newVal.Signer.Bytes())
 			require.Equal(t, item.result, isCurrentVal, item.resultmsg)
@@ -28,6 +28,10 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return handleQueryValidatorStatus(ctx, req, keeper)
 		case types.QueryProposer:
 			return handleQueryProposer(ctx, req, keeper)
+		case types.QueryProposerSchedule:
+			return handleQueryProposerSchedule(ctx, req, keeper)
+		case types.QueryProposerTurn:
+			return handleQueryProposerTurn(ctx, req, keeper)
 		case types.QueryCurrentProposer:
 			return handleQueryCurrentProposer(ctx, req, keeper)
 		case types.QueryDividendAccount:
@@ -38,6 +42,20 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return handleQueryAccountProof(ctx, req, keeper)
 		case types.QueryVerifyAccountProof:
 			return handleQueryVerifyAccountProof(ctx, req, keeper)
+		case types.QueryValidatorDelegations:
+			return handleQueryValidatorDelegations(ctx, req, keeper)
+		case types.QueryDelegatorDelegations:
+			return handleQueryDelegatorDelegations(ctx, req, keeper)
+		case types.QueryAuctions:
+			return handleQueryAuctions(ctx, req, keeper)
+		case types.QueryAuction:
+			return handleQueryAuction(ctx, req, keeper)
+		case types.QueryParams:
+			return handleQueryParams(ctx, req, keeper)
+		case types.QueryHistoricalValidatorSet:
+			return handleQueryHistoricalValidatorSet(ctx, req, keeper)
+		case types.QueryHistoricalValidatorSetByHeight:
+			return handleQueryHistoricalValidatorSetByHeight(ctx, req, keeper)
 
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown staking query endpoint")
@@ -69,8 +87,8 @@ func handleQuerySigner(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("Error while getting validator by signer", err.Error()))
 	}
 
-	// json record
-	bz, err := json.Marshal(validator)
+	// json record with metadata
+	bz, err := json.Marshal(keeper.GetValidatorInfoWithMetadata(ctx, validator))
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
 	}
@@ -89,8 +107,8 @@ func handleQueryValidator(ctx sdk.Context, req abci.RequestQuery, keeper Keeper)
 		return nil, sdk.ErrUnknownRequest("No validator found")
 	}
 
-	// json record
-	bz, err := json.Marshal(validator)
+	// json record with metadata
+	bz, err := json.Marshal(keeper.GetValidatorInfoWithMetadata(ctx, validator))
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
 	}
@@ -145,6 +163,43 @@ func handleQueryProposer(ctx sdk.Context, req abci.RequestQuery, keeper Keeper)
 	return bz, nil
 }
 
+func handleQueryProposerSchedule(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryProposerScheduleParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if params.Steps > types.MaxProposerScheduleSteps {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("steps should not be more than %d", types.MaxProposerScheduleSteps))
+	}
+
+	// json record
+	bz, err := json.Marshal(keeper.GetProposerSchedule(ctx, params.Steps))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryProposerTurn(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryValidatorParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	step, ok := keeper.GetProposerTurn(ctx, params.ValidatorID, types.MaxProposerScheduleSteps)
+	if !ok {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("validator is not proposer within %d steps", types.MaxProposerScheduleSteps))
+	}
+
+	// json record
+	bz, err := json.Marshal(types.ProposerTurn{ValidatorID: params.ValidatorID, Step: step})
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
 func handleQueryCurrentProposer(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
 	proposer := keeper.GetCurrentProposer(ctx)
 
@@ -235,3 +290,120 @@ func handleQueryVerifyAccountProof(ctx sdk.Context, req abci.RequestQuery, keepe
 	}
 	return bz, nil
 }
+
+func handleQueryValidatorDelegations(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryValidatorParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	delegations := keeper.GetValidatorDelegations(ctx, params.ValidatorID)
+	if delegations == nil {
+		delegations = make([]types.Delegation, 0)
+	}
+
+	// json record
+	bz, err := json.Marshal(delegations)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryDelegatorDelegations(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryDelegatorParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	delegations := keeper.GetDelegatorDelegations(ctx, params.Delegator)
+	if delegations == nil {
+		delegations = make([]types.Delegation, 0)
+	}
+
+	// json record
+	bz, err := json.Marshal(delegations)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryAuctions(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	auctions := keeper.GetAllAuctions(ctx)
+	if auctions == nil {
+		auctions = make([]types.Auction, 0)
+	}
+
+	// json record
+	bz, err := json.Marshal(auctions)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryAuction(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryValidatorParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	auction, found := keeper.GetAuction(ctx, params.ValidatorID)
+	if !found {
+		return nil, sdk.ErrUnknownRequest("No auction found")
+	}
+
+	// json record
+	bz, err := json.Marshal(auction)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryParams(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	bz, err := json.Marshal(keeper.GetParams(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryHistoricalValidatorSet(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryHistoricalValidatorSetParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	historical, found := keeper.GetHistoricalValidatorSet(ctx, params.Epoch)
+	if !found {
+		return nil, sdk.ErrUnknownRequest("No validator set found for epoch")
+	}
+
+	// json record
+	bz, err := json.Marshal(historical)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryHistoricalValidatorSetByHeight(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryHeightParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	historical, found := keeper.GetHistoricalValidatorSetByHeight(ctx, params.Height)
+	if !found {
+		return nil, sdk.ErrUnknownRequest("No validator set found for height")
+	}
+
+	// json record
+	bz, err := json.Marshal(historical)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
@@ -0,0 +1,921 @@
+package staking
+
+import (
+	"bytes"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmCommon "github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/helper"
+	"github.com/maticnetwork/heimdall/staking/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// NewSideTxHandler returns side tx handler for staking module
+func NewSideTxHandler(k Keeper, contractCaller helper.IContractCaller) hmTypes.SideTxHandler {
+	return func(ctx sdk.Context, msg sdk.Msg) hmTypes.SideTxResult {
+		switch msg := msg.(type) {
+		case types.MsgValidatorJoin:
+			return SideHandleMsgValidatorJoin(ctx, msg, k, contractCaller)
+		case types.MsgValidatorExit:
+			return SideHandleMsgValidatorExit(ctx, msg, k, contractCaller)
+		case types.MsgSignerUpdate:
+			return SideHandleMsgSignerUpdate(ctx, msg, k, contractCaller)
+		case types.MsgStakeUpdate:
+			return SideHandleMsgStakeUpdate(ctx, msg, k, contractCaller)
+		case types.MsgValidatorRestake:
+			return SideHandleMsgValidatorRestake(ctx, msg, k, contractCaller)
+		case types.MsgValidatorJailed:
+			return SideHandleMsgValidatorJailed(ctx, msg, k, contractCaller)
+		case types.MsgDelegate:
+			return SideHandleMsgDelegate(ctx, msg, k, contractCaller)
+		case types.MsgUndelegate:
+			return SideHandleMsgUndelegate(ctx, msg, k, contractCaller)
+		case types.MsgStartAuction:
+			return SideHandleMsgStartAuction(ctx, msg, k, contractCaller)
+		case types.MsgConfirmAuction:
+			return SideHandleMsgConfirmAuction(ctx, msg, k, contractCaller)
+		default:
+			return hmTypes.SideTxResultSkip
+		}
+	}
+}
+
+// NewPostTxHandler returns post tx handler for staking module
+func NewPostTxHandler(k Keeper, contractCaller helper.IContractCaller) hmTypes.PostTxHandler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case types.MsgValidatorJoin:
+			return PostHandleMsgValidatorJoin(ctx, msg, k)
+		case types.MsgValidatorExit:
+			return PostHandleMsgValidatorExit(ctx, msg, k)
+		case types.MsgSignerUpdate:
+			return PostHandleMsgSignerUpdate(ctx, msg, k)
+		case types.MsgStakeUpdate:
+			return PostHandleMsgStakeUpdate(ctx, msg, k)
+		case types.MsgValidatorRestake:
+			return PostHandleMsgValidatorRestake(ctx, msg, k)
+		case types.MsgValidatorJailed:
+			return PostHandleMsgValidatorJailed(ctx, msg, k)
+		case types.MsgDelegate:
+			return PostHandleMsgDelegate(ctx, msg, k)
+		case types.MsgUndelegate:
+			return PostHandleMsgUndelegate(ctx, msg, k)
+		case types.MsgStartAuction:
+			return PostHandleMsgStartAuction(ctx, msg, k)
+		case types.MsgConfirmAuction:
+			return PostHandleMsgConfirmAuction(ctx, msg, k)
+		default:
+			return sdk.ErrTxDecode("Invalid message in staking module").Result()
+		}
+	}
+}
+
+//
+// Side handlers
+//
+
+// SideHandleMsgValidatorJoin verifies validator join against staked event on root chain
+func SideHandleMsgValidatorJoin(ctx sdk.Context, msg types.MsgValidatorJoin, k Keeper, contractCaller helper.IContractCaller) hmTypes.SideTxResult {
+	// get main tx receipt
+	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), k.GetConfirmationBlocks(ctx))
+	if err != nil || receipt == nil {
+		return hmTypes.SideTxResultSkip
+	}
+
+	eventLog, err := contractCaller.DecodeValidatorJoinEvent(receipt, msg.LogIndex)
+	if err != nil || eventLog == nil {
+		k.Logger(ctx).Error("Error fetching log from txhash")
+		return hmTypes.SideTxResultNo
+	}
+
+	if eventLog.ValidatorId.Uint64() != msg.ID.Uint64() {
+		k.Logger(ctx).Error("ID in message doesnt match id in logs", "MsgID", msg.ID, "IdFromTx", eventLog.ValidatorId)
+		return hmTypes.SideTxResultNo
+	}
+
+	if !bytes.Equal(eventLog.Signer.Bytes(), msg.SignerPubKey.Address().Bytes()) {
+		k.Logger(ctx).Error(
+			"Signer Address does not match",
+			"msgValidator", msg.SignerPubKey.Address().String(),
+			"mainchainValidator", eventLog.Signer.String(),
+		)
+		return hmTypes.SideTxResultNo
+	}
+
+	if eventLog.ActivationEpoch.Uint64() != msg.ActivationEpoch {
+		k.Logger(ctx).Error("ActivationEpoch in message doesnt match with logs", "MsgActivationEpoch", msg.ActivationEpoch, "ActivationEpochFromTx", eventLog.ActivationEpoch)
+		return hmTypes.SideTxResultNo
+	}
+
+	if eventLog.Amount.Cmp(msg.Amount.BigInt()) != 0 {
+		k.Logger(ctx).Error("Amount in message doesnt match with logs", "MsgAmount", msg.Amount, "AmountFromTx", eventLog.Amount)
+		return hmTypes.SideTxResultNo
+	}
+
+	return hmTypes.SideTxResultYes
+}
+
+// SideHandleMsgStakeUpdate verifies stake update against root chain event
+func SideHandleMsgStakeUpdate(ctx sdk.Context, msg types.MsgStakeUpdate, k Keeper, contractCaller helper.IContractCaller) hmTypes.SideTxResult {
+	// get main tx receipt
+	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), k.GetConfirmationBlocks(ctx))
+	if err != nil || receipt == nil {
+		return hmTypes.SideTxResultSkip
+	}
+
+	eventLog, err := contractCaller.DecodeValidatorStakeUpdateEvent(receipt, msg.LogIndex)
+	if err != nil || eventLog == nil {
+		k.Logger(ctx).Error("Error fetching log from txhash")
+		return hmTypes.SideTxResultNo
+	}
+
+	if receipt.BlockNumber.Uint64() != msg.BlockNumber {
+		k.Logger(ctx).Error("BlockNumber in message doesnt match blocknumber in receipt", "MsgBlockNumber", msg.BlockNumber, "ReceiptBlockNumber", receipt.BlockNumber.Uint64())
+		return hmTypes.SideTxResultNo
+	}
+
+	if eventLog.ValidatorId.Uint64() != msg.ID.Uint64() {
+		k.Logger(ctx).Error("ID in message doesnt match id in logs", "MsgID", msg.ID, "IdFromTx", eventLog.ValidatorId)
+		return hmTypes.SideTxResultNo
+	}
+
+	if eventLog.NewAmount.Cmp(msg.NewAmount.BigInt()) != 0 {
+		k.Logger(ctx).Error("NewAmount in message doesnt match with logs", "MsgNewAmount", msg.NewAmount, "NewAmountFromTx", eventLog.NewAmount)
+		return hmTypes.SideTxResultNo
+	}
+
+	return hmTypes.SideTxResultYes
+}
+
+// SideHandleMsgSignerUpdate verifies signer update against root chain event
+func SideHandleMsgSignerUpdate(ctx sdk.Context, msg types.MsgSignerUpdate, k Keeper, contractCaller helper.IContractCaller) hmTypes.SideTxResult {
+	// get main tx receipt
+	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), k.GetConfirmationBlocks(ctx))
+	if err != nil || receipt == nil {
+		return hmTypes.SideTxResultSkip
+	}
+
+	newSigner := msg.NewSignerPubKey.Address()
+
+	eventLog, err := contractCaller.DecodeSignerUpdateEvent(receipt, msg.LogIndex)
+	if err != nil || eventLog == nil {
+		k.Logger(ctx).Error("Error fetching log from txhash")
+		return hmTypes.SideTxResultNo
+	}
+
+	if receipt.BlockNumber.Uint64() != msg.BlockNumber {
+		k.Logger(ctx).Error("BlockNumber in message doesnt match blocknumber in receipt", "MsgBlockNumber", msg.BlockNumber, "ReceiptBlockNumber", receipt.BlockNumber.Uint64())
+		return hmTypes.SideTxResultNo
+	}
+
+	if eventLog.ValidatorId.Uint64() != msg.ID.Uint64() {
+		k.Logger(ctx).Error("ID in message doesnt match id in logs", "MsgID", msg.ID, "IdFromTx", eventLog.ValidatorId.Uint64())
+		return hmTypes.SideTxResultNo
+	}
+
+	if !bytes.Equal(eventLog.NewSigner.Bytes(), newSigner.Bytes()) {
+		k.Logger(ctx).Error("Signer in txhash and msg dont match", "MsgSigner", newSigner.String(), "SignerTx", eventLog.NewSigner.String())
+		return hmTypes.SideTxResultNo
+	}
+
+	return hmTypes.SideTxResultYes
+}
+
+// SideHandleMsgValidatorExit verifies validator exit against unstake init event on root chain
+func SideHandleMsgValidatorExit(ctx sdk.Context, msg types.MsgValidatorExit, k Keeper, contractCaller helper.IContractCaller) hmTypes.SideTxResult {
+	// get main tx receipt
+	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), k.GetConfirmationBlocks(ctx))
+	if err != nil || receipt == nil {
+		return hmTypes.SideTxResultSkip
+	}
+
+	eventLog, err := contractCaller.DecodeValidatorExitEvent(receipt, msg.LogIndex)
+	if err != nil || eventLog == nil {
+		k.Logger(ctx).Error("Error fetching log from txhash")
+		return hmTypes.SideTxResultNo
+	}
+
+	if eventLog.ValidatorId.Uint64() != msg.ID.Uint64() {
+		k.Logger(ctx).Error("ID in message doesnt match id in logs", "MsgID", msg.ID, "IdFromTx", eventLog.ValidatorId)
+		return hmTypes.SideTxResultNo
+	}
+
+	if eventLog.DeactivationEpoch.Uint64() != msg.DeactivationEpoch {
+		k.Logger(ctx).Error("DeactivationEpoch in message doesnt match with logs", "MsgDeactivationEpoch", msg.DeactivationEpoch, "DeactivationEpochFromTx", eventLog.DeactivationEpoch)
+		return hmTypes.SideTxResultNo
+	}
+
+	return hmTypes.SideTxResultYes
+}
+
+// SideHandleMsgValidatorRestake verifies validator restake against restaked event on root chain
+func SideHandleMsgValidatorRestake(ctx sdk.Context, msg types.MsgValidatorRestake, k Keeper, contractCaller helper.IContractCaller) hmTypes.SideTxResult {
+	// get main tx receipt
+	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), k.GetConfirmationBlocks(ctx))
+	if err != nil || receipt == nil {
+		return hmTypes.SideTxResultSkip
+	}
+
+	eventLog, err := contractCaller.DecodeValidatorReStakedEvent(receipt, msg.LogIndex)
+	if err != nil || eventLog == nil {
+		k.Logger(ctx).Error("Error fetching log from txhash")
+		return hmTypes.SideTxResultNo
+	}
+
+	if receipt.BlockNumber.Uint64() != msg.BlockNumber {
+		k.Logger(ctx).Error("BlockNumber in message doesnt match blocknumber in receipt", "MsgBlockNumber", msg.BlockNumber, "ReceiptBlockNumber", receipt.BlockNumber.Uint64())
+		return hmTypes.SideTxResultNo
+	}
+
+	if eventLog.ValidatorId.Uint64() != msg.ID.Uint64() {
+		k.Logger(ctx).Error("ID in message doesnt match id in logs", "MsgID", msg.ID, "IdFromTx", eventLog.ValidatorId)
+		return hmTypes.SideTxResultNo
+	}
+
+	// total in logs is stake of whole network, amount is stake of validator
+	if eventLog.Amount.Cmp(msg.NewAmount.BigInt()) != 0 {
+		k.Logger(ctx).Error("NewAmount in message doesnt match amount in logs", "MsgNewAmount", msg.NewAmount, "AmountFromTx", eventLog.Amount)
+		return hmTypes.SideTxResultNo
+	}
+
+	return hmTypes.SideTxResultYes
+}
+
+// SideHandleMsgValidatorJailed verifies validator jailed against jailed event on root chain
+func SideHandleMsgValidatorJailed(ctx sdk.Context, msg types.MsgValidatorJailed, k Keeper, contractCaller helper.IContractCaller) hmTypes.SideTxResult {
+	// get main tx receipt
+	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), k.GetConfirmationBlocks(ctx))
+	if err != nil || receipt == nil {
+		return hmTypes.SideTxResultSkip
+	}
+
+	eventLog, err := contractCaller.DecodeValidatorJailedEvent(receipt, msg.LogIndex)
+	if err != nil || eventLog == nil {
+		k.Logger(ctx).Error("Error fetching log from txhash")
+		return hmTypes.SideTxResultNo
+	}
+
+	if receipt.BlockNumber.Uint64() != msg.BlockNumber {
+		k.Logger(ctx).Error("BlockNumber in message doesnt match blocknumber in receipt", "MsgBlockNumber", msg.BlockNumber, "ReceiptBlockNumber", receipt.BlockNumber.Uint64())
+		return hmTypes.SideTxResultNo
+	}
+
+	if eventLog.ValidatorId.Uint64() != msg.ID.Uint64() {
+		k.Logger(ctx).Error("ID in message doesnt match id in logs", "MsgID", msg.ID, "IdFromTx", eventLog.ValidatorId)
+		return hmTypes.SideTxResultNo
+	}
+
+	if eventLog.ExitEpoch.Uint64() != msg.ExitEpoch {
+		k.Logger(ctx).Error("ExitEpoch in message doesnt match with logs", "MsgExitEpoch", msg.ExitEpoch, "ExitEpochFromTx", eventLog.ExitEpoch)
+		return hmTypes.SideTxResultNo
+	}
+
+	return hmTypes.SideTxResultYes
+}
+
+// SideHandleMsgDelegate verifies delegate against share minted event on root chain
+func SideHandleMsgDelegate(ctx sdk.Context, msg types.MsgDelegate, k Keeper, contractCaller helper.IContractCaller) hmTypes.SideTxResult {
+	// get main tx receipt
+	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), k.GetConfirmationBlocks(ctx))
+	if err != nil || receipt == nil {
+		return hmTypes.SideTxResultSkip
+	}
+
+	eventLog, err := contractCaller.DecodeShareMintedEvent(receipt, msg.LogIndex)
+	if err != nil || eventLog == nil {
+		k.Logger(ctx).Error("Error fetching log from txhash")
+		return hmTypes.SideTxResultNo
+	}
+
+	if receipt.BlockNumber.Uint64() != msg.BlockNumber {
+		k.Logger(ctx).Error("BlockNumber in message doesnt match blocknumber in receipt", "MsgBlockNumber", msg.BlockNumber, "ReceiptBlockNumber", receipt.BlockNumber.Uint64())
+		return hmTypes.SideTxResultNo
+	}
+
+	if eventLog.ValidatorId.Uint64() != msg.ID.Uint64() {
+		k.Logger(ctx).Error("ID in message doesnt match id in logs", "MsgID", msg.ID, "IdFromTx", eventLog.ValidatorId)
+		return hmTypes.SideTxResultNo
+	}
+
+	if !bytes.Equal(eventLog.User.Bytes(), msg.Delegator.Bytes()) {
+		k.Logger(ctx).Error("Delegator in message doesnt match user in logs", "MsgDelegator", msg.Delegator.String(), "UserFromTx", eventLog.User.String())
+		return hmTypes.SideTxResultNo
+	}
+
+	if eventLog.Amount.Cmp(msg.Shares.BigInt()) != 0 {
+		k.Logger(ctx).Error("Shares in message doesnt match with logs", "MsgShares", msg.Shares, "SharesFromTx", eventLog.Amount)
+		return hmTypes.SideTxResultNo
+	}
+
+	if eventLog.Tokens.Cmp(msg.Amount.BigInt()) != 0 {
+		k.Logger(ctx).Error("Amount in message doesnt match tokens in logs", "MsgAmount", msg.Amount, "TokensFromTx", eventLog.Tokens)
+		return hmTypes.SideTxResultNo
+	}
+
+	return hmTypes.SideTxResultYes
+}
+
+// SideHandleMsgUndelegate verifies undelegate against share burned event on root chain
+func SideHandleMsgUndelegate(ctx sdk.Context, msg types.MsgUndelegate, k Keeper, contractCaller helper.IContractCaller) hmTypes.SideTxResult {
+	// get main tx receipt
+	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), k.GetConfirmationBlocks(ctx))
+	if err != nil || receipt == nil {
+		return hmTypes.SideTxResultSkip
+	}
+
+	eventLog, err := contractCaller.DecodeShareBurnedEvent(receipt, msg.LogIndex)
+	if err != nil || eventLog == nil {
+		k.Logger(ctx).Error("Error fetching log from txhash")
+		return hmTypes.SideTxResultNo
+	}
+
+	if receipt.BlockNumber.Uint64() != msg.BlockNumber {
+		k.Logger(ctx).Error("BlockNumber in message doesnt match blocknumber in receipt", "MsgBlockNumber", msg.BlockNumber, "ReceiptBlockNumber", receipt.BlockNumber.Uint64())
+		return hmTypes.SideTxResultNo
+	}
+
+	if eventLog.ValidatorId.Uint64() != msg.ID.Uint64() {
+		k.Logger(ctx).Error("ID in message doesnt match id in logs", "MsgID", msg.ID, "IdFromTx", eventLog.ValidatorId)
+		return hmTypes.SideTxResultNo
+	}
+
+	if !bytes.Equal(eventLog.User.Bytes(), msg.Delegator.Bytes()) {
+		k.Logger(ctx).Error("Delegator in message doesnt match user in logs", "MsgDelegator", msg.Delegator.String(), "UserFromTx", eventLog.User.String())
+		return hmTypes.SideTxResultNo
+	}
+
+	if eventLog.Amount.Cmp(msg.Shares.BigInt()) != 0 {
+		k.Logger(ctx).Error("Shares in message doesnt match with logs", "MsgShares", msg.Shares, "SharesFromTx", eventLog.Amount)
+		return hmTypes.SideTxResultNo
+	}
+
+	if eventLog.Tokens.Cmp(msg.Amount.BigInt()) != 0 {
+		k.Logger(ctx).Error("Amount in message doesnt match tokens in logs", "MsgAmount", msg.Amount, "TokensFromTx", eventLog.Tokens)
+		return hmTypes.SideTxResultNo
+	}
+
+	return hmTypes.SideTxResultYes
+}
+
+// SideHandleMsgStartAuction verifies auction bid against start auction event on root chain
+func SideHandleMsgStartAuction(ctx sdk.Context, msg types.MsgStartAuction, k Keeper, contractCaller helper.IContractCaller) hmTypes.SideTxResult {
+	// get main tx receipt
+	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), k.GetConfirmationBlocks(ctx))
+	if err != nil || receipt == nil {
+		return hmTypes.SideTxResultSkip
+	}
+
+	eventLog, err := contractCaller.DecodeStartAuctionEvent(receipt, msg.LogIndex)
+	if err != nil || eventLog == nil {
+		k.Logger(ctx).Error("Error fetching log from txhash")
+		return hmTypes.SideTxResultNo
+	}
+
+	if receipt.BlockNumber.Uint64() != msg.BlockNumber {
+		k.Logger(ctx).Error("BlockNumber in message doesnt match blocknumber in receipt", "MsgBlockNumber", msg.BlockNumber, "ReceiptBlockNumber", receipt.BlockNumber.Uint64())
+		return hmTypes.SideTxResultNo
+	}
+
+	if eventLog.ValidatorId.Uint64() != msg.ID.Uint64() {
+		k.Logger(ctx).Error("ID in message doesnt match id in logs", "MsgID", msg.ID, "IdFromTx", eventLog.ValidatorId)
+		return hmTypes.SideTxResultNo
+	}
+
+	if eventLog.Amount.Cmp(msg.Amount.BigInt()) != 0 {
+		k.Logger(ctx).Error("Amount in message doesnt match with logs", "MsgAmount", msg.Amount, "AmountFromTx", eventLog.Amount)
+		return hmTypes.SideTxResultNo
+	}
+
+	if eventLog.AuctionAmount.Cmp(msg.AuctionAmount.BigInt()) != 0 {
+		k.Logger(ctx).Error("AuctionAmount in message doesnt match with logs", "MsgAuctionAmount", msg.AuctionAmount, "AuctionAmountFromTx", eventLog.AuctionAmount)
+		return hmTypes.SideTxResultNo
+	}
+
+	return hmTypes.SideTxResultYes
+}
+
+// SideHandleMsgConfirmAuction verifies confirmed bid against confirm auction and staked events on root chain
+func SideHandleMsgConfirmAuction(ctx sdk.Context, msg types.MsgConfirmAuction, k Keeper, contractCaller helper.IContractCaller) hmTypes.SideTxResult {
+	// get main tx receipt
+	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash(), k.GetConfirmationBlocks(ctx))
+	if err != nil || receipt == nil {
+		return hmTypes.SideTxResultSkip
+	}
+
+	eventLog, err := contractCaller.DecodeConfirmAuctionEvent(receipt, msg.LogIndex)
+	if err != nil || eventLog == nil {
+		k.Logger(ctx).Error("Error fetching log from txhash")
+		return hmTypes.SideTxResultNo
+	}
+
+	if receipt.BlockNumber.Uint64() != msg.BlockNumber {
+		k.Logger(ctx).Error("BlockNumber in message doesnt match blocknumber in receipt", "MsgBlockNumber", msg.BlockNumber, "ReceiptBlockNumber", receipt.BlockNumber.Uint64())
+		return hmTypes.SideTxResultNo
+	}
+
+	if eventLog.OldValidatorId.Uint64() != msg.OldID.Uint64() || eventLog.NewValidatorId.Uint64() != msg.NewID.Uint64() {
+		k.Logger(ctx).Error("IDs in message dont match ids in logs", "MsgOldID", msg.OldID, "MsgNewID", msg.NewID, "OldIdFromTx", eventLog.OldValidatorId, "NewIdFromTx", eventLog.NewValidatorId)
+		return hmTypes.SideTxResultNo
+	}
+
+	if eventLog.Amount.Cmp(msg.Amount.BigInt()) != 0 {
+		k.Logger(ctx).Error("Amount in message doesnt match with logs", "MsgAmount", msg.Amount, "AmountFromTx", eventLog.Amount)
+		return hmTypes.SideTxResultNo
+	}
+
+	// auction winner is staked in same tx
+	stakedLog, err := contractCaller.DecodeValidatorJoinEvent(receipt, msg.StakedLogIndex)
+	if err != nil || stakedLog == nil {
+		k.Logger(ctx).Error("Error fetching staked log from txhash")
+		return hmTypes.SideTxResultNo
+	}
+
+	if stakedLog.ValidatorId.Uint64() != msg.NewID.Uint64() {
+		k.Logger(ctx).Error("New ID in message doesnt match id in staked logs", "MsgNewID", msg.NewID, "IdFromTx", stakedLog.ValidatorId)
+		return hmTypes.SideTxResultNo
+	}
+
+	if !bytes.Equal(stakedLog.Signer.Bytes(), msg.SignerPubKey.Address().Bytes()) {
+		k.Logger(ctx).Error("Signer Address does not match", "msgValidator", msg.SignerPubKey.Address().String(), "mainchainValidator", stakedLog.Signer.String())
+		return hmTypes.SideTxResultNo
+	}
+
+	if stakedLog.ActivationEpoch.Uint64() != msg.ActivationEpoch {
+		k.Logger(ctx).Error("ActivationEpoch in message doesnt match with logs", "MsgActivationEpoch", msg.ActivationEpoch, "ActivationEpochFromTx", stakedLog.ActivationEpoch)
+		return hmTypes.SideTxResultNo
+	}
+
+	return hmTypes.SideTxResultYes
+}
+
+//
+// Post handlers
+//
+
+// PostHandleMsgValidatorJoin adds validator to state once join is approved
+func PostHandleMsgValidatorJoin(ctx sdk.Context, msg types.MsgValidatorJoin, k Keeper) sdk.Result {
+	// state might have changed while side tx was pending
+	if err := validateValidatorJoin(ctx, msg, k); err != nil {
+		return err.Result()
+	}
+
+	// voting power from staked amount
+	power, err := k.GetPowerFromAmount(ctx, msg.Amount.BigInt())
+	if err != nil {
+		return hmCommon.ErrInvalidMsg(k.Codespace(), "Invalid amount for validator: %v", msg.ID).Result()
+	}
+
+	if err := k.ValidateTotalPower(ctx, msg.ID, power); err != nil {
+		k.Logger(ctx).Error("Unable to add validator power", "error", err, "ValidatorID", msg.ID)
+		return hmCommon.ErrInvalidMsg(k.Codespace(), "Invalid amount for validator: %v", msg.ID).Result()
+	}
+
+	pubkey := msg.SignerPubKey
+
+	// create new validator
+	newValidator := hmTypes.Validator{
+		ID:          msg.ID,
+		StartEpoch:  msg.ActivationEpoch,
+		EndEpoch:    0,
+		VotingPower: power,
+		PubKey:      pubkey,
+		Signer:      hmTypes.BytesToHeimdallAddress(pubkey.Address().Bytes()),
+		LastUpdated: 0,
+		Amount:      msg.Amount,
+	}
+
+	// add validator to store
+	k.Logger(ctx).Debug("Adding new validator to state", "validator", newValidator.String())
+	if err := k.AddValidator(ctx, newValidator); err != nil {
+		k.Logger(ctx).Error("Unable to add validator to state", "error", err, "validator", newValidator.String())
+		return hmCommon.ErrValidatorSave(k.Codespace()).Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeValidatorJoin,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(newValidator.ID.Uint64(), 10)),
+			sdk.NewAttribute(types.AttributeKeySigner, newValidator.Signer.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, newValidator.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyPower, strconv.FormatInt(newValidator.VotingPower, 10)),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// PostHandleMsgStakeUpdate updates validator power once stake update is approved
+func PostHandleMsgStakeUpdate(ctx sdk.Context, msg types.MsgStakeUpdate, k Keeper) sdk.Result {
+	validator, sdkErr := validateStakingSequence(ctx, msg.ID, msg.BlockNumber, msg.LogIndex, k)
+	if sdkErr != nil {
+		return sdkErr.Result()
+	}
+
+	// sequence id
+	sequence := getStakingSequence(msg.BlockNumber, msg.LogIndex)
+
+	// update last updated
+	validator.LastUpdated = sequence
+
+	// set validator amount, voting power includes delegated stake
+	validator.Amount = msg.NewAmount
+	p, err := k.GetValidatorPower(ctx, validator)
+	if err != nil {
+		return hmCommon.ErrInvalidMsg(k.Codespace(), "Invalid amount for validator: %v", msg.ID).Result()
+	}
+	validator.VotingPower = p
+
+	// save validator
+	err = k.AddValidator(ctx, validator)
+	if err != nil {
+		k.Logger(ctx).Error("Unable to update signer", "error", err, "ValidatorID", validator.ID)
+		return hmCommon.ErrSignerUpdateError(k.Codespace()).Result()
+	}
+
+	// save staking sequence
+	k.SetStakingSequence(ctx, sequence)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeStakeUpdate,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(validator.ID.Uint64(), 10)),
+			sdk.NewAttribute(types.AttributeKeyAmount, validator.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyPower, strconv.FormatInt(validator.VotingPower, 10)),
+			sdk.NewAttribute(types.AttributeKeyUpdatedAt, strconv.FormatUint(validator.LastUpdated, 10)),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// PostHandleMsgSignerUpdate updates validator signer once signer update is approved
+func PostHandleMsgSignerUpdate(ctx sdk.Context, msg types.MsgSignerUpdate, k Keeper) sdk.Result {
+	validator, sdkErr := validateStakingSequence(ctx, msg.ID, msg.BlockNumber, msg.LogIndex, k)
+	if sdkErr != nil {
+		return sdkErr.Result()
+	}
+	oldValidator := validator.Copy()
+
+	newPubKey := msg.NewSignerPubKey
+	newSigner := newPubKey.Address()
+
+	// sequence id
+	sequence := getStakingSequence(msg.BlockNumber, msg.LogIndex)
+
+	// update last udpated
+	validator.LastUpdated = sequence
+
+	// check if we are actually updating signer
+	if !bytes.Equal(newSigner.Bytes(), validator.Signer.Bytes()) {
+		// Update signer in prev Validator
+		validator.Signer = hmTypes.HeimdallAddress(newSigner)
+		validator.PubKey = newPubKey
+		k.Logger(ctx).Debug("Updating new signer", "signer", newSigner.String(), "oldSigner", oldValidator.Signer.String(), "validatorID", msg.ID)
+	}
+
+	k.Logger(ctx).Debug("Removing old validator", "validator", oldValidator.String())
+
+	// remove old validator from HM
+	oldValidator.EndEpoch = k.ackRetriever.GetACKCount(ctx)
+
+	// remove old validator from TM
+	oldValidator.VotingPower = 0
+	// updated last
+	oldValidator.LastUpdated = sequence
+
+	// save old validator
+	if err := k.AddValidator(ctx, *oldValidator); err != nil {
+		k.Logger(ctx).Error("Unable to update signer", "error", err, "validatorId", validator.ID)
+		return hmCommon.ErrSignerUpdateError(k.Codespace()).Result()
+	}
+
+	// adding new validator
+	k.Logger(ctx).Debug("Adding new validator", "validator", validator.String())
+
+	// save validator
+	if err := k.AddValidator(ctx, validator); err != nil {
+		k.Logger(ctx).Error("Unable to update signer", "error", err, "ValidatorID", validator.ID)
+		return hmCommon.ErrSignerUpdateError(k.Codespace()).Result()
+	}
+	// save staking sequence
+	k.SetStakingSequence(ctx, sequence)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSignerUpdate,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(validator.ID.Uint64(), 10)),
+			sdk.NewAttribute(types.AttributeKeyUpdatedAt, strconv.FormatUint(validator.LastUpdated, 10)),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// PostHandleMsgValidatorExit sets deactivation epoch once validator exit is approved
+func PostHandleMsgValidatorExit(ctx sdk.Context, msg types.MsgValidatorExit, k Keeper) sdk.Result {
+	validator, sdkErr := validateValidatorExit(ctx, msg, k)
+	if sdkErr != nil {
+		return sdkErr.Result()
+	}
+
+	// Add deactivation time for validator
+	updatedVal := validator
+	updatedVal.EndEpoch = msg.DeactivationEpoch
+	if err := k.AddDeactivationEpoch(ctx, validator, updatedVal); err != nil {
+		k.Logger(ctx).Error("Error while setting deactivation epoch to validator", "error", err, "validatorID", validator.ID)
+		return hmCommon.ErrValidatorNotDeactivated(k.Codespace()).Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeValidatorExit,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(validator.ID.Uint64(), 10)),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// PostHandleMsgValidatorRestake updates validator power once restake is approved
+func PostHandleMsgValidatorRestake(ctx sdk.Context, msg types.MsgValidatorRestake, k Keeper) sdk.Result {
+	validator, sdkErr := validateStakingSequence(ctx, msg.ID, msg.BlockNumber, msg.LogIndex, k)
+	if sdkErr != nil {
+		return sdkErr.Result()
+	}
+
+	// sequence id
+	sequence := getStakingSequence(msg.BlockNumber, msg.LogIndex)
+
+	// update last updated
+	validator.LastUpdated = sequence
+
+	// restaked total and delegated stake is new voting power, validator set picks it up on next update
+	validator.Amount = msg.NewAmount
+	p, err := k.GetValidatorPower(ctx, validator)
+	if err != nil {
+		return hmCommon.ErrInvalidMsg(k.Codespace(), "Invalid amount for validator: %v", msg.ID).Result()
+	}
+	validator.VotingPower = p
+
+	// save validator
+	if err := k.AddValidator(ctx, validator); err != nil {
+		k.Logger(ctx).Error("Unable to update validator", "error", err, "ValidatorID", validator.ID)
+		return hmCommon.ErrValidatorSave(k.Codespace()).Result()
+	}
+
+	// save staking sequence
+	k.SetStakingSequence(ctx, sequence)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRestake,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(validator.ID.Uint64(), 10)),
+			sdk.NewAttribute(types.AttributeKeyAmount, validator.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyPower, strconv.FormatInt(validator.VotingPower, 10)),
+			sdk.NewAttribute(types.AttributeKeyUpdatedAt, strconv.FormatUint(validator.LastUpdated, 10)),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// PostHandleMsgValidatorJailed sets exit epoch of validator jailed on root chain once approved
+func PostHandleMsgValidatorJailed(ctx sdk.Context, msg types.MsgValidatorJailed, k Keeper) sdk.Result {
+	validator, sdkErr := validateStakingSequence(ctx, msg.ID, msg.BlockNumber, msg.LogIndex, k)
+	if sdkErr != nil {
+		return sdkErr.Result()
+	}
+
+	// sequence id
+	sequence := getStakingSequence(msg.BlockNumber, msg.LogIndex)
+
+	// validator leaves validator set once ack count reaches exit epoch
+	validator.EndEpoch = msg.ExitEpoch
+	validator.LastUpdated = sequence
+
+	// save validator
+	if err := k.AddValidator(ctx, validator); err != nil {
+		k.Logger(ctx).Error("Unable to update validator", "error", err, "ValidatorID", validator.ID)
+		return hmCommon.ErrValidatorSave(k.Codespace()).Result()
+	}
+
+	// save staking sequence
+	k.SetStakingSequence(ctx, sequence)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeJailed,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(validator.ID.Uint64(), 10)),
+			sdk.NewAttribute(types.AttributeKeyExitEpoch, strconv.FormatUint(validator.EndEpoch, 10)),
+			sdk.NewAttribute(types.AttributeKeyUpdatedAt, strconv.FormatUint(validator.LastUpdated, 10)),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// PostHandleMsgDelegate adds minted shares to delegation and delegated stake to validator power
+func PostHandleMsgDelegate(ctx sdk.Context, msg types.MsgDelegate, k Keeper) sdk.Result {
+	validator, sdkErr := validateStakingSequence(ctx, msg.ID, msg.BlockNumber, msg.LogIndex, k)
+	if sdkErr != nil {
+		return sdkErr.Result()
+	}
+
+	delegation, found := k.GetDelegation(ctx, msg.ID, msg.Delegator)
+	if !found {
+		delegation = types.NewDelegation(msg.ID, msg.Delegator, hmTypes.ZeroInt(), hmTypes.ZeroInt())
+	}
+
+	delegation.Shares = delegation.Shares.Add(msg.Shares)
+	delegation.Amount = delegation.Amount.Add(msg.Amount)
+
+	return updateDelegation(ctx, msg.BlockNumber, msg.LogIndex, validator, delegation, types.EventTypeDelegate, k)
+}
+
+// PostHandleMsgUndelegate removes burned shares from delegation and undelegated stake from validator power
+func PostHandleMsgUndelegate(ctx sdk.Context, msg types.MsgUndelegate, k Keeper) sdk.Result {
+	validator, delegation, sdkErr := validateUndelegate(ctx, msg, k)
+	if sdkErr != nil {
+		return sdkErr.Result()
+	}
+
+	delegation.Shares = delegation.Shares.Sub(msg.Shares)
+	delegation.Amount = delegation.Amount.Sub(msg.Amount)
+	if delegation.Amount.IsNegative() {
+		delegation.Amount = hmTypes.ZeroInt()
+	}
+
+	return updateDelegation(ctx, msg.BlockNumber, msg.LogIndex, validator, delegation, types.EventTypeUndelegate, k)
+}
+
+// updateDelegation stores delegation and updates voting power of validator with total delegated stake
+func updateDelegation(ctx sdk.Context, blockNumber uint64, logIndex uint64, validator hmTypes.Validator, delegation types.Delegation, eventType string, k Keeper) sdk.Result {
+	// sequence id
+	sequence := getStakingSequence(blockNumber, logIndex)
+	delegation.LastUpdated = sequence
+
+	if err := k.SetDelegation(ctx, delegation); err != nil {
+		k.Logger(ctx).Error("Unable to store delegation", "error", err, "delegation", delegation.String())
+		return hmCommon.ErrValidatorSave(k.Codespace()).Result()
+	}
+
+	// validator set picks up new power on next update
+	power, err := k.GetValidatorPower(ctx, validator)
+	if err != nil {
+		k.Logger(ctx).Error("Unable to compute validator power", "error", err, "ValidatorID", validator.ID)
+		return hmCommon.ErrInvalidMsg(k.Codespace(), "Invalid amount for validator: %v", validator.ID).Result()
+	}
+	validator.VotingPower = power
+	validator.LastUpdated = sequence
+	if err := k.AddValidator(ctx, validator); err != nil {
+		k.Logger(ctx).Error("Unable to update validator", "error", err, "ValidatorID", validator.ID)
+		return hmCommon.ErrValidatorSave(k.Codespace()).Result()
+	}
+
+	// save staking sequence
+	k.SetStakingSequence(ctx, sequence)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(validator.ID.Uint64(), 10)),
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegation.Delegator.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, delegation.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyUpdatedAt, strconv.FormatUint(sequence, 10)),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// PostHandleMsgStartAuction records highest bid on validator slot once approved
+func PostHandleMsgStartAuction(ctx sdk.Context, msg types.MsgStartAuction, k Keeper) sdk.Result {
+	validator, sdkErr := validateStakingSequence(ctx, msg.ID, msg.BlockNumber, msg.LogIndex, k)
+	if sdkErr != nil {
+		return sdkErr.Result()
+	}
+
+	// sequence id
+	sequence := getStakingSequence(msg.BlockNumber, msg.LogIndex)
+
+	auction := types.Auction{
+		ValidatorID:   validator.ID,
+		Amount:        msg.Amount,
+		AuctionAmount: msg.AuctionAmount,
+		LastUpdated:   sequence,
+	}
+	if err := k.SetAuction(ctx, auction); err != nil {
+		k.Logger(ctx).Error("Unable to store auction", "error", err, "auction", auction.String())
+		return hmCommon.ErrValidatorSave(k.Codespace()).Result()
+	}
+
+	// save staking sequence
+	k.SetStakingSequence(ctx, sequence)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeStartAuction,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(validator.ID.Uint64(), 10)),
+			sdk.NewAttribute(types.AttributeKeyAuctionAmount, msg.AuctionAmount.String()),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// PostHandleMsgConfirmAuction swaps old validator with auction winner once approved
+func PostHandleMsgConfirmAuction(ctx sdk.Context, msg types.MsgConfirmAuction, k Keeper) sdk.Result {
+	oldValidator, sdkErr := validateConfirmAuction(ctx, msg, k)
+	if sdkErr != nil {
+		return sdkErr.Result()
+	}
+
+	// voting power from staked amount
+	power, err := k.GetPowerFromAmount(ctx, msg.Amount.BigInt())
+	if err != nil {
+		return hmCommon.ErrInvalidMsg(k.Codespace(), "Invalid amount for validator: %v", msg.NewID).Result()
+	}
+
+	// old validator leaves, so its power doesn't count towards total
+	if err := k.ValidateTotalPower(ctx, msg.NewID, power, oldValidator.ID); err != nil {
+		k.Logger(ctx).Error("Unable to add validator power", "error", err, "ValidatorID", msg.NewID)
+		return hmCommon.ErrInvalidMsg(k.Codespace(), "Invalid amount for validator: %v", msg.NewID).Result()
+	}
+
+	// sequence id
+	sequence := getStakingSequence(msg.BlockNumber, msg.LogIndex)
+
+	// old validator leaves validator set as new validator joins
+	oldValidator.EndEpoch = msg.ActivationEpoch - 1
+	oldValidator.LastUpdated = sequence
+
+	newValidator := hmTypes.Validator{
+		ID:          msg.NewID,
+		StartEpoch:  msg.ActivationEpoch,
+		EndEpoch:    0,
+		VotingPower: power,
+		PubKey:      msg.SignerPubKey,
+		Signer:      hmTypes.BytesToHeimdallAddress(msg.SignerPubKey.Address().Bytes()),
+		LastUpdated: sequence,
+		Amount:      msg.Amount,
+	}
+
+	k.Logger(ctx).Debug("Replacing validator with auction winner", "oldValidator", oldValidator.String(), "newValidator", newValidator.String())
+	if err := k.AddValidator(ctx, oldValidator); err != nil {
+		k.Logger(ctx).Error("Unable to update validator", "error", err, "ValidatorID", oldValidator.ID)
+		return hmCommon.ErrValidatorSave(k.Codespace()).Result()
+	}
+
+	if err := k.AddValidator(ctx, newValidator); err != nil {
+		k.Logger(ctx).Error("Unable to add validator to state", "error", err, "validator", newValidator.String())
+		return hmCommon.ErrValidatorSave(k.Codespace()).Result()
+	}
+
+	// auction for slot is closed
+	k.RemoveAuction(ctx, oldValidator.ID)
+
+	// save staking sequence
+	k.SetStakingSequence(ctx, sequence)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeConfirmAuction,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(oldValidator.ID.Uint64(), 10)),
+			sdk.NewAttribute(types.AttributeKeyNewValidatorID, strconv.FormatUint(newValidator.ID.Uint64(), 10)),
+			sdk.NewAttribute(types.AttributeKeySigner, newValidator.Signer.String()),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
@@ -0,0 +1,26 @@
+package types
+
+import (
+	"fmt"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// Auction represents open auction for validator slot on root chain
+type Auction struct {
+	ValidatorID   hmTypes.ValidatorID `json:"validator_id"`
+	Amount        hmTypes.Int         `json:"amount"`         // stake of current validator
+	AuctionAmount hmTypes.Int         `json:"auction_amount"` // highest bid for slot
+	LastUpdated   uint64              `json:"last_updated"`   // staking sequence of last bid
+}
+
+// String returns the string representation of auction
+func (a Auction) String() string {
+	return fmt.Sprintf(
+		"Auction{%v %v %v %v}",
+		a.ValidatorID,
+		a.Amount.String(),
+		a.AuctionAmount.String(),
+		a.LastUpdated,
+	)
+}
@@ -13,6 +13,13 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgSignerUpdate{}, "staking/MsgSignerUpdate", nil)
 	cdc.RegisterConcrete(MsgValidatorExit{}, "staking/MsgValidatorExit", nil)
 	cdc.RegisterConcrete(MsgStakeUpdate{}, "staking/MsgStakeUpdate", nil)
+	cdc.RegisterConcrete(MsgValidatorRestake{}, "staking/MsgValidatorRestake", nil)
+	cdc.RegisterConcrete(MsgValidatorJailed{}, "staking/MsgValidatorJailed", nil)
+	cdc.RegisterConcrete(MsgDelegate{}, "staking/MsgDelegate", nil)
+	cdc.RegisterConcrete(MsgUndelegate{}, "staking/MsgUndelegate", nil)
+	cdc.RegisterConcrete(MsgStartAuction{}, "staking/MsgStartAuction", nil)
+	cdc.RegisterConcrete(MsgConfirmAuction{}, "staking/MsgConfirmAuction", nil)
+	cdc.RegisterConcrete(MsgEditValidatorMetadata{}, "staking/MsgEditValidatorMetadata", nil)
 }
 
 func RegisterPulp(pulp *authTypes.Pulp) {
@@ -20,6 +27,13 @@ func RegisterPulp(pulp *authTypes.Pulp) {
 	pulp.RegisterConcrete(MsgSignerUpdate{})
 	pulp.RegisterConcrete(MsgValidatorExit{})
 	pulp.RegisterConcrete(MsgStakeUpdate{})
+	pulp.RegisterConcrete(MsgValidatorRestake{})
+	pulp.RegisterConcrete(MsgValidatorJailed{})
+	pulp.RegisterConcrete(MsgDelegate{})
+	pulp.RegisterConcrete(MsgUndelegate{})
+	pulp.RegisterConcrete(MsgStartAuction{})
+	pulp.RegisterConcrete(MsgConfirmAuction{})
+	pulp.RegisterConcrete(MsgEditValidatorMetadata{})
 }
 
 // ModuleCdc generic sealed codec to be used throughout module
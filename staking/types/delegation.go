@@ -0,0 +1,38 @@
+package types
+
+import (
+	"fmt"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// Delegation represents stake delegated by delegator to validator on root chain
+type Delegation struct {
+	ValidatorID hmTypes.ValidatorID     `json:"validator_id"`
+	Delegator   hmTypes.HeimdallAddress `json:"delegator"`
+	Shares      hmTypes.Int             `json:"shares"`       // validator share tokens held by delegator
+	Amount      hmTypes.Int             `json:"amount"`       // staked tokens backing shares
+	LastUpdated uint64                  `json:"last_updated"` // staking sequence of last update
+}
+
+// NewDelegation creates new delegation
+func NewDelegation(validatorID hmTypes.ValidatorID, delegator hmTypes.HeimdallAddress, shares hmTypes.Int, amount hmTypes.Int) Delegation {
+	return Delegation{
+		ValidatorID: validatorID,
+		Delegator:   delegator,
+		Shares:      shares,
+		Amount:      amount,
+	}
+}
+
+// String returns the string representation of delegation
+func (d Delegation) String() string {
+	return fmt.Sprintf(
+		"Delegation{%v %v %v %v %v}",
+		d.ValidatorID,
+		d.Delegator.String(),
+		d.Shares.String(),
+		d.Amount.String(),
+		d.LastUpdated,
+	)
+}
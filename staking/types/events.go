@@ -2,17 +2,31 @@ package types
 
 // Checkpoint tags
 var (
-	EventTypeNewProposer   = "new-proposer"
-	EventTypeValidatorJoin = "validator-join"
-	EventTypeSignerUpdate  = "signer-update"
-	EventTypeStakeUpdate   = "stake-update"
-	EventTypeValidatorExit = "validator-exit"
+	EventTypeNewProposer    = "new-proposer"
+	EventTypeValidatorJoin  = "validator-join"
+	EventTypeSignerUpdate   = "signer-update"
+	EventTypeStakeUpdate    = "stake-update"
+	EventTypeValidatorExit  = "validator-exit"
+	EventTypeRestake        = "validator-restake"
+	EventTypeJailed         = "validator-jailed"
+	EventTypeDelegate       = "delegate"
+	EventTypeUndelegate     = "undelegate"
+	EventTypeStartAuction   = "start-auction"
+	EventTypeConfirmAuction = "confirm-auction"
+	EventTypeEditMetadata   = "edit-validator-metadata"
 
 	AttributeKeySigner            = "signer"
 	AttributeKeyDeactivationEpoch = "deactivation-epoch"
 	AttributeKeyActivationEpoch   = "activation-epoch"
 	AttributeKeyValidatorID       = "validator-id"
 	AttributeKeyUpdatedAt         = "updated-at"
+	AttributeKeyExitEpoch         = "exit-epoch"
+	AttributeKeyDelegator         = "delegator"
+	AttributeKeyAmount            = "amount"
+	AttributeKeyPower             = "power"
+	AttributeKeyAuctionAmount     = "auction-amount"
+	AttributeKeyNewValidatorID    = "new-validator-id"
+	AttributeKeyMoniker           = "moniker"
 
 	AttributeValueCategory = ModuleName
 )
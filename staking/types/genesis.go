@@ -31,37 +31,77 @@ func (v *GenesisValidator) HeimdallValidator() hmTypes.Validator {
 
 // GenesisState is the checkpoint state that must be provided at genesis.
 type GenesisState struct {
+	Params           Params                    `json:"params" yaml:"params"`
 	Validators       []*hmTypes.Validator      `json:"validators" yaml:"validators"`
 	CurrentValSet    hmTypes.ValidatorSet      `json:"current_val_set" yaml:"current_val_set"`
 	DividentAccounts []hmTypes.DividendAccount `json:"dividend_accounts" yaml:"dividend_accounts"`
+	Delegations      []Delegation              `json:"delegations" yaml:"delegations"`
+	Auctions         []Auction                 `json:"auctions" yaml:"auctions"`
+
+	HistoricalValidatorSets []HistoricalValidatorSet `json:"historical_validator_sets" yaml:"historical_validator_sets"`
+	ValidatorMetadata       []ValidatorMetadata      `json:"validator_metadata" yaml:"validator_metadata"`
 }
 
 // NewGenesisState creates a new genesis state.
 func NewGenesisState(
+	params Params,
 	validators []*hmTypes.Validator,
 	currentValSet hmTypes.ValidatorSet,
 	dividentAccounts []hmTypes.DividendAccount,
-
+	delegations []Delegation,
+	auctions []Auction,
+	historicalValidatorSets []HistoricalValidatorSet,
+	validatorMetadata []ValidatorMetadata,
 ) GenesisState {
 	return GenesisState{
+		Params:           params,
 		Validators:       validators,
 		CurrentValSet:    currentValSet,
 		DividentAccounts: dividentAccounts,
+		Delegations:      delegations,
+		Auctions:         auctions,
+
+		HistoricalValidatorSets: historicalValidatorSets,
+		ValidatorMetadata:       validatorMetadata,
 	}
 }
 
 // DefaultGenesisState returns a default genesis state
 func DefaultGenesisState() GenesisState {
-	return NewGenesisState(nil, hmTypes.ValidatorSet{}, nil)
+	return NewGenesisState(DefaultParams(), nil, hmTypes.ValidatorSet{}, nil, nil, nil, nil, nil)
 }
 
 // ValidateGenesis performs basic validation of bor genesis data returning an
 // error for any failed validation criteria.
 func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
 	for _, validator := range data.Validators {
 		if !validator.ValidateBasic() {
 			return errors.New("Invalid validator")
 		}
+
+		if validator.Amount.I != nil && validator.Amount.IsNegative() {
+			return errors.New("Invalid validator amount")
+		}
+	}
+
+	for _, delegation := range data.Delegations {
+		if delegation.Delegator.Empty() || delegation.Shares.I == nil || !delegation.Shares.IsPositive() {
+			return errors.New("Invalid delegation")
+		}
+	}
+
+	for _, metadata := range data.ValidatorMetadata {
+		if metadata.ValidatorID == 0 ||
+			len(metadata.Moniker) > MaxMonikerLength ||
+			len(metadata.Website) > MaxWebsiteLength ||
+			len(metadata.Contact) > MaxContactLength ||
+			len(metadata.LogoHash) > MaxLogoHashLength {
+			return errors.New("Invalid validator metadata")
+		}
 	}
 
 	return nil
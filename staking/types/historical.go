@@ -0,0 +1,24 @@
+package types
+
+import (
+	"fmt"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// HistoricalValidatorSet represents validator set snapshot taken when ack count changed
+type HistoricalValidatorSet struct {
+	Epoch        uint64               `json:"epoch"`  // ack count snapshot belongs to
+	Height       int64                `json:"height"` // heimdall block height snapshot was taken at
+	ValidatorSet hmTypes.ValidatorSet `json:"validator_set"`
+}
+
+// String returns the string representation of historical validator set
+func (h HistoricalValidatorSet) String() string {
+	return fmt.Sprintf(
+		"HistoricalValidatorSet{%v %v %v}",
+		h.Epoch,
+		h.Height,
+		h.ValidatorSet.String(),
+	)
+}
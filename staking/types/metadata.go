@@ -0,0 +1,43 @@
+package types
+
+import (
+	"fmt"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// Length limits of validator metadata fields
+const (
+	MaxMonikerLength  = 70
+	MaxWebsiteLength  = 140
+	MaxContactLength  = 140
+	MaxLogoHashLength = 128
+)
+
+// ValidatorMetadata represents descriptive metadata set by validator
+type ValidatorMetadata struct {
+	ValidatorID hmTypes.ValidatorID `json:"validator_id"`
+	Moniker     string              `json:"moniker"`
+	Website     string              `json:"website"`
+	Contact     string              `json:"contact"`
+	LogoHash    string              `json:"logo_hash"`
+}
+
+// String returns the string representation of validator metadata
+func (m ValidatorMetadata) String() string {
+	return fmt.Sprintf(
+		"ValidatorMetadata{%v %v %v %v %v}",
+		m.ValidatorID,
+		m.Moniker,
+		m.Website,
+		m.Contact,
+		m.LogoHash,
+	)
+}
+
+// ValidatorInfo represents validator along with its metadata
+type ValidatorInfo struct {
+	hmTypes.Validator
+
+	Metadata *ValidatorMetadata `json:"metadata,omitempty"`
+}
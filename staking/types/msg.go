@@ -21,28 +21,34 @@ var cdc = codec.New()
 var _ sdk.Msg = &MsgValidatorJoin{}
 
 type MsgValidatorJoin struct {
-	From         hmTypes.HeimdallAddress `json:"from"`
-	ID           hmTypes.ValidatorID     `json:"id"`
-	SignerPubKey hmTypes.PubKey          `json:"pub_key"`
-	TxHash       hmTypes.HeimdallHash    `json:"tx_hash"`
-	LogIndex     uint64                  `json:"log_index"`
+	From            hmTypes.HeimdallAddress `json:"from"`
+	ID              hmTypes.ValidatorID     `json:"id"`
+	ActivationEpoch uint64                  `json:"activationEpoch"`
+	Amount          hmTypes.Int             `json:"amount"`
+	SignerPubKey    hmTypes.PubKey          `json:"pub_key"`
+	TxHash          hmTypes.HeimdallHash    `json:"tx_hash"`
+	LogIndex        uint64                  `json:"log_index"`
 }
 
 // NewMsgValidatorJoin creates new validator-join
 func NewMsgValidatorJoin(
 	from hmTypes.HeimdallAddress,
 	id uint64,
+	activationEpoch uint64,
+	amount hmTypes.Int,
 	pubkey hmTypes.PubKey,
 	txhash hmTypes.HeimdallHash,
 	logIndex uint64,
 ) MsgValidatorJoin {
 
 	return MsgValidatorJoin{
-		From:         from,
-		ID:           hmTypes.NewValidatorID(id),
-		SignerPubKey: pubkey,
-		TxHash:       txhash,
-		LogIndex:     logIndex,
+		From:            from,
+		ID:              hmTypes.NewValidatorID(id),
+		ActivationEpoch: activationEpoch,
+		Amount:          amount,
+		SignerPubKey:    pubkey,
+		TxHash:          txhash,
+		LogIndex:        logIndex,
 	}
 }
 
@@ -79,6 +85,10 @@ func (msg MsgValidatorJoin) ValidateBasic() sdk.Error {
 		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid proposer %v", msg.From.String())
 	}
 
+	if msg.Amount.I == nil || !msg.Amount.IsPositive() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid amount %v", msg.Amount)
+	}
+
 	return nil
 }
 
@@ -92,6 +102,11 @@ func (msg MsgValidatorJoin) GetLogIndex() uint64 {
 	return msg.LogIndex
 }
 
+// IsSideTxMsg marks validator join as side tx
+func (msg MsgValidatorJoin) IsSideTxMsg() bool {
+	return true
+}
+
 //
 // Stake update
 //
@@ -104,19 +119,23 @@ var _ sdk.Msg = &MsgStakeUpdate{}
 
 // MsgStakeUpdate represents stake update
 type MsgStakeUpdate struct {
-	From     hmTypes.HeimdallAddress `json:"from"`
-	ID       hmTypes.ValidatorID     `json:"id"`
-	TxHash   hmTypes.HeimdallHash    `json:"tx_hash"`
-	LogIndex uint64                  `json:"log_index"`
+	From        hmTypes.HeimdallAddress `json:"from"`
+	ID          hmTypes.ValidatorID     `json:"id"`
+	NewAmount   hmTypes.Int             `json:"amount"`
+	TxHash      hmTypes.HeimdallHash    `json:"tx_hash"`
+	LogIndex    uint64                  `json:"log_index"`
+	BlockNumber uint64                  `json:"block_number"`
 }
 
 // NewMsgStakeUpdate represents stake update
-func NewMsgStakeUpdate(from hmTypes.HeimdallAddress, id uint64, txhash hmTypes.HeimdallHash, logIndex uint64) MsgStakeUpdate {
+func NewMsgStakeUpdate(from hmTypes.HeimdallAddress, id uint64, newAmount hmTypes.Int, txhash hmTypes.HeimdallHash, logIndex uint64, blockNumber uint64) MsgStakeUpdate {
 	return MsgStakeUpdate{
-		From:     from,
-		ID:       hmTypes.NewValidatorID(id),
-		TxHash:   txhash,
-		LogIndex: logIndex,
+		From:        from,
+		ID:          hmTypes.NewValidatorID(id),
+		NewAmount:   newAmount,
+		TxHash:      txhash,
+		LogIndex:    logIndex,
+		BlockNumber: blockNumber,
 	}
 }
 
@@ -149,6 +168,10 @@ func (msg MsgStakeUpdate) ValidateBasic() sdk.Error {
 		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid proposer %v", msg.From.String())
 	}
 
+	if msg.NewAmount.I == nil || msg.NewAmount.IsNegative() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid amount %v", msg.NewAmount)
+	}
+
 	return nil
 }
 
@@ -162,6 +185,11 @@ func (msg MsgStakeUpdate) GetLogIndex() uint64 {
 	return msg.LogIndex
 }
 
+// IsSideTxMsg marks stake update as side tx
+func (msg MsgStakeUpdate) IsSideTxMsg() bool {
+	return true
+}
+
 //
 // validator update
 //
@@ -175,6 +203,7 @@ type MsgSignerUpdate struct {
 	NewSignerPubKey hmTypes.PubKey          `json:"pubKey"`
 	TxHash          hmTypes.HeimdallHash    `json:"tx_hash"`
 	LogIndex        uint64                  `json:"log_index"`
+	BlockNumber     uint64                  `json:"block_number"`
 }
 
 func NewMsgSignerUpdate(
@@ -183,6 +212,7 @@ func NewMsgSignerUpdate(
 	pubKey hmTypes.PubKey,
 	txhash hmTypes.HeimdallHash,
 	logIndex uint64,
+	blockNumber uint64,
 ) MsgSignerUpdate {
 	return MsgSignerUpdate{
 		From:            from,
@@ -190,6 +220,7 @@ func NewMsgSignerUpdate(
 		NewSignerPubKey: pubKey,
 		TxHash:          txhash,
 		LogIndex:        logIndex,
+		BlockNumber:     blockNumber,
 	}
 }
 
@@ -239,6 +270,11 @@ func (msg MsgSignerUpdate) GetLogIndex() uint64 {
 	return msg.LogIndex
 }
 
+// IsSideTxMsg marks signer update as side tx
+func (msg MsgSignerUpdate) IsSideTxMsg() bool {
+	return true
+}
+
 //
 // validator exit
 //
@@ -246,18 +282,20 @@ func (msg MsgSignerUpdate) GetLogIndex() uint64 {
 var _ sdk.Msg = &MsgValidatorExit{}
 
 type MsgValidatorExit struct {
-	From     hmTypes.HeimdallAddress `json:"from"`
-	ID       hmTypes.ValidatorID     `json:"id"`
-	TxHash   hmTypes.HeimdallHash    `json:"tx_hash"`
-	LogIndex uint64                  `json:"log_index"`
+	From              hmTypes.HeimdallAddress `json:"from"`
+	ID                hmTypes.ValidatorID     `json:"id"`
+	DeactivationEpoch uint64                  `json:"deactivationEpoch"`
+	TxHash            hmTypes.HeimdallHash    `json:"tx_hash"`
+	LogIndex          uint64                  `json:"log_index"`
 }
 
-func NewMsgValidatorExit(from hmTypes.HeimdallAddress, id uint64, txhash hmTypes.HeimdallHash, logIndex uint64) MsgValidatorExit {
+func NewMsgValidatorExit(from hmTypes.HeimdallAddress, id uint64, deactivationEpoch uint64, txhash hmTypes.HeimdallHash, logIndex uint64) MsgValidatorExit {
 	return MsgValidatorExit{
-		From:     from,
-		ID:       hmTypes.NewValidatorID(id),
-		TxHash:   txhash,
-		LogIndex: logIndex,
+		From:              from,
+		ID:                hmTypes.NewValidatorID(id),
+		DeactivationEpoch: deactivationEpoch,
+		TxHash:            txhash,
+		LogIndex:          logIndex,
 	}
 }
 
@@ -290,6 +328,10 @@ func (msg MsgValidatorExit) ValidateBasic() sdk.Error {
 		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid proposer %v", msg.From.String())
 	}
 
+	if msg.DeactivationEpoch == 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid deactivation epoch %v", msg.DeactivationEpoch)
+	}
+
 	return nil
 }
 
@@ -302,3 +344,657 @@ func (msg MsgValidatorExit) GetTxHash() types.HeimdallHash {
 func (msg MsgValidatorExit) GetLogIndex() uint64 {
 	return msg.LogIndex
 }
+
+// IsSideTxMsg marks validator exit as side tx
+func (msg MsgValidatorExit) IsSideTxMsg() bool {
+	return true
+}
+
+//
+// validator restake
+//
+
+var _ sdk.Msg = &MsgValidatorRestake{}
+
+// MsgValidatorRestake represents restake of validator on root chain
+type MsgValidatorRestake struct {
+	From        hmTypes.HeimdallAddress `json:"from"`
+	ID          hmTypes.ValidatorID     `json:"id"`
+	NewAmount   hmTypes.Int             `json:"amount"`
+	TxHash      hmTypes.HeimdallHash    `json:"tx_hash"`
+	LogIndex    uint64                  `json:"log_index"`
+	BlockNumber uint64                  `json:"block_number"`
+}
+
+// NewMsgValidatorRestake creates new validator restake msg, new amount is stake of validator after restake
+func NewMsgValidatorRestake(from hmTypes.HeimdallAddress, id uint64, newAmount hmTypes.Int, txhash hmTypes.HeimdallHash, logIndex uint64, blockNumber uint64) MsgValidatorRestake {
+	return MsgValidatorRestake{
+		From:        from,
+		ID:          hmTypes.NewValidatorID(id),
+		NewAmount:   newAmount,
+		TxHash:      txhash,
+		LogIndex:    logIndex,
+		BlockNumber: blockNumber,
+	}
+}
+
+func (msg MsgValidatorRestake) Type() string {
+	return "validator-restake"
+}
+
+func (msg MsgValidatorRestake) Route() string {
+	return RouterKey
+}
+
+func (msg MsgValidatorRestake) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{hmTypes.HeimdallAddressToAccAddress(msg.From)}
+}
+
+func (msg MsgValidatorRestake) GetSignBytes() []byte {
+	b, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgValidatorRestake) ValidateBasic() sdk.Error {
+	if msg.ID <= 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid validator ID %v", msg.ID)
+	}
+
+	if msg.From.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid proposer %v", msg.From.String())
+	}
+
+	if msg.NewAmount.I == nil || !msg.NewAmount.IsPositive() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid amount %v", msg.NewAmount)
+	}
+
+	return nil
+}
+
+// GetTxHash Returns tx hash
+func (msg MsgValidatorRestake) GetTxHash() types.HeimdallHash {
+	return msg.TxHash
+}
+
+// GetLogIndex Returns log index
+func (msg MsgValidatorRestake) GetLogIndex() uint64 {
+	return msg.LogIndex
+}
+
+// IsSideTxMsg marks validator restake as side tx
+func (msg MsgValidatorRestake) IsSideTxMsg() bool {
+	return true
+}
+
+//
+// validator jailed
+//
+
+var _ sdk.Msg = &MsgValidatorJailed{}
+
+// MsgValidatorJailed represents jail of validator on root chain
+type MsgValidatorJailed struct {
+	From        hmTypes.HeimdallAddress `json:"from"`
+	ID          hmTypes.ValidatorID     `json:"id"`
+	ExitEpoch   uint64                  `json:"exitEpoch"`
+	TxHash      hmTypes.HeimdallHash    `json:"tx_hash"`
+	LogIndex    uint64                  `json:"log_index"`
+	BlockNumber uint64                  `json:"block_number"`
+}
+
+// NewMsgValidatorJailed creates new validator jailed msg
+func NewMsgValidatorJailed(from hmTypes.HeimdallAddress, id uint64, exitEpoch uint64, txhash hmTypes.HeimdallHash, logIndex uint64, blockNumber uint64) MsgValidatorJailed {
+	return MsgValidatorJailed{
+		From:        from,
+		ID:          hmTypes.NewValidatorID(id),
+		ExitEpoch:   exitEpoch,
+		TxHash:      txhash,
+		LogIndex:    logIndex,
+		BlockNumber: blockNumber,
+	}
+}
+
+func (msg MsgValidatorJailed) Type() string {
+	return "validator-jailed"
+}
+
+func (msg MsgValidatorJailed) Route() string {
+	return RouterKey
+}
+
+func (msg MsgValidatorJailed) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{hmTypes.HeimdallAddressToAccAddress(msg.From)}
+}
+
+func (msg MsgValidatorJailed) GetSignBytes() []byte {
+	b, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgValidatorJailed) ValidateBasic() sdk.Error {
+	if msg.ID <= 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid validator ID %v", msg.ID)
+	}
+
+	if msg.From.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid proposer %v", msg.From.String())
+	}
+
+	if msg.ExitEpoch == 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid exit epoch %v", msg.ExitEpoch)
+	}
+
+	return nil
+}
+
+// GetTxHash Returns tx hash
+func (msg MsgValidatorJailed) GetTxHash() types.HeimdallHash {
+	return msg.TxHash
+}
+
+// GetLogIndex Returns log index
+func (msg MsgValidatorJailed) GetLogIndex() uint64 {
+	return msg.LogIndex
+}
+
+// IsSideTxMsg marks validator jailed as side tx
+func (msg MsgValidatorJailed) IsSideTxMsg() bool {
+	return true
+}
+
+//
+// delegate
+//
+
+var _ sdk.Msg = &MsgDelegate{}
+
+// MsgDelegate represents shares minted to delegator on root chain
+type MsgDelegate struct {
+	From        hmTypes.HeimdallAddress `json:"from"`
+	ID          hmTypes.ValidatorID     `json:"id"`
+	Delegator   hmTypes.HeimdallAddress `json:"delegator"`
+	Shares      hmTypes.Int             `json:"shares"`
+	Amount      hmTypes.Int             `json:"amount"`
+	TxHash      hmTypes.HeimdallHash    `json:"tx_hash"`
+	LogIndex    uint64                  `json:"log_index"`
+	BlockNumber uint64                  `json:"block_number"`
+}
+
+// NewMsgDelegate creates new delegate msg
+func NewMsgDelegate(
+	from hmTypes.HeimdallAddress,
+	id uint64,
+	delegator hmTypes.HeimdallAddress,
+	shares hmTypes.Int,
+	amount hmTypes.Int,
+	txhash hmTypes.HeimdallHash,
+	logIndex uint64,
+	blockNumber uint64,
+) MsgDelegate {
+	return MsgDelegate{
+		From:        from,
+		ID:          hmTypes.NewValidatorID(id),
+		Delegator:   delegator,
+		Shares:      shares,
+		Amount:      amount,
+		TxHash:      txhash,
+		LogIndex:    logIndex,
+		BlockNumber: blockNumber,
+	}
+}
+
+func (msg MsgDelegate) Type() string {
+	return "delegate"
+}
+
+func (msg MsgDelegate) Route() string {
+	return RouterKey
+}
+
+func (msg MsgDelegate) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{hmTypes.HeimdallAddressToAccAddress(msg.From)}
+}
+
+func (msg MsgDelegate) GetSignBytes() []byte {
+	b, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgDelegate) ValidateBasic() sdk.Error {
+	if msg.ID <= 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid validator ID %v", msg.ID)
+	}
+
+	if msg.From.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid proposer %v", msg.From.String())
+	}
+
+	if msg.Delegator.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid delegator %v", msg.Delegator.String())
+	}
+
+	if msg.Shares.I == nil || !msg.Shares.IsPositive() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid shares %v", msg.Shares)
+	}
+
+	if msg.Amount.I == nil || msg.Amount.IsNegative() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid amount %v", msg.Amount)
+	}
+
+	return nil
+}
+
+// GetTxHash Returns tx hash
+func (msg MsgDelegate) GetTxHash() types.HeimdallHash {
+	return msg.TxHash
+}
+
+// GetLogIndex Returns log index
+func (msg MsgDelegate) GetLogIndex() uint64 {
+	return msg.LogIndex
+}
+
+// IsSideTxMsg marks delegate as side tx
+func (msg MsgDelegate) IsSideTxMsg() bool {
+	return true
+}
+
+//
+// undelegate
+//
+
+var _ sdk.Msg = &MsgUndelegate{}
+
+// MsgUndelegate represents shares burned by delegator on root chain
+type MsgUndelegate struct {
+	From        hmTypes.HeimdallAddress `json:"from"`
+	ID          hmTypes.ValidatorID     `json:"id"`
+	Delegator   hmTypes.HeimdallAddress `json:"delegator"`
+	Shares      hmTypes.Int             `json:"shares"`
+	Amount      hmTypes.Int             `json:"amount"`
+	TxHash      hmTypes.HeimdallHash    `json:"tx_hash"`
+	LogIndex    uint64                  `json:"log_index"`
+	BlockNumber uint64                  `json:"block_number"`
+}
+
+// NewMsgUndelegate creates new undelegate msg
+func NewMsgUndelegate(
+	from hmTypes.HeimdallAddress,
+	id uint64,
+	delegator hmTypes.HeimdallAddress,
+	shares hmTypes.Int,
+	amount hmTypes.Int,
+	txhash hmTypes.HeimdallHash,
+	logIndex uint64,
+	blockNumber uint64,
+) MsgUndelegate {
+	return MsgUndelegate{
+		From:        from,
+		ID:          hmTypes.NewValidatorID(id),
+		Delegator:   delegator,
+		Shares:      shares,
+		Amount:      amount,
+		TxHash:      txhash,
+		LogIndex:    logIndex,
+		BlockNumber: blockNumber,
+	}
+}
+
+func (msg MsgUndelegate) Type() string {
+	return "undelegate"
+}
+
+func (msg MsgUndelegate) Route() string {
+	return RouterKey
+}
+
+func (msg MsgUndelegate) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{hmTypes.HeimdallAddressToAccAddress(msg.From)}
+}
+
+func (msg MsgUndelegate) GetSignBytes() []byte {
+	b, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgUndelegate) ValidateBasic() sdk.Error {
+	if msg.ID <= 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid validator ID %v", msg.ID)
+	}
+
+	if msg.From.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid proposer %v", msg.From.String())
+	}
+
+	if msg.Delegator.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid delegator %v", msg.Delegator.String())
+	}
+
+	if msg.Shares.I == nil || !msg.Shares.IsPositive() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid shares %v", msg.Shares)
+	}
+
+	if msg.Amount.I == nil || msg.Amount.IsNegative() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid amount %v", msg.Amount)
+	}
+
+	return nil
+}
+
+// GetTxHash Returns tx hash
+func (msg MsgUndelegate) GetTxHash() types.HeimdallHash {
+	return msg.TxHash
+}
+
+// GetLogIndex Returns log index
+func (msg MsgUndelegate) GetLogIndex() uint64 {
+	return msg.LogIndex
+}
+
+// IsSideTxMsg marks undelegate as side tx
+func (msg MsgUndelegate) IsSideTxMsg() bool {
+	return true
+}
+
+//
+// start auction
+//
+
+var _ sdk.Msg = &MsgStartAuction{}
+
+// MsgStartAuction represents bid on validator slot on root chain
+type MsgStartAuction struct {
+	From          hmTypes.HeimdallAddress `json:"from"`
+	ID            hmTypes.ValidatorID     `json:"id"`
+	Amount        hmTypes.Int             `json:"amount"`
+	AuctionAmount hmTypes.Int             `json:"auction_amount"`
+	TxHash        hmTypes.HeimdallHash    `json:"tx_hash"`
+	LogIndex      uint64                  `json:"log_index"`
+	BlockNumber   uint64                  `json:"block_number"`
+}
+
+// NewMsgStartAuction creates new start auction msg
+func NewMsgStartAuction(
+	from hmTypes.HeimdallAddress,
+	id uint64,
+	amount hmTypes.Int,
+	auctionAmount hmTypes.Int,
+	txhash hmTypes.HeimdallHash,
+	logIndex uint64,
+	blockNumber uint64,
+) MsgStartAuction {
+	return MsgStartAuction{
+		From:          from,
+		ID:            hmTypes.NewValidatorID(id),
+		Amount:        amount,
+		AuctionAmount: auctionAmount,
+		TxHash:        txhash,
+		LogIndex:      logIndex,
+		BlockNumber:   blockNumber,
+	}
+}
+
+func (msg MsgStartAuction) Type() string {
+	return "start-auction"
+}
+
+func (msg MsgStartAuction) Route() string {
+	return RouterKey
+}
+
+func (msg MsgStartAuction) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{hmTypes.HeimdallAddressToAccAddress(msg.From)}
+}
+
+func (msg MsgStartAuction) GetSignBytes() []byte {
+	b, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgStartAuction) ValidateBasic() sdk.Error {
+	if msg.ID <= 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid validator ID %v", msg.ID)
+	}
+
+	if msg.From.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid proposer %v", msg.From.String())
+	}
+
+	if msg.Amount.I == nil || msg.Amount.IsNegative() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid amount %v", msg.Amount)
+	}
+
+	if msg.AuctionAmount.I == nil || !msg.AuctionAmount.IsPositive() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid auction amount %v", msg.AuctionAmount)
+	}
+
+	return nil
+}
+
+// GetTxHash Returns tx hash
+func (msg MsgStartAuction) GetTxHash() types.HeimdallHash {
+	return msg.TxHash
+}
+
+// GetLogIndex Returns log index
+func (msg MsgStartAuction) GetLogIndex() uint64 {
+	return msg.LogIndex
+}
+
+// IsSideTxMsg marks start auction as side tx
+func (msg MsgStartAuction) IsSideTxMsg() bool {
+	return true
+}
+
+//
+// confirm auction
+//
+
+var _ sdk.Msg = &MsgConfirmAuction{}
+
+// MsgConfirmAuction represents confirmed auction bid, auction winner replaces old validator
+type MsgConfirmAuction struct {
+	From            hmTypes.HeimdallAddress `json:"from"`
+	OldID           hmTypes.ValidatorID     `json:"old_id"`
+	NewID           hmTypes.ValidatorID     `json:"new_id"`
+	ActivationEpoch uint64                  `json:"activationEpoch"`
+	Amount          hmTypes.Int             `json:"amount"`
+	SignerPubKey    hmTypes.PubKey          `json:"pub_key"`
+	TxHash          hmTypes.HeimdallHash    `json:"tx_hash"`
+	LogIndex        uint64                  `json:"log_index"`        // index of ConfirmAuction log
+	StakedLogIndex  uint64                  `json:"staked_log_index"` // index of Staked log of new validator
+	BlockNumber     uint64                  `json:"block_number"`
+}
+
+// NewMsgConfirmAuction creates new confirm auction msg
+func NewMsgConfirmAuction(
+	from hmTypes.HeimdallAddress,
+	oldID uint64,
+	newID uint64,
+	activationEpoch uint64,
+	amount hmTypes.Int,
+	pubkey hmTypes.PubKey,
+	txhash hmTypes.HeimdallHash,
+	logIndex uint64,
+	stakedLogIndex uint64,
+	blockNumber uint64,
+) MsgConfirmAuction {
+	return MsgConfirmAuction{
+		From:            from,
+		OldID:           hmTypes.NewValidatorID(oldID),
+		NewID:           hmTypes.NewValidatorID(newID),
+		ActivationEpoch: activationEpoch,
+		Amount:          amount,
+		SignerPubKey:    pubkey,
+		TxHash:          txhash,
+		LogIndex:        logIndex,
+		StakedLogIndex:  stakedLogIndex,
+		BlockNumber:     blockNumber,
+	}
+}
+
+func (msg MsgConfirmAuction) Type() string {
+	return "confirm-auction"
+}
+
+func (msg MsgConfirmAuction) Route() string {
+	return RouterKey
+}
+
+func (msg MsgConfirmAuction) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{hmTypes.HeimdallAddressToAccAddress(msg.From)}
+}
+
+func (msg MsgConfirmAuction) GetSignBytes() []byte {
+	b, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgConfirmAuction) ValidateBasic() sdk.Error {
+	if msg.OldID <= 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid old validator ID %v", msg.OldID)
+	}
+
+	if msg.NewID <= 0 || msg.NewID == msg.OldID {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid new validator ID %v", msg.NewID)
+	}
+
+	if msg.ActivationEpoch == 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid activation epoch %v", msg.ActivationEpoch)
+	}
+
+	if bytes.Equal(msg.SignerPubKey.Bytes(), helper.ZeroPubKey.Bytes()) {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid pub key %v", msg.SignerPubKey.String())
+	}
+
+	if msg.From.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid proposer %v", msg.From.String())
+	}
+
+	if msg.Amount.I == nil || !msg.Amount.IsPositive() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid amount %v", msg.Amount)
+	}
+
+	return nil
+}
+
+// GetTxHash Returns tx hash
+func (msg MsgConfirmAuction) GetTxHash() types.HeimdallHash {
+	return msg.TxHash
+}
+
+// GetLogIndex Returns log index
+func (msg MsgConfirmAuction) GetLogIndex() uint64 {
+	return msg.LogIndex
+}
+
+// IsSideTxMsg marks confirm auction as side tx
+func (msg MsgConfirmAuction) IsSideTxMsg() bool {
+	return true
+}
+
+//
+// Edit validator metadata
+//
+
+var _ sdk.Msg = &MsgEditValidatorMetadata{}
+
+// MsgEditValidatorMetadata sets descriptive metadata of validator, signed by current signer
+type MsgEditValidatorMetadata struct {
+	From     hmTypes.HeimdallAddress `json:"from"`
+	ID       hmTypes.ValidatorID     `json:"id"`
+	Moniker  string                  `json:"moniker"`
+	Website  string                  `json:"website"`
+	Contact  string                  `json:"contact"`
+	LogoHash string                  `json:"logo_hash"`
+}
+
+// NewMsgEditValidatorMetadata creates new edit validator metadata message
+func NewMsgEditValidatorMetadata(
+	from hmTypes.HeimdallAddress,
+	id uint64,
+	moniker string,
+	website string,
+	contact string,
+	logoHash string,
+) MsgEditValidatorMetadata {
+	return MsgEditValidatorMetadata{
+		From:     from,
+		ID:       hmTypes.NewValidatorID(id),
+		Moniker:  moniker,
+		Website:  website,
+		Contact:  contact,
+		LogoHash: logoHash,
+	}
+}
+
+// Type returns message type
+func (msg MsgEditValidatorMetadata) Type() string {
+	return "edit-validator-metadata"
+}
+
+// Route returns message route
+func (msg MsgEditValidatorMetadata) Route() string {
+	return RouterKey
+}
+
+// GetSigners returns address of the signer
+func (msg MsgEditValidatorMetadata) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{hmTypes.HeimdallAddressToAccAddress(msg.From)}
+}
+
+// GetSignBytes returns sign bytes
+func (msg MsgEditValidatorMetadata) GetSignBytes() []byte {
+	b, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// ValidateBasic validates the message
+func (msg MsgEditValidatorMetadata) ValidateBasic() sdk.Error {
+	if msg.From.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid proposer %v", msg.From.String())
+	}
+
+	if msg.ID == 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid validator ID %v", msg.ID)
+	}
+
+	if len(msg.Moniker) > MaxMonikerLength {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Moniker longer than %v characters", MaxMonikerLength)
+	}
+
+	if len(msg.Website) > MaxWebsiteLength {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Website longer than %v characters", MaxWebsiteLength)
+	}
+
+	if len(msg.Contact) > MaxContactLength {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Contact longer than %v characters", MaxContactLength)
+	}
+
+	if len(msg.LogoHash) > MaxLogoHashLength {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Logo hash longer than %v characters", MaxLogoHashLength)
+	}
+
+	return nil
+}
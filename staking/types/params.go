@@ -1,21 +1,101 @@
 package types
 
 import (
-	"github.com/cosmos/cosmos-sdk/x/params"
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/x/params/subspace"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
+// Default parameter values
 const (
+	DefaultProposerBonusPercent int64  = 10   // proposer signer reward ratio
+	DefaultHistoricalEpochs     uint64 = 1000 // number of epochs validator set snapshots are kept for
+)
 
-	// DefaultProposerBonusPercent - Proposer Signer Reward Ratio
-	DefaultProposerBonusPercent = int64(10)
+// DefaultPowerDivisor is amount of one voting power (1 token with 18 decimals)
+var DefaultPowerDivisor = hmTypes.NewIntWithDecimal(1, 18)
+
+// Parameter keys
+var (
+	KeyProposerBonusPercent = []byte("ProposerBonusPercent")
+	KeyHistoricalEpochs     = []byte("HistoricalEpochs")
+	KeyPowerDivisor         = []byte("PowerDivisor")
 )
 
-// ParamStoreKeyProposerBonusPercent - Store's Key for Reward amount
-var ParamStoreKeyProposerBonusPercent = []byte("proposerbonuspercent")
+var _ subspace.ParamSet = &Params{}
+
+// Params defines the parameters for the staking module.
+type Params struct {
+	ProposerBonusPercent int64       `json:"proposer_bonus_percent" yaml:"proposer_bonus_percent"`
+	HistoricalEpochs     uint64      `json:"historical_epochs" yaml:"historical_epochs"`
+	PowerDivisor         hmTypes.Int `json:"power_divisor" yaml:"power_divisor"`
+}
+
+// NewParams creates a new Params object
+func NewParams(proposerBonusPercent int64, historicalEpochs uint64, powerDivisor hmTypes.Int) Params {
+	return Params{
+		ProposerBonusPercent: proposerBonusPercent,
+		HistoricalEpochs:     historicalEpochs,
+		PowerDivisor:         powerDivisor,
+	}
+}
+
+// ParamKeyTable for staking module
+func ParamKeyTable() subspace.KeyTable {
+	return subspace.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+// pairs of staking module's parameters.
+// nolint
+func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
+	return subspace.ParamSetPairs{
+		{Key: KeyProposerBonusPercent, Value: &p.ProposerBonusPercent},
+		{Key: KeyHistoricalEpochs, Value: &p.HistoricalEpochs},
+		{Key: KeyPowerDivisor, Value: &p.PowerDivisor},
+	}
+}
+
+// Equal returns a boolean determining if two Params types are identical.
+func (p Params) Equal(p2 Params) bool {
+	bz1 := ModuleCdc.MustMarshalBinaryLengthPrefixed(&p)
+	bz2 := ModuleCdc.MustMarshalBinaryLengthPrefixed(&p2)
+	return bytes.Equal(bz1, bz2)
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return NewParams(DefaultProposerBonusPercent, DefaultHistoricalEpochs, DefaultPowerDivisor)
+}
+
+// String implements the stringer interface.
+func (p Params) String() string {
+	var sb strings.Builder
+	sb.WriteString("Params: \n")
+	sb.WriteString(fmt.Sprintf("ProposerBonusPercent: %d\n", p.ProposerBonusPercent))
+	sb.WriteString(fmt.Sprintf("HistoricalEpochs: %d\n", p.HistoricalEpochs))
+	sb.WriteString(fmt.Sprintf("PowerDivisor: %s\n", p.PowerDivisor))
+	return sb.String()
+}
+
+// Validate checks that the parameters have valid values.
+func (p Params) Validate() error {
+	if p.ProposerBonusPercent < 0 || p.ProposerBonusPercent > 100 {
+		return fmt.Errorf("proposer bonus percent should be between 0 and 100: %d", p.ProposerBonusPercent)
+	}
+
+	if p.HistoricalEpochs == 0 {
+		return errors.New("historical epochs should be non-zero")
+	}
+
+	if p.PowerDivisor.I == nil || !p.PowerDivisor.IsPositive() {
+		return errors.New("power divisor should be positive")
+	}
 
-// ParamKeyTable type declaration for parameters
-func ParamKeyTable() params.KeyTable {
-	return params.NewKeyTable(
-		ParamStoreKeyProposerBonusPercent, DefaultProposerBonusPercent,
-	)
+	return nil
 }
@@ -1,10 +1,7 @@
 package types
 
 import (
-	"math/big"
-
 	"github.com/maticnetwork/heimdall/types"
-	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
 // query endpoints supported by the staking Querier
@@ -20,7 +17,17 @@ const (
 	QueryDividendAccountRoot  = "dividend-account-root"
 	QueryAccountProof         = "dividend-account-proof"
 	QueryVerifyAccountProof   = "verify-account-proof"
-	QuerySlashValidator       = "slash-validator"
+	QueryValidatorDelegations = "validator-delegations"
+	QueryDelegatorDelegations = "delegator-delegations"
+	QueryAuctions             = "auctions"
+	QueryAuction              = "auction"
+	QueryParams               = "params"
+
+	QueryHistoricalValidatorSet         = "historical-validator-set"
+	QueryHistoricalValidatorSetByHeight = "historical-validator-set-by-height"
+
+	QueryProposerSchedule = "proposer-schedule"
+	QueryProposerTurn     = "proposer-turn"
 )
 
 // QuerySignerParams defines the params for querying by address
@@ -74,6 +81,16 @@ func NewQueryVerifyAccountProofParams(dividendAccountID types.DividendAccountID,
 	return QueryVerifyAccountProofParams{DividendAccountID: dividendAccountID, AccountProof: accountProof}
 }
 
+// QueryProposerScheduleParams defines the params for querying proposer schedule.
+type QueryProposerScheduleParams struct {
+	Steps uint64 `json:"steps"`
+}
+
+// NewQueryProposerScheduleParams creates a new instance of QueryProposerScheduleParams.
+func NewQueryProposerScheduleParams(steps uint64) QueryProposerScheduleParams {
+	return QueryProposerScheduleParams{Steps: steps}
+}
+
 // QueryProposerParams defines the params for querying val status.
 type QueryProposerParams struct {
 	Times uint64 `json:"times"`
@@ -89,18 +106,37 @@ type QueryValidatorStatusParams struct {
 	SignerAddress []byte
 }
 
-// ValidatorSlashParams defines the params for slashing a validator
-type ValidatorSlashParams struct {
-	ValID       hmTypes.ValidatorID
-	SlashAmount *big.Int
-}
-
 // NewQueryValidatorStatusParams creates a new instance of QueryValidatorStatusParams.
 func NewQueryValidatorStatusParams(signerAddress []byte) QueryValidatorStatusParams {
 	return QueryValidatorStatusParams{SignerAddress: signerAddress}
 }
 
-// NewValidatorSlashParams creates a new instance of ValidatorSlashParams.
-func NewValidatorSlashParams(validatorID hmTypes.ValidatorID, amountToSlash *big.Int) ValidatorSlashParams {
-	return ValidatorSlashParams{ValID: validatorID, SlashAmount: amountToSlash}
+// QueryDelegatorParams defines the params for querying delegations of delegator.
+type QueryDelegatorParams struct {
+	Delegator types.HeimdallAddress `json:"delegator"`
+}
+
+// NewQueryDelegatorParams creates a new instance of QueryDelegatorParams.
+func NewQueryDelegatorParams(delegator types.HeimdallAddress) QueryDelegatorParams {
+	return QueryDelegatorParams{Delegator: delegator}
+}
+
+// QueryHistoricalValidatorSetParams defines the params for querying validator set of epoch.
+type QueryHistoricalValidatorSetParams struct {
+	Epoch uint64 `json:"epoch"`
+}
+
+// NewQueryHistoricalValidatorSetParams creates a new instance of QueryHistoricalValidatorSetParams.
+func NewQueryHistoricalValidatorSetParams(epoch uint64) QueryHistoricalValidatorSetParams {
+	return QueryHistoricalValidatorSetParams{Epoch: epoch}
+}
+
+// QueryHeightParams defines the params for querying by heimdall block height.
+type QueryHeightParams struct {
+	Height int64 `json:"height"`
+}
+
+// NewQueryHeightParams creates a new instance of QueryHeightParams.
+func NewQueryHeightParams(height int64) QueryHeightParams {
+	return QueryHeightParams{Height: height}
 }
@@ -0,0 +1,20 @@
+package types
+
+import (
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// MaxProposerScheduleSteps is max number of ack/no-ack steps proposer schedule is projected for
+const MaxProposerScheduleSteps uint64 = 10000
+
+// ScheduledProposer is checkpoint proposer after given number of ack/no-ack steps
+type ScheduledProposer struct {
+	Step     uint64            `json:"step"`
+	Proposer hmTypes.Validator `json:"proposer"`
+}
+
+// ProposerTurn is number of ack/no-ack steps after which validator becomes checkpoint proposer
+type ProposerTurn struct {
+	ValidatorID hmTypes.ValidatorID `json:"validator_id"`
+	Step        uint64              `json:"step"`
+}
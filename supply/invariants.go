@@ -0,0 +1,45 @@
+package supply
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	"github.com/maticnetwork/heimdall/supply/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// RegisterInvariants registers all supply invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "total-supply", TotalSupply(k))
+}
+
+// AllInvariants runs all invariants of the supply module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return TotalSupply(k)(ctx)
+	}
+}
+
+// TotalSupply checks that the total supply reflects all the coins held in accounts
+func TotalSupply(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var expectedTotal hmTypes.Coins
+		supply := k.GetSupply(ctx)
+
+		k.ak.IterateAccounts(ctx, func(acc authTypes.Account) bool {
+			expectedTotal = expectedTotal.Add(acc.GetCoins())
+			return false
+		})
+
+		diff, _ := expectedTotal.SafeSub(supply.Total)
+		broken := !diff.IsZero()
+
+		return sdk.FormatInvariant(types.ModuleName, "total supply",
+			fmt.Sprintf(
+				"\tsum of accounts coins: %v\n"+
+					"\tsupply.Total:          %v\n",
+				expectedTotal, supply.Total)), broken
+	}
+}
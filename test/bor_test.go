@@ -0,0 +1,530 @@
+package test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/params/subspace"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	ethTypes "github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/bor/crypto"
+
+	"github.com/maticnetwork/heimdall/bor"
+	borTypes "github.com/maticnetwork/heimdall/bor/types"
+	"github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/helper"
+	"github.com/maticnetwork/heimdall/staking"
+	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
+	"github.com/maticnetwork/heimdall/types"
+)
+
+// staticCheckpointRetriever returns last checkpoint set by test
+type staticCheckpointRetriever struct {
+	checkpoint *types.CheckpointBlockHeader
+}
+
+// GetLastCheckpoint returns last checkpoint
+func (r *staticCheckpointRetriever) GetLastCheckpoint(ctx sdk.Context, borChainID string) (types.CheckpointBlockHeader, error) {
+	if r.checkpoint == nil {
+		return types.CheckpointBlockHeader{}, common.ErrNoCheckpointFound(common.DefaultCodespace)
+	}
+	return *r.checkpoint, nil
+}
+
+// create bor and staking keeper without heimdall config
+func createBorTestInput(t *testing.T) (sdk.Context, bor.Keeper, staking.Keeper) {
+	return createBorTestInputWithCheckpoint(t, &staticCheckpointRetriever{})
+}
+
+// create bor and staking keeper without heimdall config using given checkpoint retriever
+func createBorTestInputWithCheckpoint(t *testing.T, checkpointRetriever bor.CheckpointRetriever) (sdk.Context, bor.Keeper, staking.Keeper) {
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+
+	keyBor := sdk.NewKVStoreKey(borTypes.StoreKey)
+	keyStaking := sdk.NewKVStoreKey(stakingTypes.StoreKey)
+	keyParams := sdk.NewKVStoreKey(subspace.StoreKey)
+	tKeyParams := sdk.NewTransientStoreKey(subspace.TStoreKey)
+
+	ms.MountStoreWithDB(keyBor, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyStaking, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tKeyParams, sdk.StoreTypeTransient, db)
+
+	err := ms.LoadLatestVersion()
+	require.Nil(t, err)
+
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "foochainid"}, false, log.NewNopLogger())
+
+	cdc := codec.New()
+	codec.RegisterCrypto(cdc)
+	sdk.RegisterCodec(cdc)
+	stakingTypes.RegisterCodec(cdc)
+	borTypes.RegisterCodec(cdc)
+	cdc.Seal()
+
+	paramsKeeper := params.NewKeeper(cdc, keyParams, tKeyParams, common.DefaultCodespace)
+
+	stakingKeeper := staking.NewKeeper(
+		cdc,
+		keyStaking,
+		paramsKeeper.Subspace(stakingTypes.DefaultParamspace),
+		common.DefaultCodespace,
+		&staticAckRetriever{},
+	)
+	stakingKeeper.SetParams(ctx, stakingTypes.DefaultParams())
+
+	borKeeper := bor.NewKeeper(
+		cdc,
+		keyBor,
+		paramsKeeper.Subspace(borTypes.DefaultParamspace),
+		common.DefaultCodespace,
+		stakingKeeper,
+		helper.ContractCaller{},
+		checkpointRetriever,
+	)
+
+	return ctx, borKeeper, stakingKeeper
+}
+
+func TestProposeSpanValidation(t *testing.T) {
+	ctx, borKeeper, sk := createBorTestInput(t)
+
+	// producer count covers all validators so no mainchain seed is needed
+	validators := GenRandomVal(4, 0, 10, 0, false, 1)
+	loadValidators(t, sk, ctx, validators)
+
+	// genesis without chain id follows chain id of its spans
+	firstSpan := types.NewSpan(0, 0, 255, sk.GetValidatorSet(ctx), validators, borTypes.DefaultChainID)
+	genesisState := borTypes.NewGenesisState(
+		borTypes.DefaultSprintDuration,
+		256,
+		4,
+		[]*types.Span{&firstSpan},
+		"",
+		"",
+		"",
+		nil,
+		nil,
+		nil,
+	)
+	bor.InitGenesis(ctx, borKeeper, genesisState)
+	require.Equal(t, borTypes.DefaultChainID, borKeeper.GetChainID(ctx))
+	require.Equal(t, borTypes.DefaultChainID, bor.ExportGenesis(ctx, borKeeper).ChainID)
+
+	proposer := validators[0].Signer
+	outsider := GenRandomVal(1, 0, 10, 0, false, 10)[0].Signer
+
+	cases := []struct {
+		name  string
+		msg   borTypes.MsgProposeSpan
+		check string
+	}{
+		{"span id gap", borTypes.NewMsgProposeSpan(2, proposer, 256, 511, borTypes.DefaultChainID), borTypes.SpanCheckID},
+		{"start block overlap", borTypes.NewMsgProposeSpan(1, proposer, 200, 455, borTypes.DefaultChainID), borTypes.SpanCheckStartBlock},
+		{"start block gap", borTypes.NewMsgProposeSpan(1, proposer, 300, 555, borTypes.DefaultChainID), borTypes.SpanCheckStartBlock},
+		{"end block off by one", borTypes.NewMsgProposeSpan(1, proposer, 256, 512, borTypes.DefaultChainID), borTypes.SpanCheckEndBlock},
+		{"wrong chain id", borTypes.NewMsgProposeSpan(1, proposer, 256, 511, "80001"), borTypes.SpanCheckChainID},
+		{"non validator proposer", borTypes.NewMsgProposeSpan(1, outsider, 256, 511, borTypes.DefaultChainID), borTypes.SpanCheckProposer},
+	}
+
+	querier := bor.NewQuerier(borKeeper)
+	handler := bor.NewHandler(borKeeper)
+	for _, c := range cases {
+		// dry run reports failed check
+		data, err := borTypes.ModuleCdc.MarshalJSON(c.msg)
+		require.NoError(t, err)
+		res, sdkErr := querier(ctx, []string{borTypes.QueryValidateSpan}, abci.RequestQuery{Data: data})
+		require.Nil(t, sdkErr, c.name)
+
+		var validation borTypes.SpanValidation
+		require.NoError(t, json.Unmarshal(res, &validation))
+		require.False(t, validation.Valid, c.name)
+		require.Equal(t, c.check, validation.FailedCheck, c.name)
+		require.NotEmpty(t, validation.Error, c.name)
+
+		// handler rejects span
+		result := handler(ctx, c.msg)
+		require.False(t, result.IsOK(), c.name)
+	}
+
+	// valid span passes dry run without storing it
+	msg := borTypes.NewMsgProposeSpan(1, proposer, 256, 511, borTypes.DefaultChainID)
+	data, err := borTypes.ModuleCdc.MarshalJSON(msg)
+	require.NoError(t, err)
+	res, sdkErr := querier(ctx, []string{borTypes.QueryValidateSpan}, abci.RequestQuery{Data: data})
+	require.Nil(t, sdkErr)
+
+	var validation borTypes.SpanValidation
+	require.NoError(t, json.Unmarshal(res, &validation))
+	require.True(t, validation.Valid)
+	require.Empty(t, validation.FailedCheck)
+
+	lastSpan, err := borKeeper.GetLastSpan(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), lastSpan.ID)
+
+	// valid span is stored by handler
+	result := handler(ctx, msg)
+	require.True(t, result.IsOK(), result.Log)
+
+	lastSpan, err = borKeeper.GetLastSpan(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), lastSpan.ID)
+	require.Equal(t, uint64(256), lastSpan.StartBlock)
+	require.Equal(t, uint64(511), lastSpan.EndBlock)
+	require.Len(t, lastSpan.SelectedProducers, 4)
+
+	// same span can not be proposed twice
+	result = handler(ctx, msg)
+	require.False(t, result.IsOK())
+}
+
+func TestHeimdallSpanSeed(t *testing.T) {
+	checkpointRetriever := &staticCheckpointRetriever{}
+	ctx, borKeeper, sk := createBorTestInputWithCheckpoint(t, checkpointRetriever)
+	ctx = ctx.WithBlockHeader(abci.Header{ChainID: "foochainid", Height: 10, AppHash: []byte{0x01, 0x02, 0x03}})
+
+	// more validators than producers so a seed is required
+	validators := GenRandomVal(6, 0, 10, 0, false, 1)
+	loadValidators(t, sk, ctx, validators)
+
+	firstSpan := types.NewSpan(0, 0, 255, sk.GetValidatorSet(ctx), validators[:4], borTypes.DefaultChainID)
+	genesisState := borTypes.DefaultGenesisState()
+	genesisState.SpanDuration = 256
+	genesisState.Spans = []*types.Span{&firstSpan}
+	genesisState.SeedSource = borTypes.SeedSourceHeimdall
+	require.NoError(t, borTypes.ValidateGenesis(genesisState))
+	bor.InitGenesis(ctx, borKeeper, genesisState)
+
+	// selection never reaches mainchain and is same on every run
+	producers, spanSeed, err := borKeeper.SelectNextProducersWithSeed(ctx)
+	require.NoError(t, err)
+	require.Equal(t, borTypes.SeedSourceHeimdall, spanSeed.Source)
+	require.False(t, spanSeed.Seed.Empty())
+	require.Equal(t, uint64(0), spanSeed.EthBlock)
+
+	again, againSeed, err := borKeeper.SelectNextProducersWithSeed(ctx)
+	require.NoError(t, err)
+	require.Equal(t, spanSeed, againSeed)
+	require.Equal(t, producers, again)
+
+	// last checkpoint changes seed
+	checkpointRetriever.checkpoint = &types.CheckpointBlockHeader{RootHash: types.HexToHeimdallHash("0xabcd")}
+	_, otherSeed, err := borKeeper.SelectNextProducersWithSeed(ctx)
+	require.NoError(t, err)
+	require.NotEqual(t, spanSeed.Seed, otherSeed.Seed)
+	require.Equal(t, types.HexToHeimdallHash("0xabcd"), otherSeed.CheckpointRoot)
+
+	// query runs on last committed header, propose span runs in header of next block
+	res, sdkErr := bor.NewQuerier(borKeeper)(ctx, []string{borTypes.QueryNextSpan}, abci.RequestQuery{})
+	require.Nil(t, sdkErr)
+
+	var schedule borTypes.NextSpanSchedule
+	require.NoError(t, json.Unmarshal(res, &schedule))
+
+	deliverCtx := ctx.WithBlockHeader(abci.Header{ChainID: "foochainid", Height: 11, AppHash: []byte{0x04}})
+	deliverProducers, deliverSeed, err := borKeeper.SelectNextProducersWithSeed(deliverCtx)
+	require.NoError(t, err)
+	require.Equal(t, otherSeed, deliverSeed)
+	require.Equal(t, schedule.SelectedProducers, deliverProducers)
+
+	// propose span with a producer selected by query
+	ctx = deliverCtx
+	spanSeed = deliverSeed
+	msg := borTypes.NewMsgProposeSpan(1, schedule.SelectedProducers[0].Signer, 256, 511, borTypes.DefaultChainID)
+	result := bor.NewHandler(borKeeper)(ctx, msg)
+	require.True(t, result.IsOK(), result.Log)
+
+	// seed history re-verifies selection of stored span
+	storedSeed, err := borKeeper.GetSpanSeed(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, spanSeed, *storedSeed)
+
+	span, err := borKeeper.GetSpan(ctx, 1)
+	require.NoError(t, err)
+
+	// seed is derived again from recorded input
+	seedSpan, err := borKeeper.GetSpan(ctx, 0)
+	require.NoError(t, err)
+	seedSpanBytes, err := codec.New().MarshalBinaryBare(*seedSpan)
+	require.NoError(t, err)
+	require.Equal(t, crypto.Keccak256Hash(seedSpanBytes, storedSeed.CheckpointRoot.Bytes()), storedSeed.Seed.EthHash())
+
+	selector, err := bor.GetProducerSelector(storedSeed.ProducerSelection)
+	require.NoError(t, err)
+	verified, err := selector.SelectProducers(bor.SelectionInput{
+		SpanID:        storedSeed.SpanID,
+		Seed:          storedSeed.Seed.EthHash(),
+		Validators:    sk.GetSpanEligibleValidators(ctx),
+		ProducerCount: borTypes.DefaultProducerCount,
+	})
+	require.NoError(t, err)
+	require.Equal(t, span.SelectedProducers, types.SortValidatorByAddress(verified))
+
+	// seed history is exported and queryable
+	require.Equal(t, []borTypes.SpanSeed{*storedSeed}, bor.ExportGenesis(ctx, borKeeper).SpanSeeds)
+
+	data, err := borTypes.ModuleCdc.MarshalJSON(borTypes.NewQuerySpanParams(1))
+	require.NoError(t, err)
+	res, sdkErr = bor.NewQuerier(borKeeper)(ctx, []string{borTypes.QuerySpanSeed}, abci.RequestQuery{Data: data})
+	require.Nil(t, sdkErr)
+
+	var queried borTypes.SpanSeed
+	require.NoError(t, json.Unmarshal(res, &queried))
+	require.Equal(t, *storedSeed, queried)
+
+	// genesis span has no seed
+	_, err = borKeeper.GetSpanSeed(ctx, 0)
+	require.Error(t, err)
+}
+
+// borContractCaller serves span details and bor block from memory
+type borContractCaller struct {
+	helper.IContractCaller
+
+	spans    map[uint64][3]uint64 // span id to number, start block and end block on contract
+	borBlock uint64
+}
+
+// GetSpanDetails returns span committed on validator set contract
+func (c *borContractCaller) GetSpanDetails(id *big.Int) (*big.Int, *big.Int, *big.Int, error) {
+	span := c.spans[id.Uint64()]
+	return new(big.Int).SetUint64(span[0]), new(big.Int).SetUint64(span[1]), new(big.Int).SetUint64(span[2]), nil
+}
+
+// GetMaticChainBlock returns latest bor block
+func (c *borContractCaller) GetMaticChainBlock(*big.Int) (*ethTypes.Header, error) {
+	return &ethTypes.Header{Number: new(big.Int).SetUint64(c.borBlock)}, nil
+}
+
+func TestSpanStatus(t *testing.T) {
+	ctx, borKeeper, sk := createBorTestInput(t)
+
+	validators := GenRandomVal(4, 0, 10, 0, false, 1)
+	loadValidators(t, sk, ctx, validators)
+
+	firstSpan := types.NewSpan(0, 0, 255, sk.GetValidatorSet(ctx), validators, borTypes.DefaultChainID)
+	genesisState := borTypes.DefaultGenesisState()
+	genesisState.SpanDuration = 256
+	genesisState.Spans = []*types.Span{&firstSpan}
+	bor.InitGenesis(ctx, borKeeper, genesisState)
+
+	handler := bor.NewHandler(borKeeper)
+	postHandler := bor.NewPostTxHandler(borKeeper)
+	from := validators[0].Signer
+
+	// genesis and newly proposed spans wait for commit
+	result := handler(ctx, borTypes.NewMsgProposeSpan(1, from, 256, 511, borTypes.DefaultChainID))
+	require.True(t, result.IsOK(), result.Log)
+	result = handler(ctx, borTypes.NewMsgProposeSpan(2, from, 512, 767, borTypes.DefaultChainID))
+	require.True(t, result.IsOK(), result.Log)
+	for id := uint64(0); id <= 2; id++ {
+		spanStatus, err := borKeeper.GetSpanStatus(ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, borTypes.SpanStatusProposed, spanStatus.Status)
+	}
+
+	// only committed and missed can be reported for existing spans
+	require.NotNil(t, borTypes.NewMsgSpanStatus(from, 1, borTypes.SpanStatusProposed).ValidateBasic())
+	require.Nil(t, borTypes.NewMsgSpanStatus(from, 1, borTypes.SpanStatusMissed).ValidateBasic())
+	require.False(t, handler(ctx, borTypes.NewMsgSpanStatus(from, 3, borTypes.SpanStatusCommitted)).IsOK())
+
+	// bor committed span 1 and is at block 600 without span 2
+	caller := &borContractCaller{
+		spans:    map[uint64][3]uint64{0: {0, 0, 255}, 1: {1, 256, 511}},
+		borBlock: 600,
+	}
+	sideHandler := bor.NewSideTxHandler(borKeeper, caller)
+
+	require.Equal(t, types.SideTxResultYes, sideHandler(ctx, borTypes.NewMsgSpanStatus(from, 1, borTypes.SpanStatusCommitted)))
+	require.Equal(t, types.SideTxResultNo, sideHandler(ctx, borTypes.NewMsgSpanStatus(from, 1, borTypes.SpanStatusMissed)))
+	require.Equal(t, types.SideTxResultNo, sideHandler(ctx, borTypes.NewMsgSpanStatus(from, 2, borTypes.SpanStatusCommitted)))
+	require.Equal(t, types.SideTxResultYes, sideHandler(ctx, borTypes.NewMsgSpanStatus(from, 2, borTypes.SpanStatusMissed)))
+
+	// span is not missed before bor reaches its start block
+	caller.borBlock = 500
+	require.Equal(t, types.SideTxResultNo, sideHandler(ctx, borTypes.NewMsgSpanStatus(from, 2, borTypes.SpanStatusMissed)))
+
+	// approved statuses are stored
+	result = postHandler(ctx, borTypes.NewMsgSpanStatus(from, 1, borTypes.SpanStatusCommitted))
+	require.True(t, result.IsOK(), result.Log)
+
+	// missed span raises alert
+	result = postHandler(ctx, borTypes.NewMsgSpanStatus(from, 2, borTypes.SpanStatusMissed))
+	require.True(t, result.IsOK(), result.Log)
+	alerted := false
+	for _, event := range result.Events {
+		if event.Type == borTypes.EventTypeSpanMissed {
+			alerted = true
+		}
+	}
+	require.True(t, alerted)
+
+	// committed is final, missed span can still be committed late
+	require.False(t, handler(ctx, borTypes.NewMsgSpanStatus(from, 1, borTypes.SpanStatusMissed)).IsOK())
+	require.False(t, postHandler(ctx, borTypes.NewMsgSpanStatus(from, 1, borTypes.SpanStatusCommitted)).IsOK())
+	require.True(t, handler(ctx, borTypes.NewMsgSpanStatus(from, 2, borTypes.SpanStatusCommitted)).IsOK())
+
+	// status is queryable and exported
+	data, err := borTypes.ModuleCdc.MarshalJSON(borTypes.NewQuerySpanParams(2))
+	require.NoError(t, err)
+	res, sdkErr := bor.NewQuerier(borKeeper)(ctx, []string{borTypes.QuerySpanStatus}, abci.RequestQuery{Data: data})
+	require.Nil(t, sdkErr)
+
+	var queried borTypes.SpanStatus
+	require.NoError(t, json.Unmarshal(res, &queried))
+	require.Equal(t, borTypes.NewSpanStatus(2, borTypes.SpanStatusMissed), queried)
+
+	exported := bor.ExportGenesis(ctx, borKeeper)
+	require.NoError(t, borTypes.ValidateGenesis(exported))
+	require.Equal(t, []borTypes.SpanStatus{
+		borTypes.NewSpanStatus(0, borTypes.SpanStatusProposed),
+		borTypes.NewSpanStatus(1, borTypes.SpanStatusCommitted),
+		borTypes.NewSpanStatus(2, borTypes.SpanStatusMissed),
+	}, exported.SpanStatuses)
+}
+
+func TestReplaceProducer(t *testing.T) {
+	ctx, borKeeper, sk := createBorTestInput(t)
+
+	// one validator more than producers, round robin needs no mainchain seed
+	validators := GenRandomVal(5, 0, 10, 0, false, 1)
+	loadValidators(t, sk, ctx, validators)
+
+	firstSpan := types.NewSpan(0, 0, 255, sk.GetValidatorSet(ctx), validators[:4], borTypes.DefaultChainID)
+	genesisState := borTypes.DefaultGenesisState()
+	genesisState.SpanDuration = 256
+	genesisState.Spans = []*types.Span{&firstSpan}
+	genesisState.ProducerSelection = borTypes.ProducerSelectionRoundRobin
+	bor.InitGenesis(ctx, borKeeper, genesisState)
+
+	handler := bor.NewHandler(borKeeper)
+	replaced := validators[1].ID
+
+	// producer, voter and effective block are checked
+	require.NotNil(t, borTypes.NewMsgReplaceProducer(validators[0].Signer, 0, 0, 64).ValidateBasic())
+	outsider := GenRandomVal(1, 0, 10, 0, false, 10)[0].Signer
+	invalid := []borTypes.MsgReplaceProducer{
+		borTypes.NewMsgReplaceProducer(validators[0].Signer, 1, replaced, 64),
+		borTypes.NewMsgReplaceProducer(outsider, 0, replaced, 64),
+		borTypes.NewMsgReplaceProducer(validators[0].Signer, 0, validators[4].ID, 64),
+		borTypes.NewMsgReplaceProducer(validators[0].Signer, 0, replaced, 100),
+		borTypes.NewMsgReplaceProducer(validators[0].Signer, 0, replaced, 256),
+	}
+	for _, msg := range invalid {
+		require.False(t, handler(ctx, msg).IsOK(), "%+v", msg)
+	}
+
+	// votes up to 2/3 of power are only stored
+	for _, val := range validators[:3] {
+		result := handler(ctx, borTypes.NewMsgReplaceProducer(val.Signer, 0, replaced, 128))
+		require.True(t, result.IsOK(), result.Log)
+	}
+	require.False(t, handler(ctx, borTypes.NewMsgReplaceProducer(validators[0].Signer, 0, replaced, 128)).IsOK())
+	require.Empty(t, borKeeper.GetSpanVersions(ctx, 0))
+	require.Len(t, borKeeper.GetReplaceVotes(ctx, 0, replaced, 128).Voters, 3)
+
+	// vote above 2/3 of power appends span version without replaced producer
+	result := handler(ctx, borTypes.NewMsgReplaceProducer(validators[3].Signer, 0, replaced, 128))
+	require.True(t, result.IsOK(), result.Log)
+
+	versions := borKeeper.GetSpanVersions(ctx, 0)
+	require.Len(t, versions, 1)
+	require.Equal(t, uint64(1), versions[0].Version)
+	require.Equal(t, uint64(128), versions[0].EffectiveBlock)
+	require.Equal(t, replaced, versions[0].ReplacedProducer)
+	require.Len(t, versions[0].SelectedProducers, 4)
+	for _, producer := range versions[0].SelectedProducers {
+		require.NotEqual(t, replaced, producer.ID)
+	}
+	require.Empty(t, borKeeper.GetReplaceVotes(ctx, 0, replaced, 128).Voters)
+
+	// producers change only from effective block
+	version, producers := borKeeper.GetSpanProducersAt(ctx, firstSpan, 127)
+	require.Equal(t, uint64(0), version)
+	require.Equal(t, firstSpan.SelectedProducers, producers)
+	version, producers = borKeeper.GetSpanProducersAt(ctx, firstSpan, 128)
+	require.Equal(t, uint64(1), version)
+	require.Equal(t, versions[0].SelectedProducers, producers)
+
+	// replaced producer and blocks before latest version can not be replaced again
+	require.False(t, handler(ctx, borTypes.NewMsgReplaceProducer(validators[0].Signer, 0, replaced, 192)).IsOK())
+	require.False(t, handler(ctx, borTypes.NewMsgReplaceProducer(validators[0].Signer, 0, validators[0].ID, 128)).IsOK())
+
+	// latest producers are queryable
+	data, err := borTypes.ModuleCdc.MarshalJSON(borTypes.NewQuerySpanProducersParams(0, 0))
+	require.NoError(t, err)
+	res, sdkErr := bor.NewQuerier(borKeeper)(ctx, []string{borTypes.QuerySpanProducers}, abci.RequestQuery{Data: data})
+	require.Nil(t, sdkErr)
+
+	var queried []types.Validator
+	require.NoError(t, json.Unmarshal(res, &queried))
+	require.Equal(t, versions[0].SelectedProducers, queried)
+
+	// span queries read by bor serve producers effective at bor block, latest producers by default
+	querier := bor.NewQuerier(borKeeper)
+	for block, expected := range map[uint64][]types.Validator{
+		0:   versions[0].SelectedProducers,
+		127: firstSpan.SelectedProducers,
+		128: versions[0].SelectedProducers,
+	} {
+		data, err = borTypes.ModuleCdc.MarshalJSON(borTypes.NewQuerySpanAtBlockParams(0, block))
+		require.NoError(t, err)
+		res, sdkErr = querier(ctx, []string{borTypes.QuerySpan}, abci.RequestQuery{Data: data})
+		require.Nil(t, sdkErr)
+
+		var span types.Span
+		require.NoError(t, json.Unmarshal(res, &span))
+		require.Equal(t, expected, span.SelectedProducers, "block %v", block)
+	}
+
+	res, sdkErr = querier(ctx, []string{borTypes.QueryLatestSpan}, abci.RequestQuery{})
+	require.Nil(t, sdkErr)
+	var latestSpan types.Span
+	require.NoError(t, json.Unmarshal(res, &latestSpan))
+	require.Equal(t, versions[0].SelectedProducers, latestSpan.SelectedProducers)
+
+	// versions are exported
+	exported := bor.ExportGenesis(ctx, borKeeper)
+	require.NoError(t, borTypes.ValidateGenesis(exported))
+	require.Equal(t, versions, exported.SpanVersions)
+
+	// producer replaced earlier is not selected again
+	next := versions[0].SelectedProducers[0].ID
+	for _, val := range validators[:4] {
+		result := handler(ctx, borTypes.NewMsgReplaceProducer(val.Signer, 0, next, 192))
+		require.True(t, result.IsOK(), result.Log)
+	}
+	versions = borKeeper.GetSpanVersions(ctx, 0)
+	require.Len(t, versions, 2)
+	require.Len(t, versions[1].SelectedProducers, 3)
+	for _, producer := range versions[1].SelectedProducers {
+		require.NotEqual(t, replaced, producer.ID)
+		require.NotEqual(t, next, producer.ID)
+	}
+
+	// votes which never reach 2/3 of power expire once span is over
+	for id, start := range []uint64{256, 512} {
+		result = handler(ctx, borTypes.NewMsgProposeSpan(uint64(id+1), validators[0].Signer, start, start+255, borTypes.DefaultChainID))
+		require.True(t, result.IsOK(), result.Log)
+	}
+	producer := validators[4].ID
+	result = handler(ctx, borTypes.NewMsgReplaceProducer(validators[0].Signer, 1, producer, 320))
+	require.True(t, result.IsOK(), result.Log)
+	require.Len(t, borKeeper.GetReplaceVotes(ctx, 1, producer, 320).Voters, 1)
+
+	result = handler(ctx, borTypes.NewMsgProposeSpan(3, validators[0].Signer, 768, 1023, borTypes.DefaultChainID))
+	require.True(t, result.IsOK(), result.Log)
+	require.Empty(t, borKeeper.GetReplaceVotes(ctx, 1, producer, 320).Voters)
+	require.False(t, handler(ctx, borTypes.NewMsgReplaceProducer(validators[1].Signer, 1, producer, 320)).IsOK())
+}
@@ -0,0 +1,680 @@
+package test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/params/subspace"
+	ethCommon "github.com/maticnetwork/bor/common"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/bor/crypto"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/maticnetwork/heimdall/checkpoint"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/helper"
+	"github.com/maticnetwork/heimdall/sidetx"
+	sidetxTypes "github.com/maticnetwork/heimdall/sidetx/types"
+	"github.com/maticnetwork/heimdall/staking"
+	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
+	"github.com/maticnetwork/heimdall/types"
+)
+
+// testBorChainID is the bor chain registered by test input
+var testBorChainID = strconv.Itoa(helper.DefaultBorChainID)
+
+// checkpointTimingResult captures results and state after checkpoint timing scenario
+type checkpointTimingResult struct {
+	CheckpointCode sdk.CodeType
+	NoAckCodes     []sdk.CodeType
+	LastNoAck      uint64
+	ProposerID     types.ValidatorID
+	HasBuffer      bool
+}
+
+// runCheckpointTimingScenario runs same set of checkpoint txs at block time with given local config and checkpoint params.
+// Last acked checkpoint and buffered checkpoint are created at checkpoint time.
+func runCheckpointTimingScenario(t *testing.T, config helper.Configuration, params checkpointTypes.Params, validators []types.Validator, checkpointTime time.Time, blockTime time.Time) checkpointTimingResult {
+	helper.SetTestConfig(config)
+
+	ctx, sk, ck := CreateTestInput(t, false)
+	ctx = ctx.WithBlockTime(blockTime)
+	genesisState := checkpointTypes.DefaultGenesisState()
+	genesisState.Params = params
+	checkpoint.InitGenesis(ctx, ck, genesisState)
+	loadValidators(t, sk, ctx, validators)
+	sk.IncrementAccum(ctx, 1)
+
+	handler := checkpoint.NewHandler(ck, nil)
+	proposer := sk.GetValidatorSet(ctx).Proposer.Signer
+
+	// last acked checkpoint and next checkpoint in buffer
+	require.NoError(t, ck.AddCheckpoint(ctx, params.ChildBlockInterval, types.CreateBlock(0, 255, types.HexToHeimdallHash("0x01"), types.HexToHeimdallHash("0x02"), proposer, testBorChainID, uint64(checkpointTime.Unix()))))
+	ck.UpdateACKCount(ctx, testBorChainID)
+	require.NoError(t, ck.SetCheckpointBuffer(ctx, types.CreateBlock(256, 511, types.HexToHeimdallHash("0x03"), types.HexToHeimdallHash("0x02"), proposer, testBorChainID, uint64(checkpointTime.Unix()))))
+
+	var result checkpointTimingResult
+
+	// new checkpoint is rejected only while buffer is alive
+	msgCheckpoint := checkpointTypes.NewMsgCheckpointBlock(proposer, 256, 511, types.HexToHeimdallHash("0x04"), types.HexToHeimdallHash("0x02"), testBorChainID, uint64(blockTime.Unix()))
+	result.CheckpointCode = handler(ctx, msgCheckpoint).Code
+
+	// second no-ack is sent a minute after first one
+	for _, noAckTime := range []time.Time{blockTime, blockTime.Add(time.Minute)} {
+		ctx = ctx.WithBlockTime(noAckTime)
+		msgNoAck := checkpointTypes.NewMsgCheckpointNoAck(proposer, uint64(noAckTime.Unix()))
+		result.NoAckCodes = append(result.NoAckCodes, handler(ctx, msgNoAck).Code)
+	}
+
+	result.LastNoAck = ck.GetLastNoAck(ctx)
+	result.ProposerID = sk.GetValidatorSet(ctx).Proposer.ID
+	_, err := ck.GetCheckpointFromBuffer(ctx, testBorChainID)
+	result.HasBuffer = err == nil
+	return result
+}
+
+// loadValidators adds given validators to store and current validator set
+func loadValidators(t *testing.T, keeper staking.Keeper, ctx sdk.Context, validators []types.Validator) {
+	var valSet types.ValidatorSet
+	for i := range validators {
+		validator := validators[i]
+		err := keeper.AddValidator(ctx, validator)
+		require.Empty(t, err, "Unable to set validator, Error: %v", err)
+		valSet.UpdateWithChangeSet([]*types.Validator{&validator})
+	}
+
+	err := keeper.UpdateValidatorSetInStore(ctx, valSet)
+	require.Empty(t, err, "Unable to update validator set")
+}
+
+// tests nodes with different local configs reach same state for checkpoint timing decisions,
+// and that block time decides timing while wall clock disagrees with it
+func TestCheckpointTimingWithDifferentConfigs(t *testing.T) {
+	validators := GenRandomVal(4, 0, 10, 0, false, 1)
+	params := checkpointTypes.DefaultParams()
+
+	// local bridge config differs between nodes
+	config := helper.GetDefaultHeimdallConfig()
+	otherConfig := helper.GetDefaultHeimdallConfig()
+	otherConfig.CheckpointerPollInterval = time.Minute
+	otherConfig.NoACKPollInterval = time.Second
+	otherConfig.SyncerPollInterval = time.Hour
+	otherConfig.SideTxPollInterval = time.Millisecond
+
+	noAckCode := common.ErrNoACK(common.DefaultCodespace, 0).Code()
+
+	// checkpoints long expired by wall clock are alive by block time
+	checkpointTime := time.Unix(1500000000, 0).UTC()
+	blockTime := checkpointTime.Add(params.CheckpointBufferTime / 2)
+	require.True(t, time.Since(checkpointTime) > params.CheckpointBufferTime)
+
+	first := runCheckpointTimingScenario(t, config, params, validators, checkpointTime, blockTime)
+	second := runCheckpointTimingScenario(t, otherConfig, params, validators, checkpointTime, blockTime)
+	require.Equal(t, first, second, "Nodes with different local config should reach same state")
+	require.Equal(t, noAckCode, first.CheckpointCode, "Checkpoint should be rejected while buffer is alive")
+	require.Equal(t, common.ErrInvalidNoACK(common.DefaultCodespace).Code(), first.NoAckCodes[0], "No-ack should be rejected within buffer time of last checkpoint")
+	require.Equal(t, first.NoAckCodes[0], first.NoAckCodes[1])
+	require.Equal(t, uint64(0), first.LastNoAck, "Last no-ack should not be set")
+	require.True(t, first.HasBuffer, "Buffered checkpoint should not be flushed")
+
+	// checkpoints still alive by wall clock are expired by block time
+	checkpointTime = time.Now().UTC()
+	blockTime = checkpointTime.Add(2 * params.CheckpointBufferTime)
+
+	first = runCheckpointTimingScenario(t, config, params, validators, checkpointTime, blockTime)
+	second = runCheckpointTimingScenario(t, otherConfig, params, validators, checkpointTime, blockTime)
+	require.Equal(t, first, second, "Nodes with different local config should reach same state")
+	require.NotEqual(t, noAckCode, first.CheckpointCode, "Checkpoint should pass buffer check once buffer expired")
+	require.Equal(t, sdk.CodeOK, first.NoAckCodes[0], "First no-ack should be accepted after buffer time")
+	require.Equal(t, common.ErrTooManyNoACK(common.DefaultCodespace).Code(), first.NoAckCodes[1], "Repetitive no-ack should be rejected")
+	require.Equal(t, uint64(blockTime.Unix()), first.LastNoAck, "Last no-ack should be set from block time")
+}
+
+// tests checkpoint timing and length decisions follow checkpoint params
+func TestCheckpointTimingWithParams(t *testing.T) {
+	helper.SetTestConfig(helper.GetDefaultHeimdallConfig())
+	validators := GenRandomVal(4, 0, 10, 0, false, 1)
+	blockTime := time.Unix(1500000000, 0).UTC()
+
+	params := checkpointTypes.DefaultParams()
+	params.MaxCheckpointLength = 512
+	params.CheckpointBufferTime = time.Second
+	require.NoError(t, params.Validate())
+
+	ctx, sk, ck := CreateTestInput(t, false)
+	ctx = ctx.WithBlockTime(blockTime)
+	genesisState := checkpointTypes.DefaultGenesisState()
+	genesisState.Params = params
+	checkpoint.InitGenesis(ctx, ck, genesisState)
+	require.Equal(t, params, ck.GetParams(ctx), "Params should be set from genesis")
+	require.Equal(t, params, checkpoint.ExportGenesis(ctx, ck).Params, "Params should be exported")
+	loadValidators(t, sk, ctx, validators)
+	sk.IncrementAccum(ctx, 1)
+
+	handler := checkpoint.NewHandler(ck, nil)
+	proposer := sk.GetValidatorSet(ctx).Proposer.Signer
+
+	// checkpoint longer than max checkpoint length is rejected
+	msgCheckpoint := checkpointTypes.NewMsgCheckpointBlock(proposer, 0, 1023, types.HexToHeimdallHash("0x03"), types.HexToHeimdallHash("0x04"), testBorChainID, uint64(blockTime.Unix()))
+	require.NotEqual(t, sdk.CodeOK, handler(ctx, msgCheckpoint).Code, "Checkpoint longer than max length should be rejected")
+
+	// no-acks a minute apart are accepted with short buffer time
+	for _, noAckTime := range []time.Time{blockTime, blockTime.Add(time.Minute)} {
+		ctx = ctx.WithBlockTime(noAckTime)
+		msgNoAck := checkpointTypes.NewMsgCheckpointNoAck(proposer, uint64(noAckTime.Unix()))
+		require.Equal(t, sdk.CodeOK, handler(ctx, msgNoAck).Code, "No-ack after buffer time should be accepted")
+	}
+	require.Equal(t, uint64(blockTime.Add(time.Minute).Unix()), ck.GetLastNoAck(ctx), "Last no-ack should be set from block time")
+}
+
+// tests checkpoint containing child block is found by block number
+func TestGetCheckpointByBlockNumber(t *testing.T) {
+	helper.SetTestConfig(helper.GetDefaultHeimdallConfig())
+	ctx, _, ck := CreateTestInput(t, false)
+	ck.SetParams(ctx, checkpointTypes.DefaultParams())
+	childBlockInterval := ck.GetParams(ctx).ChildBlockInterval
+
+	// add contiguous checkpoints [0, 255], [256, 511], [512, 767]
+	for i := uint64(0); i < 3; i++ {
+		header := types.CreateBlock(i*256, i*256+255, types.HexToHeimdallHash("0x01"), types.HexToHeimdallHash("0x02"), types.HexToHeimdallAddress("0x03"), testBorChainID, 0)
+		require.NoError(t, ck.AddCheckpoint(ctx, (i+1)*childBlockInterval, header))
+		ck.UpdateACKCount(ctx, testBorChainID)
+	}
+
+	for _, tc := range []struct {
+		blockNumber uint64
+		headerIndex uint64
+	}{
+		{0, childBlockInterval},
+		{255, childBlockInterval},
+		{256, 2 * childBlockInterval},
+		{600, 3 * childBlockInterval},
+		{767, 3 * childBlockInterval},
+	} {
+		headerIndex, header, err := ck.GetCheckpointByBlockNumber(ctx, testBorChainID, tc.blockNumber)
+		require.NoError(t, err)
+		require.Equal(t, tc.headerIndex, headerIndex, "Wrong checkpoint for block %v", tc.blockNumber)
+		require.True(t, header.StartBlock <= tc.blockNumber && tc.blockNumber <= header.EndBlock)
+	}
+
+	_, _, err := ck.GetCheckpointByBlockNumber(ctx, testBorChainID, 768)
+	require.Error(t, err, "Block after last checkpoint should not be found")
+}
+
+// tests merkle proof of leaf verifies against root as RootChain contract does
+func TestGetMerkleProof(t *testing.T) {
+	leaves := make([][32]byte, 5)
+	for i := range leaves {
+		copy(leaves[i][:], crypto.Keccak256([]byte{byte(i)}))
+	}
+
+	for index := uint64(0); index < uint64(len(leaves)); index++ {
+		root, proof, err := checkpointTypes.GetMerkleProof(leaves, index)
+		require.NoError(t, err)
+		require.Equal(t, 3*32, len(proof), "Proof should have sibling for each level")
+
+		// compute root from leaf and proof
+		computed := leaves[index][:]
+		idx := index
+		for i := 0; i < len(proof); i += 32 {
+			if idx%2 == 0 {
+				computed = crypto.Keccak256(computed, proof[i:i+32])
+			} else {
+				computed = crypto.Keccak256(proof[i:i+32], computed)
+			}
+			idx = idx / 2
+		}
+		require.Equal(t, root, computed, "Proof should verify for leaf %v", index)
+	}
+
+	_, _, err := checkpointTypes.GetMerkleProof(leaves, 5)
+	require.Error(t, err, "Out of range leaf should fail")
+}
+
+// tests expired checkpoint buffer is flushed in end blocker and proposer is rotated
+func TestCheckpointBufferTimeout(t *testing.T) {
+	helper.SetTestConfig(helper.GetDefaultHeimdallConfig())
+	validators := GenRandomVal(4, 0, 10, 0, false, 1)
+	blockTime := time.Unix(1500000000, 0).UTC()
+
+	ctx, sk, ck := CreateTestInput(t, false)
+	ck.SetParams(ctx, checkpointTypes.DefaultParams())
+	loadValidators(t, sk, ctx, validators)
+	sk.IncrementAccum(ctx, 1)
+
+	bufferTime := ck.GetParams(ctx).CheckpointBufferTime
+	proposer := sk.GetValidatorSet(ctx).Proposer.Signer
+	header := types.CreateBlock(0, 255, types.HexToHeimdallHash("0x01"), types.HexToHeimdallHash("0x02"), proposer, testBorChainID, uint64(blockTime.Unix()))
+	require.NoError(t, ck.SetCheckpointBuffer(ctx, header))
+
+	// buffer is kept within buffer time
+	ctx = ctx.WithBlockTime(blockTime.Add(bufferTime - time.Second)).WithEventManager(sdk.NewEventManager())
+	checkpoint.EndBlocker(ctx, ck)
+	_, err := ck.GetCheckpointFromBuffer(ctx, testBorChainID)
+	require.NoError(t, err, "Checkpoint should stay in buffer before buffer time")
+	require.Empty(t, ctx.EventManager().Events(), "No event should be emitted before buffer time")
+
+	// buffer is flushed after buffer time
+	ctx = ctx.WithBlockTime(blockTime.Add(bufferTime)).WithEventManager(sdk.NewEventManager())
+	checkpoint.EndBlocker(ctx, ck)
+	_, err = ck.GetCheckpointFromBuffer(ctx, testBorChainID)
+	require.Error(t, err, "Checkpoint buffer should be flushed after buffer time")
+	require.NotEqual(t, proposer, sk.GetValidatorSet(ctx).Proposer.Signer, "Proposer should be rotated")
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, checkpointTypes.EventTypeCheckpointTimeout, events[0].Type)
+}
+
+// tests proposer is rotated once when buffers of several bor chains expire in same block
+func TestCheckpointBufferTimeoutMultipleChains(t *testing.T) {
+	helper.SetTestConfig(helper.GetDefaultHeimdallConfig())
+	validators := GenRandomVal(4, 0, 10, 0, false, 1)
+	blockTime := time.Unix(1500000000, 0).UTC()
+	otherBorChainID := "80001"
+
+	ctx, sk, ck := CreateTestInput(t, false)
+	genesisState := checkpointTypes.DefaultGenesisState()
+	genesisState.BorChainIDs = append(genesisState.BorChainIDs, otherBorChainID)
+	checkpoint.InitGenesis(ctx, ck, genesisState)
+	loadValidators(t, sk, ctx, validators)
+	sk.IncrementAccum(ctx, 1)
+
+	proposer := sk.GetValidatorSet(ctx).Proposer.Signer
+	require.NoError(t, ck.SetCheckpointBuffer(ctx, types.CreateBlock(0, 255, types.HexToHeimdallHash("0x01"), types.HexToHeimdallHash("0x02"), proposer, testBorChainID, uint64(blockTime.Unix()))))
+	require.NoError(t, ck.SetCheckpointBuffer(ctx, types.CreateBlock(0, 127, types.HexToHeimdallHash("0x03"), types.HexToHeimdallHash("0x02"), proposer, otherBorChainID, uint64(blockTime.Unix()))))
+
+	validatorSet := sk.GetValidatorSet(ctx)
+	expectedProposer := validatorSet.CopyIncrementProposerPriority(1).Proposer.Signer
+
+	ctx = ctx.WithBlockTime(blockTime.Add(ck.GetParams(ctx).CheckpointBufferTime)).WithEventManager(sdk.NewEventManager())
+	checkpoint.EndBlocker(ctx, ck)
+	require.Empty(t, ck.GetCheckpointBuffers(ctx), "Buffers of both chains should be flushed")
+	require.Equal(t, expectedProposer, sk.GetValidatorSet(ctx).Proposer.Signer, "Proposer should be rotated once")
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 2, "Timeout event should be emitted per chain")
+	for _, event := range events {
+		require.Equal(t, checkpointTypes.EventTypeCheckpointTimeout, event.Type)
+	}
+}
+
+// tests ack with earlier end block records on-chain proposer and credits its stats
+func TestCheckpointAckAdjusted(t *testing.T) {
+	helper.SetTestConfig(helper.GetDefaultHeimdallConfig())
+	validators := GenRandomVal(4, 0, 10, 0, false, 1)
+
+	ctx, sk, ck := CreateTestInput(t, false)
+	ck.SetParams(ctx, checkpointTypes.DefaultParams())
+	loadValidators(t, sk, ctx, validators)
+	childBlockInterval := ck.GetParams(ctx).ChildBlockInterval
+
+	// buffered checkpoint proposed by first validator
+	header := types.CreateBlock(0, 255, types.HexToHeimdallHash("0x01"), types.HexToHeimdallHash("0x02"), validators[0].Signer, testBorChainID, 0)
+	require.NoError(t, ck.SetCheckpointBuffer(ctx, header))
+
+	// second validator submitted shorter checkpoint on root chain
+	dividendAccounts := sk.GetAllDividendAccounts(ctx)
+	msgAck := checkpointTypes.NewMsgCheckpointAck(validators[1].Signer, childBlockInterval, validators[1].Signer, 0, 127, types.HexToHeimdallHash("0x03"), testBorChainID, types.HexToHeimdallHash("0x04"), 0, 0)
+	result := checkpoint.NewPostTxHandler(ck, nil)(ctx, msgAck)
+	require.True(t, result.IsOK(), "Ack should be applied, log: %v", result.Log)
+
+	stored, err := ck.GetCheckpointByIndex(ctx, testBorChainID, childBlockInterval)
+	require.NoError(t, err)
+	require.Equal(t, uint64(127), stored.EndBlock, "End block should be adjusted")
+	require.Equal(t, types.HexToHeimdallHash("0x03"), stored.RootHash, "Root hash should be adjusted")
+	require.Equal(t, validators[1].Signer, stored.Proposer, "Proposer should be on-chain proposer")
+
+	stats := ck.GetProposerStats(ctx, validators[1].ID)
+	require.Equal(t, uint64(1), stats.CheckpointCount)
+	require.Equal(t, uint64(128), stats.BlockCount)
+	require.Equal(t, uint64(0), ck.GetProposerStats(ctx, validators[0].ID).CheckpointCount, "Original proposer should not be credited")
+	require.Equal(t, dividendAccounts, sk.GetAllDividendAccounts(ctx), "Rewards are paid on root chain, not to dividend accounts")
+
+	var adjusted *sdk.Event
+	for i, event := range result.Events {
+		if event.Type == checkpointTypes.EventTypeCheckpointAdjust {
+			adjusted = &result.Events[i]
+		}
+	}
+	require.NotNil(t, adjusted, "Adjusted event should be emitted")
+
+	attributes := make(map[string]string)
+	for _, attribute := range adjusted.Attributes {
+		attributes[string(attribute.Key)] = string(attribute.Value)
+	}
+	require.Equal(t, "255", attributes[checkpointTypes.AttributeKeyOriginalEndBlock])
+	require.Equal(t, "127", attributes[checkpointTypes.AttributeKeyEndBlock])
+	require.Equal(t, validators[0].Signer.String(), attributes[checkpointTypes.AttributeKeyOriginalProposer])
+	require.Equal(t, validators[1].Signer.String(), attributes[checkpointTypes.AttributeKeyProposer])
+}
+
+// tests ack for already processed root chain event is rejected
+func TestCheckpointAckSequence(t *testing.T) {
+	helper.SetTestConfig(helper.GetDefaultHeimdallConfig())
+	validators := GenRandomVal(4, 0, 10, 0, false, 1)
+
+	ctx, sk, ck := CreateTestInput(t, false)
+	ck.SetParams(ctx, checkpointTypes.DefaultParams())
+	loadValidators(t, sk, ctx, validators)
+	childBlockInterval := ck.GetParams(ctx).ChildBlockInterval
+
+	proposer := validators[0].Signer
+	header := types.CreateBlock(0, 255, types.HexToHeimdallHash("0x01"), types.HexToHeimdallHash("0x02"), proposer, testBorChainID, 0)
+	require.NoError(t, ck.SetCheckpointBuffer(ctx, header))
+
+	msgAck := checkpointTypes.NewMsgCheckpointAck(proposer, childBlockInterval, proposer, 0, 255, types.HexToHeimdallHash("0x01"), testBorChainID, types.HexToHeimdallHash("0x04"), 3, 100)
+	result := checkpoint.NewPostTxHandler(ck, nil)(ctx, msgAck)
+	require.True(t, result.IsOK(), "Ack should be applied, log: %v", result.Log)
+
+	// same root chain event is replayed against new buffered checkpoint
+	header = types.CreateBlock(256, 511, types.HexToHeimdallHash("0x03"), types.HexToHeimdallHash("0x02"), proposer, testBorChainID, 0)
+	require.NoError(t, ck.SetCheckpointBuffer(ctx, header))
+
+	msgReplay := checkpointTypes.NewMsgCheckpointAck(proposer, 2*childBlockInterval, proposer, 256, 511, types.HexToHeimdallHash("0x03"), testBorChainID, types.HexToHeimdallHash("0x04"), 3, 100)
+	result = checkpoint.NewPostTxHandler(ck, nil)(ctx, msgReplay)
+	require.False(t, result.IsOK(), "Replayed ack should be rejected")
+	require.Equal(t, uint64(1), ck.GetChainACKCount(ctx, testBorChainID))
+
+	// ack from different log is applied
+	msgReplay.LogIndex = 4
+	result = checkpoint.NewPostTxHandler(ck, nil)(ctx, msgReplay)
+	require.True(t, result.IsOK(), "Ack should be applied, log: %v", result.Log)
+	require.Equal(t, uint64(2), ck.GetChainACKCount(ctx, testBorChainID))
+}
+
+// tests ack must carry header block next to last acked checkpoint
+func TestCheckpointAckHeaderBlock(t *testing.T) {
+	helper.SetTestConfig(helper.GetDefaultHeimdallConfig())
+	validators := GenRandomVal(4, 0, 10, 0, false, 1)
+
+	ctx, sk, ck := CreateTestInput(t, false)
+	ck.SetParams(ctx, checkpointTypes.DefaultParams())
+	loadValidators(t, sk, ctx, validators)
+	childBlockInterval := ck.GetParams(ctx).ChildBlockInterval
+
+	proposer := validators[0].Signer
+	header := types.CreateBlock(0, 255, types.HexToHeimdallHash("0x01"), types.HexToHeimdallHash("0x02"), proposer, testBorChainID, 0)
+	require.NoError(t, ck.SetCheckpointBuffer(ctx, header))
+
+	// aligned header block skipping ahead of ack count is rejected
+	msgAck := checkpointTypes.NewMsgCheckpointAck(proposer, 2*childBlockInterval, proposer, 0, 255, types.HexToHeimdallHash("0x01"), testBorChainID, types.HexToHeimdallHash("0x04"), 0, 0)
+	result := checkpoint.NewPostTxHandler(ck, nil)(ctx, msgAck)
+	require.False(t, result.IsOK(), "Ack with wrong header block should be rejected")
+	require.Equal(t, uint64(0), ck.GetChainACKCount(ctx, testBorChainID))
+
+	// next header block is applied and stored where lookups read it
+	msgAck.HeaderBlock = childBlockInterval
+	result = checkpoint.NewPostTxHandler(ck, nil)(ctx, msgAck)
+	require.True(t, result.IsOK(), "Ack should be applied, log: %v", result.Log)
+	_, err := ck.GetLastCheckpoint(ctx, testBorChainID)
+	require.NoError(t, err)
+}
+
+// confirmationContractCaller records confirmations required for receipt, receipt is never confirmed
+type confirmationContractCaller struct {
+	helper.IContractCaller
+
+	requiredConfirmations []uint64
+}
+
+func (c *confirmationContractCaller) GetConfirmedTxReceipt(tx ethCommon.Hash, requiredConfirmations uint64) (*ethTypes.Receipt, error) {
+	c.requiredConfirmations = append(c.requiredConfirmations, requiredConfirmations)
+	return nil, errors.New("Not enough confirmations")
+}
+
+// tests ack receipt needs confirmations from checkpoint params
+func TestCheckpointAckConfirmations(t *testing.T) {
+	helper.SetTestConfig(helper.GetDefaultHeimdallConfig())
+
+	ctx, _, ck := CreateTestInput(t, false)
+	params := checkpointTypes.DefaultParams()
+	params.ConfirmationBlocks = 20
+	ck.SetParams(ctx, params)
+
+	caller := &confirmationContractCaller{}
+	proposer := GenRandomVal(1, 0, 10, 0, false, 1)[0].Signer
+	msgAck := checkpointTypes.NewMsgCheckpointAck(proposer, params.ChildBlockInterval, proposer, 0, 255, types.HexToHeimdallHash("0x01"), testBorChainID, types.HexToHeimdallHash("0x04"), 0, 100)
+
+	result := checkpoint.NewSideTxHandler(ck, caller)(ctx, msgAck)
+	require.Equal(t, types.SideTxResultSkip, result, "Unconfirmed ack should be skipped")
+	require.Equal(t, []uint64{20}, caller.requiredConfirmations)
+
+	// zero confirmations would disable reorg protection of all side handlers
+	genesisState := checkpointTypes.DefaultGenesisState()
+	genesisState.Params.ConfirmationBlocks = 0
+	require.Error(t, checkpointTypes.ValidateGenesis(genesisState), "Zero confirmation blocks should be rejected")
+}
+
+// createCheckpointSideTxTestInput creates checkpoint keeper with side tx keeper routing checkpoint side txs
+func createCheckpointSideTxTestInput(t *testing.T) (sdk.Context, staking.Keeper, checkpoint.Keeper, sidetx.Keeper) {
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+
+	keyCheckpoint := sdk.NewKVStoreKey(checkpointTypes.StoreKey)
+	keyStaking := sdk.NewKVStoreKey("staking")
+	keySideTx := sdk.NewKVStoreKey(sidetxTypes.StoreKey)
+	keyParams := sdk.NewKVStoreKey(subspace.StoreKey)
+	tKeyParams := sdk.NewTransientStoreKey(subspace.TStoreKey)
+
+	ms.MountStoreWithDB(keyCheckpoint, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyStaking, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keySideTx, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tKeyParams, sdk.StoreTypeTransient, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "foochainid", Height: 1}, false, log.NewNopLogger())
+	cdc := MakeTestCodec()
+	paramsKeeper := params.NewKeeper(cdc, keyParams, tKeyParams, common.DefaultCodespace)
+
+	ackRetriever := &testAckRetriever{}
+	stakingKeeper := staking.NewKeeper(cdc, keyStaking, paramsKeeper.Subspace(stakingTypes.DefaultParamspace), common.DefaultCodespace, ackRetriever)
+	checkpointKeeper := checkpoint.NewKeeper(cdc, keyCheckpoint, paramsKeeper.Subspace(checkpointTypes.DefaultParamspace), common.DefaultCodespace, stakingKeeper)
+	ackRetriever.checkpointKeeper = &checkpointKeeper
+
+	sideRouter := types.NewSideRouter()
+	sideRouter.AddRoute(checkpointTypes.RouterKey, &types.SideHandlers{
+		SideTxHandler: checkpoint.NewSideTxHandler(checkpointKeeper, nil),
+		PostTxHandler: checkpoint.NewPostTxHandler(checkpointKeeper, nil),
+	})
+	sideRouter.Seal()
+
+	sideTxKeeper := sidetx.NewKeeper(cdc, keySideTx, paramsKeeper.Subspace(sidetxTypes.DefaultParamspace), common.DefaultCodespace, stakingKeeper, sideRouter)
+	sideTxKeeper.SetParams(ctx, sidetxTypes.DefaultParams())
+
+	return ctx, stakingKeeper, checkpointKeeper, sideTxKeeper
+}
+
+// hasEvent checks if events contain event of given type
+func hasEvent(events sdk.Events, eventType string) bool {
+	for _, event := range events {
+		if event.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// tests checkpoint event which bridge dispatches to root chain is emitted only once validators approve checkpoint
+func TestCheckpointDispatchedAfterApproval(t *testing.T) {
+	helper.SetTestConfig(helper.GetDefaultHeimdallConfig())
+	blockTime := time.Unix(1500000000, 0).UTC()
+	txBytes := []byte("checkpoint-tx")
+
+	for _, approved := range []bool{false, true} {
+		ctx, sk, ck, sideTxKeeper := createCheckpointSideTxTestInput(t)
+		ctx = ctx.WithBlockTime(blockTime)
+		checkpoint.InitGenesis(ctx, ck, checkpointTypes.DefaultGenesisState())
+		validators := GenRandomVal(4, 0, 10, 0, false, 1)
+		loadValidators(t, sk, ctx, validators)
+		sk.IncrementAccum(ctx, 1)
+		for _, dividendAccount := range GenRandomDividendAccount(1, 1, true) {
+			require.NoError(t, sk.AddDividendAccount(ctx, dividendAccount))
+		}
+
+		accountRoot, err := checkpointTypes.GetAccountRootHash(sk.GetAllDividendAccounts(ctx))
+		require.NoError(t, err)
+		proposer := sk.GetValidatorSet(ctx).Proposer.Signer
+		msgCheckpoint := checkpointTypes.NewMsgCheckpointBlock(proposer, 0, 255, types.HexToHeimdallHash("0x01"), types.BytesToHeimdallHash(accountRoot), testBorChainID, uint64(blockTime.Unix()))
+
+		// checkpoint tx only waits for votes
+		result := sidetx.NewSideTxMsgHandler(sideTxKeeper, checkpoint.NewHandler(ck, nil))(ctx.WithTxBytes(txBytes), msgCheckpoint)
+		require.True(t, result.IsOK(), "Checkpoint should wait for votes, log: %v", result.Log)
+		require.False(t, hasEvent(result.Events, checkpointTypes.EventTypeCheckpoint), "Checkpoint should not be dispatched before votes")
+
+		vote := types.SideTxResultNo
+		if approved {
+			vote = types.SideTxResultYes
+		}
+
+		var events sdk.Events
+		txHash := types.BytesToHeimdallHash(tmhash.Sum(txBytes))
+		for _, validator := range validators[:3] {
+			result = sidetx.NewHandler(sideTxKeeper)(ctx, sidetxTypes.NewMsgSideTxVote(validator.Signer, txHash, vote))
+			if result.IsOK() {
+				events = append(events, result.Events...)
+			}
+		}
+
+		approvedTxHash, ok := sidetxTypes.GetApprovedTxHash(sdk.StringifyEvents(events.ToABCIEvents()), checkpointTypes.RouterKey)
+		_, bufferErr := ck.GetCheckpointFromBuffer(ctx, testBorChainID)
+		if !approved {
+			require.False(t, hasEvent(events, checkpointTypes.EventTypeCheckpoint), "Rejected checkpoint should never be dispatched")
+			require.False(t, ok, "Rejected checkpoint should not be approved")
+			require.Error(t, bufferErr, "Rejected checkpoint should not be buffered")
+			continue
+		}
+
+		require.True(t, hasEvent(events, checkpointTypes.EventTypeCheckpoint), "Approved checkpoint should be dispatched")
+		require.True(t, ok, "Approved checkpoint tx should be found in vote events")
+		require.Equal(t, txHash, approvedTxHash)
+		require.NoError(t, bufferErr, "Approved checkpoint should be buffered")
+	}
+}
+
+// tests checkpoint buffers, ack counts and headers are kept separately per bor chain
+func TestMultipleBorChains(t *testing.T) {
+	helper.SetTestConfig(helper.GetDefaultHeimdallConfig())
+	validators := GenRandomVal(4, 0, 10, 0, false, 1)
+	otherBorChainID := "80001"
+
+	ctx, sk, ck := CreateTestInput(t, false)
+	genesisState := checkpointTypes.DefaultGenesisState()
+	genesisState.BorChainIDs = append(genesisState.BorChainIDs, otherBorChainID)
+	require.NoError(t, checkpointTypes.ValidateGenesis(genesisState))
+	checkpoint.InitGenesis(ctx, ck, genesisState)
+	loadValidators(t, sk, ctx, validators)
+	childBlockInterval := ck.GetParams(ctx).ChildBlockInterval
+
+	require.Equal(t, testBorChainID, ck.GetPrimaryBorChainID(ctx))
+	require.True(t, ck.HasBorChainID(ctx, otherBorChainID))
+
+	// checkpoints of both chains are buffered together
+	proposer := validators[0].Signer
+	require.NoError(t, ck.SetCheckpointBuffer(ctx, types.CreateBlock(0, 255, types.HexToHeimdallHash("0x01"), types.HexToHeimdallHash("0x02"), proposer, testBorChainID, 0)))
+	require.NoError(t, ck.SetCheckpointBuffer(ctx, types.CreateBlock(0, 127, types.HexToHeimdallHash("0x03"), types.HexToHeimdallHash("0x02"), proposer, otherBorChainID, 0)))
+	require.Len(t, ck.GetCheckpointBuffers(ctx), 2)
+
+	// ack of other chain only affects other chain
+	msgAck := checkpointTypes.NewMsgCheckpointAck(proposer, childBlockInterval, proposer, 0, 127, types.HexToHeimdallHash("0x03"), otherBorChainID, types.HexToHeimdallHash("0x04"), 0, 0)
+	result := checkpoint.NewPostTxHandler(ck, nil)(ctx, msgAck)
+	require.True(t, result.IsOK(), "Ack should be applied, log: %v", result.Log)
+
+	require.Equal(t, uint64(1), ck.GetChainACKCount(ctx, otherBorChainID))
+	require.Equal(t, uint64(0), ck.GetACKCount(ctx), "Primary ack count should not change")
+
+	_, err := ck.GetCheckpointFromBuffer(ctx, testBorChainID)
+	require.NoError(t, err, "Buffer of primary chain should be kept")
+	_, err = ck.GetCheckpointFromBuffer(ctx, otherBorChainID)
+	require.Error(t, err, "Buffer of other chain should be flushed")
+
+	stored, err := ck.GetLastCheckpoint(ctx, otherBorChainID)
+	require.NoError(t, err)
+	require.Equal(t, otherBorChainID, stored.BorChainID)
+	_, err = ck.GetLastCheckpoint(ctx, testBorChainID)
+	require.Error(t, err, "Primary chain should have no checkpoint")
+
+	// ack and checkpoint of unknown chain are rejected
+	msgAck.BorChainID = "1"
+	require.False(t, checkpoint.NewPostTxHandler(ck, nil)(ctx, msgAck).IsOK(), "Ack of unknown chain should be rejected")
+
+	// exported state is loaded back per chain
+	exported := checkpoint.ExportGenesis(ctx, ck)
+	require.NoError(t, checkpointTypes.ValidateGenesis(exported))
+	require.Equal(t, uint64(1), exported.GetAckCount(otherBorChainID))
+	require.Len(t, exported.BufferedCheckpoints, 1)
+
+	ctx, _, ck = CreateTestInput(t, false)
+	checkpoint.InitGenesis(ctx, ck, exported)
+	require.Equal(t, uint64(1), ck.GetChainACKCount(ctx, otherBorChainID))
+	stored, err = ck.GetCheckpointByIndex(ctx, otherBorChainID, childBlockInterval)
+	require.NoError(t, err)
+	require.Equal(t, uint64(127), stored.EndBlock)
+}
+
+// tests proposer stats are exported and loaded back with genesis
+func TestProposerStatsGenesis(t *testing.T) {
+	helper.SetTestConfig(helper.GetDefaultHeimdallConfig())
+	validators := GenRandomVal(2, 0, 10, 0, false, 1)
+
+	ctx, _, ck := CreateTestInput(t, false)
+	ck.SetParams(ctx, checkpointTypes.DefaultParams())
+	ck.AddProposerCheckpoint(ctx, validators[0].ID, types.CreateBlock(0, 255, types.HexToHeimdallHash("0x01"), types.HexToHeimdallHash("0x02"), validators[0].Signer, testBorChainID, 0))
+	ck.AddProposerCheckpoint(ctx, validators[1].ID, types.CreateBlock(256, 383, types.HexToHeimdallHash("0x03"), types.HexToHeimdallHash("0x02"), validators[1].Signer, testBorChainID, 0))
+
+	exported := checkpoint.ExportGenesis(ctx, ck)
+	require.NoError(t, checkpointTypes.ValidateGenesis(exported))
+	require.Len(t, exported.ProposerStats, 2)
+
+	ctx, _, ck = CreateTestInput(t, false)
+	checkpoint.InitGenesis(ctx, ck, exported)
+	require.Equal(t, uint64(1), ck.GetProposerStats(ctx, validators[0].ID).CheckpointCount)
+	require.Equal(t, uint64(256), ck.GetProposerStats(ctx, validators[0].ID).BlockCount)
+	require.Equal(t, uint64(128), ck.GetProposerStats(ctx, validators[1].ID).BlockCount)
+
+	// duplicate and inconsistent stats are rejected
+	duplicate := exported
+	duplicate.ProposerStats = append(duplicate.ProposerStats, exported.ProposerStats[0])
+	require.Error(t, checkpointTypes.ValidateGenesis(duplicate), "Duplicate proposer stats should be rejected")
+
+	inconsistent := exported
+	inconsistent.ProposerStats = []checkpointTypes.ProposerStats{{ValidatorID: validators[0].ID, CheckpointCount: 2, BlockCount: 1}}
+	require.Error(t, checkpointTypes.ValidateGenesis(inconsistent), "Stats with fewer blocks than checkpoints should be rejected")
+}
+
+// tests checkpoint to submit is resolved from committed checkpoints and buffer
+func TestGetCheckpointForSubmit(t *testing.T) {
+	helper.SetTestConfig(helper.GetDefaultHeimdallConfig())
+	ctx, _, ck := CreateTestInput(t, false)
+	ck.SetParams(ctx, checkpointTypes.DefaultParams())
+	childBlockInterval := ck.GetParams(ctx).ChildBlockInterval
+
+	committed := types.CreateBlock(0, 255, types.HexToHeimdallHash("0x01"), types.HexToHeimdallHash("0x02"), types.HexToHeimdallAddress("0x03"), testBorChainID, 0)
+	require.NoError(t, ck.AddCheckpoint(ctx, childBlockInterval, committed))
+	ck.UpdateACKCount(ctx, testBorChainID)
+
+	buffered := types.CreateBlock(256, 511, types.HexToHeimdallHash("0x04"), types.HexToHeimdallHash("0x02"), types.HexToHeimdallAddress("0x03"), testBorChainID, 0)
+	require.NoError(t, ck.SetCheckpointBuffer(ctx, buffered))
+
+	checkpoint, err := ck.GetCheckpointForSubmit(ctx, testBorChainID, childBlockInterval)
+	require.NoError(t, err)
+	require.Equal(t, committed, checkpoint, "Committed checkpoint should be returned for acked header index")
+
+	checkpoint, err = ck.GetCheckpointForSubmit(ctx, testBorChainID, 2*childBlockInterval)
+	require.NoError(t, err)
+	require.Equal(t, buffered, checkpoint, "Buffered checkpoint should be returned for next header index")
+
+	_, err = ck.GetCheckpointForSubmit(ctx, testBorChainID, 3*childBlockInterval)
+	require.Error(t, err, "Future header index should not be found")
+}
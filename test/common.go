@@ -81,36 +81,58 @@ func CreateTestInput(t *testing.T, isCheckTx bool) (sdk.Context, staking.Keeper,
 	//pulp := MakeTestPulp()
 	paramsKeeper := params.NewKeeper(cdc, keyParams, tKeyParams, common.DefaultCodespace)
 
-	dummyStakingKeeper := staking.Keeper{}
+	// staking keeper reads ack count from checkpoint keeper (similar to app's cross communicator)
+	ackRetriever := &testAckRetriever{}
 
-	checkpointKeeper := checkpoint.NewKeeper(
+	stakingKeeper := staking.NewKeeper(
 		cdc,
-		keyCheckpoint,
-		paramsKeeper.Subspace(checkpointTypes.DefaultParamspace),
+		keyStaking,
+		paramsKeeper.Subspace(stakingTypes.DefaultParamspace),
 		common.DefaultCodespace,
-		dummyStakingKeeper,
+		ackRetriever,
 	)
 
-	stakingKeeper := staking.NewKeeper(
+	checkpointKeeper := checkpoint.NewKeeper(
 		cdc,
-		keyStaking,
-		paramsKeeper.Subspace(stakingTypes.DefaultParamspace),
+		keyCheckpoint,
+		paramsKeeper.Subspace(checkpointTypes.DefaultParamspace),
 		common.DefaultCodespace,
-		checkpointKeeper,
+		stakingKeeper,
 	)
+	ackRetriever.checkpointKeeper = &checkpointKeeper
+	checkpointKeeper.SetBorChainIDs(ctx, []string{helper.GetConfig().BorChainID})
+
 	return ctx, stakingKeeper, checkpointKeeper
 }
 
+// testAckRetriever retrieves ack count from checkpoint keeper
+type testAckRetriever struct {
+	checkpointKeeper *checkpoint.Keeper
+}
+
+// GetACKCount returns ack count
+func (r *testAckRetriever) GetACKCount(ctx sdk.Context) uint64 {
+	return r.checkpointKeeper.GetACKCount(ctx)
+}
+
+// GetConfirmationBlocks returns confirmations from checkpoint params, default if there is no checkpoint keeper
+func (r *testAckRetriever) GetConfirmationBlocks(ctx sdk.Context) uint64 {
+	if r.checkpointKeeper == nil {
+		return checkpointTypes.DefaultConfirmationBlocks
+	}
+	return r.checkpointKeeper.GetConfirmationBlocks(ctx)
+}
+
 // create random header block
 func GenRandCheckpointHeader(start int, headerSize int) (headerBlock types.CheckpointBlockHeader, err error) {
 	start = start
 	end := start + headerSize
-	roothash, err := checkpointTypes.GetHeaders(uint64(start), uint64(end))
+	roothash, err := checkpointTypes.GetHeaders(helper.GetConfig().BorChainID, uint64(start), uint64(end))
 	if err != nil {
 		return headerBlock, err
 	}
 	proposer := ethcmn.Address{}
-	headerBlock = types.CreateBlock(uint64(start), uint64(end), types.HexToHeimdallHash(hex.EncodeToString(roothash)), types.HexToHeimdallHash(hex.EncodeToString(roothash)), types.HexToHeimdallAddress(proposer.String()), uint64(time.Now().UTC().Unix()))
+	headerBlock = types.CreateBlock(uint64(start), uint64(end), types.HexToHeimdallHash(hex.EncodeToString(roothash)), types.HexToHeimdallHash(hex.EncodeToString(roothash)), types.HexToHeimdallAddress(proposer.String()), helper.GetConfig().BorChainID, uint64(time.Now().UTC().Unix()))
 
 	return headerBlock, nil
 }
@@ -137,6 +159,7 @@ func GenRandomVal(count int, startBlock uint64, power int64, timeAlive uint64, r
 			StartEpoch:       startBlock,
 			EndEpoch:         startBlock + timeAlive,
 			VotingPower:      power,
+			Amount:           types.NewIntFromBigInt(helper.GetAmountFromPower(power)),
 			Signer:           types.HexToHeimdallAddress(pubkey.Address().String()),
 			PubKey:           pubkey,
 			ProposerPriority: 0,
@@ -0,0 +1,70 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/maticnetwork/heimdall/checkpoint"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/helper"
+	"github.com/maticnetwork/heimdall/staking"
+	"github.com/maticnetwork/heimdall/types"
+)
+
+func TestStakingInvariants(t *testing.T) {
+	ctx, sk := createStakingTestInputWithAck(t, &staticAckRetriever{})
+	validators := GenRandomVal(4, 0, 10, 0, false, 1)
+	loadValidators(t, sk, ctx, validators)
+	staking.EndBlocker(ctx, sk)
+
+	registry := types.NewInvariantRegistry()
+	staking.RegisterInvariants(registry, sk)
+	require.Len(t, registry.Routes(), 2)
+	require.Empty(t, registry.CheckInvariants(ctx), "Invariants should hold after validator set update")
+
+	// validator power changed without validator set update
+	validator := validators[0]
+	validator.VotingPower = 20
+	require.NoError(t, sk.AddValidator(ctx, validator))
+	_, broken := staking.ValidatorSetInvariant(sk)(ctx)
+	require.True(t, broken, "Validator set should not match current validators")
+
+	staking.EndBlocker(ctx, sk)
+	_, broken = staking.ValidatorSetInvariant(sk)(ctx)
+	require.False(t, broken)
+
+	// validator id mapped to signer of other validator
+	sk.SetValidatorIDToSignerAddr(ctx, validators[1].ID, validators[2].Signer)
+	_, broken = staking.ValidatorMapInvariant(sk)(ctx)
+	require.True(t, broken, "Validator map should not point to other validator")
+	require.Panics(t, func() { registry.AssertInvariants(ctx) })
+}
+
+func TestCheckpointInvariants(t *testing.T) {
+	helper.SetTestConfig(helper.GetDefaultHeimdallConfig())
+	validators := GenRandomVal(4, 0, 10, 0, false, 1)
+
+	ctx, sk, ck := CreateTestInput(t, false)
+	checkpoint.InitGenesis(ctx, ck, checkpointTypes.DefaultGenesisState())
+	loadValidators(t, sk, ctx, validators)
+	childBlockInterval := ck.GetParams(ctx).ChildBlockInterval
+
+	_, broken := checkpoint.AckCountInvariant(ck)(ctx)
+	require.False(t, broken)
+
+	// acked checkpoint is stored as header
+	proposer := validators[0].Signer
+	require.NoError(t, ck.SetCheckpointBuffer(ctx, types.CreateBlock(0, 255, types.HexToHeimdallHash("0x01"), types.HexToHeimdallHash("0x02"), proposer, testBorChainID, 0)))
+	msgAck := checkpointTypes.NewMsgCheckpointAck(proposer, childBlockInterval, proposer, 0, 255, types.HexToHeimdallHash("0x01"), testBorChainID, types.HexToHeimdallHash("0x04"), 0, 0)
+	result := checkpoint.NewPostTxHandler(ck, nil)(ctx, msgAck)
+	require.True(t, result.IsOK(), "Ack should be applied, log: %v", result.Log)
+
+	_, broken = checkpoint.AckCountInvariant(ck)(ctx)
+	require.False(t, broken)
+
+	// ack count without header
+	ck.UpdateACKCount(ctx, testBorChainID)
+	_, broken = checkpoint.AckCountInvariant(ck)(ctx)
+	require.True(t, broken, "Ack count should not match stored headers")
+}
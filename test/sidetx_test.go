@@ -0,0 +1,209 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/params/subspace"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/sidetx"
+	sidetxTypes "github.com/maticnetwork/heimdall/sidetx/types"
+	"github.com/maticnetwork/heimdall/staking"
+	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
+	"github.com/maticnetwork/heimdall/types"
+)
+
+const testSideRoute = "testside"
+
+// testSideMsg is a side tx msg used to test side tx voting
+type testSideMsg struct {
+	Value uint64 `json:"value"`
+}
+
+func (msg testSideMsg) Route() string                { return testSideRoute }
+func (msg testSideMsg) Type() string                 { return "test-side" }
+func (msg testSideMsg) ValidateBasic() sdk.Error     { return nil }
+func (msg testSideMsg) GetSignBytes() []byte         { return nil }
+func (msg testSideMsg) GetSigners() []sdk.AccAddress { return nil }
+func (msg testSideMsg) IsSideTxMsg() bool            { return true }
+
+// create sidetx keeper with test side route which stores applied msg value in staking store
+func createSideTxTestInput(t *testing.T, applied *[]uint64) (sdk.Context, staking.Keeper, sidetx.Keeper) {
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+
+	keyStaking := sdk.NewKVStoreKey("staking")
+	keySideTx := sdk.NewKVStoreKey(sidetxTypes.StoreKey)
+	keyParams := sdk.NewKVStoreKey(subspace.StoreKey)
+	tKeyParams := sdk.NewTransientStoreKey(subspace.TStoreKey)
+
+	ms.MountStoreWithDB(keyStaking, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keySideTx, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tKeyParams, sdk.StoreTypeTransient, db)
+
+	err := ms.LoadLatestVersion()
+	require.Nil(t, err)
+
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "foochainid", Height: 1}, false, log.NewNopLogger())
+
+	cdc := codec.New()
+	codec.RegisterCrypto(cdc)
+	sdk.RegisterCodec(cdc)
+	stakingTypes.RegisterCodec(cdc)
+	sidetxTypes.RegisterCodec(cdc)
+	cdc.RegisterConcrete(testSideMsg{}, "heimdall/test/testSideMsg", nil)
+	cdc.Seal()
+
+	paramsKeeper := params.NewKeeper(cdc, keyParams, tKeyParams, common.DefaultCodespace)
+
+	stakingKeeper := staking.NewKeeper(
+		cdc,
+		keyStaking,
+		paramsKeeper.Subspace(stakingTypes.DefaultParamspace),
+		common.DefaultCodespace,
+		&testAckRetriever{},
+	)
+
+	sideRouter := types.NewSideRouter()
+	sideRouter.AddRoute(testSideRoute, &types.SideHandlers{
+		SideTxHandler: func(ctx sdk.Context, msg sdk.Msg) types.SideTxResult {
+			return types.SideTxResultYes
+		},
+		PostTxHandler: func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+			*applied = append(*applied, msg.(testSideMsg).Value)
+			return sdk.Result{}
+		},
+	})
+	sideRouter.Seal()
+
+	sideTxKeeper := sidetx.NewKeeper(
+		cdc,
+		keySideTx,
+		paramsKeeper.Subspace(sidetxTypes.DefaultParamspace),
+		common.DefaultCodespace,
+		stakingKeeper,
+		sideRouter,
+	)
+	sideTxKeeper.SetParams(ctx, sidetxTypes.DefaultParams())
+
+	return ctx, stakingKeeper, sideTxKeeper
+}
+
+// submit side tx msg and return its tx hash
+func submitSideTx(t *testing.T, ctx sdk.Context, k sidetx.Keeper, msg sdk.Msg, txBytes []byte) types.HeimdallHash {
+	handler := sidetx.NewSideTxMsgHandler(k, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		return sdk.Result{}
+	})
+
+	result := handler(ctx.WithTxBytes(txBytes), msg)
+	require.True(t, result.IsOK(), "Side tx should be stored as pending, log: %v", result.Log)
+	return types.BytesToHeimdallHash(tmhash.Sum(txBytes))
+}
+
+func TestSideTxPending(t *testing.T) {
+	var applied []uint64
+	ctx, _, sideTxKeeper := createSideTxTestInput(t, &applied)
+
+	txHash := submitSideTx(t, ctx, sideTxKeeper, testSideMsg{Value: 1}, []byte("tx-1"))
+
+	pendingTx, err := sideTxKeeper.GetPendingSideTx(ctx, txHash)
+	require.Nil(t, err)
+	require.Equal(t, testSideMsg{Value: 1}, pendingTx.Msg)
+	require.Empty(t, applied, "Side tx should not be applied before votes")
+
+	// same tx can't be submitted twice
+	handler := sidetx.NewSideTxMsgHandler(sideTxKeeper, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		return sdk.Result{}
+	})
+	result := handler(ctx.WithTxBytes([]byte("tx-1")).WithBlockHeight(ctx.BlockHeight()+1), testSideMsg{Value: 1})
+	require.False(t, result.IsOK(), "Duplicate side tx should fail")
+	require.Equal(t, common.ErrOldTx(sideTxKeeper.Codespace()).Code(), result.Code)
+
+	// second side tx msg of same tx is rejected and doesn't replace first one
+	result = handler(ctx.WithTxBytes([]byte("tx-1")), testSideMsg{Value: 2})
+	require.False(t, result.IsOK(), "Second side tx msg in tx should fail")
+	require.Equal(t, sdk.CodeType(sidetxTypes.CodeSideTxMultipleMsgs), result.Code)
+
+	pendingTx, err = sideTxKeeper.GetPendingSideTx(ctx, txHash)
+	require.Nil(t, err)
+	require.Equal(t, testSideMsg{Value: 1}, pendingTx.Msg)
+}
+
+func TestSideTxVotes(t *testing.T) {
+	var applied []uint64
+	ctx, stakingKeeper, sideTxKeeper := createSideTxTestInput(t, &applied)
+	valSet := LoadValidatorSet(4, t, stakingKeeper, ctx, false, 10)
+	handler := sidetx.NewHandler(sideTxKeeper)
+
+	t.Run("Approved", func(t *testing.T) {
+		txHash := submitSideTx(t, ctx, sideTxKeeper, testSideMsg{Value: 2}, []byte("tx-2"))
+
+		for i, validator := range valSet.Validators[:3] {
+			result := handler(ctx, sidetxTypes.NewMsgSideTxVote(validator.Signer, txHash, types.SideTxResultYes))
+			require.True(t, result.IsOK(), "Vote should succeed, log: %v", result.Log)
+
+			if i < 2 {
+				// 2/4 validators are not enough
+				require.True(t, sideTxKeeper.HasPendingSideTx(ctx, txHash))
+				require.Empty(t, applied)
+
+				// validator can't vote twice
+				result = handler(ctx, sidetxTypes.NewMsgSideTxVote(validator.Signer, txHash, types.SideTxResultYes))
+				require.False(t, result.IsOK(), "Second vote should fail")
+			}
+		}
+
+		require.False(t, sideTxKeeper.HasPendingSideTx(ctx, txHash))
+		require.Equal(t, []uint64{2}, applied)
+	})
+
+	t.Run("Rejected", func(t *testing.T) {
+		applied = nil
+		txHash := submitSideTx(t, ctx, sideTxKeeper, testSideMsg{Value: 3}, []byte("tx-3"))
+
+		for _, validator := range valSet.Validators[:2] {
+			result := handler(ctx, sidetxTypes.NewMsgSideTxVote(validator.Signer, txHash, types.SideTxResultNo))
+			require.True(t, result.IsOK(), "Vote should succeed, log: %v", result.Log)
+		}
+
+		require.False(t, sideTxKeeper.HasPendingSideTx(ctx, txHash))
+		require.Empty(t, applied)
+	})
+
+	t.Run("NonValidator", func(t *testing.T) {
+		txHash := submitSideTx(t, ctx, sideTxKeeper, testSideMsg{Value: 4}, []byte("tx-4"))
+
+		result := handler(ctx, sidetxTypes.NewMsgSideTxVote(types.HexToHeimdallAddress("0x01"), txHash, types.SideTxResultYes))
+		require.False(t, result.IsOK(), "Vote from non-validator should fail")
+	})
+}
+
+func TestSideTxExpiry(t *testing.T) {
+	var applied []uint64
+	ctx, _, sideTxKeeper := createSideTxTestInput(t, &applied)
+
+	txHash := submitSideTx(t, ctx, sideTxKeeper, testSideMsg{Value: 5}, []byte("tx-5"))
+	voteWindow := int64(sideTxKeeper.GetParams(ctx).VoteWindow)
+
+	sidetx.EndBlocker(ctx.WithBlockHeight(ctx.BlockHeight()+voteWindow-1), sideTxKeeper)
+	require.True(t, sideTxKeeper.HasPendingSideTx(ctx, txHash))
+
+	sidetx.EndBlocker(ctx.WithBlockHeight(ctx.BlockHeight()+voteWindow), sideTxKeeper)
+	require.False(t, sideTxKeeper.HasPendingSideTx(ctx, txHash))
+	require.Empty(t, applied)
+
+	// module end block expires side txs as well
+	txHash = submitSideTx(t, ctx, sideTxKeeper, testSideMsg{Value: 6}, []byte("tx-6"))
+	sidetx.NewAppModule(sideTxKeeper).EndBlock(ctx.WithBlockHeight(ctx.BlockHeight()+voteWindow), abci.RequestEndBlock{})
+	require.False(t, sideTxKeeper.HasPendingSideTx(ctx, txHash))
+}
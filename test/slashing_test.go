@@ -0,0 +1,283 @@
+package test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/params/subspace"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmTypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/helper"
+	"github.com/maticnetwork/heimdall/slashing"
+	slashingTypes "github.com/maticnetwork/heimdall/slashing/types"
+	"github.com/maticnetwork/heimdall/staking"
+	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
+	"github.com/maticnetwork/heimdall/types"
+)
+
+// create slashing keeper with staking keeper at given block height
+func createSlashingTestInput(t *testing.T, height int64) (sdk.Context, staking.Keeper, slashing.Keeper) {
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+
+	keyStaking := sdk.NewKVStoreKey(stakingTypes.StoreKey)
+	keySlashing := sdk.NewKVStoreKey(slashingTypes.StoreKey)
+	keyParams := sdk.NewKVStoreKey(subspace.StoreKey)
+	tKeyParams := sdk.NewTransientStoreKey(subspace.TStoreKey)
+
+	ms.MountStoreWithDB(keyStaking, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keySlashing, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tKeyParams, sdk.StoreTypeTransient, db)
+
+	err := ms.LoadLatestVersion()
+	require.Nil(t, err)
+
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "foochainid", Height: height}, false, log.NewNopLogger())
+
+	cdc := codec.New()
+	codec.RegisterCrypto(cdc)
+	sdk.RegisterCodec(cdc)
+	stakingTypes.RegisterCodec(cdc)
+	slashingTypes.RegisterCodec(cdc)
+	cdc.Seal()
+
+	paramsKeeper := params.NewKeeper(cdc, keyParams, tKeyParams, common.DefaultCodespace)
+
+	stakingKeeper := staking.NewKeeper(
+		cdc,
+		keyStaking,
+		paramsKeeper.Subspace(stakingTypes.DefaultParamspace),
+		common.DefaultCodespace,
+		&testAckRetriever{},
+	)
+
+	slashingKeeper := slashing.NewKeeper(
+		cdc,
+		keySlashing,
+		paramsKeeper.Subspace(slashingTypes.DefaultParamspace),
+		common.DefaultCodespace,
+		stakingKeeper,
+	)
+	slashingKeeper.SetParams(ctx, slashingTypes.DefaultParams())
+
+	return ctx, stakingKeeper, slashingKeeper
+}
+
+// create begin block request with double sign evidence of validator
+func doubleSignRequest(validator types.Validator, height int64) abci.RequestBeginBlock {
+	return abci.RequestBeginBlock{
+		ByzantineValidators: []abci.Evidence{
+			{
+				Type:      tmTypes.ABCIEvidenceTypeDuplicateVote,
+				Validator: abci.Validator{Address: validator.Signer.Bytes(), Power: validator.VotingPower},
+				Height:    height,
+			},
+		},
+	}
+}
+
+// create begin block request with last commit votes of validators
+func livenessRequest(validators []types.Validator, signed bool) abci.RequestBeginBlock {
+	votes := make([]abci.VoteInfo, 0, len(validators))
+	for _, validator := range validators {
+		votes = append(votes, abci.VoteInfo{
+			Validator:       abci.Validator{Address: validator.Signer.Bytes(), Power: validator.VotingPower},
+			SignedLastBlock: signed,
+		})
+	}
+
+	return abci.RequestBeginBlock{
+		LastCommitInfo: abci.LastCommitInfo{Votes: votes},
+	}
+}
+
+func TestDoubleSignSlashing(t *testing.T) {
+	validators := GenRandomVal(4, 0, 10, 0, false, 1)
+	ctx, sk, slk := createSlashingTestInput(t, 100)
+	loadValidators(t, sk, ctx, validators)
+	for _, validator := range validators {
+		require.NoError(t, sk.AddDividendAccount(ctx, types.NewDividendAccount(types.DividendAccountID(validator.ID), "0", "0")))
+	}
+
+	accountRoot, err := checkpointTypes.GetAccountRootHash(sk.GetAllDividendAccounts(ctx))
+	require.NoError(t, err)
+
+	offender := validators[0]
+	slashing.BeginBlocker(ctx, doubleSignRequest(offender, 90), slk)
+
+	// 5% of stake is slashed
+	expectedAmount := new(big.Int).Div(helper.GetAmountFromPower(offender.VotingPower), big.NewInt(20))
+	dividendAccount, err := sk.GetDividendAccountByID(ctx, types.DividendAccountID(offender.ID))
+	require.NoError(t, err)
+	require.Equal(t, expectedAmount.String(), dividendAccount.SlashedAmount)
+
+	// slash is part of account root submitted with checkpoint
+	newAccountRoot, err := checkpointTypes.GetAccountRootHash(sk.GetAllDividendAccounts(ctx))
+	require.NoError(t, err)
+	require.NotEqual(t, accountRoot, newAccountRoot, "Account root should include slashed amount")
+
+	// validator is jailed and removed from validator set on update
+	jailed, err := sk.GetValidatorInfo(ctx, offender.Signer.Bytes())
+	require.NoError(t, err)
+	require.True(t, jailed.Jailed)
+	require.False(t, jailed.IsCurrentValidator(0))
+
+	currentValidatorSet := sk.GetValidatorSet(ctx)
+	updates := helper.GetUpdatedValidators(&currentValidatorSet, sk.GetAllValidators(ctx), 0)
+	require.Len(t, updates, 1)
+	require.Equal(t, offender.ID, updates[0].ID)
+	require.Equal(t, int64(0), updates[0].VotingPower)
+
+	slashes := slk.GetValidatorSlashes(ctx, offender.ID)
+	require.Len(t, slashes, 1)
+	require.Equal(t, int64(90), slashes[0].InfractionHeight)
+	require.Equal(t, slashingTypes.SlashReasonDoubleSign, slashes[0].Reason)
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, slashingTypes.EventTypeSlash, events[0].Type)
+
+	// same infraction is slashed only once
+	slashing.BeginBlocker(ctx, doubleSignRequest(offender, 90), slk)
+	dividendAccount, err = sk.GetDividendAccountByID(ctx, types.DividendAccountID(offender.ID))
+	require.NoError(t, err)
+	require.Equal(t, expectedAmount.String(), dividendAccount.SlashedAmount)
+	require.Len(t, slk.GetAllValidatorSlashes(ctx), 1)
+
+	// exported slashes are valid genesis
+	exported := slashing.ExportGenesis(ctx, slk)
+	require.NoError(t, slashingTypes.ValidateGenesis(exported))
+	require.Len(t, exported.Slashes, 1)
+}
+
+func TestDoubleSignEvidenceIgnored(t *testing.T) {
+	validators := GenRandomVal(4, 0, 10, 0, false, 1)
+	ctx, sk, slk := createSlashingTestInput(t, slashingTypes.DefaultMaxEvidenceAge+100)
+	loadValidators(t, sk, ctx, validators)
+
+	// evidence older than max age
+	slashing.BeginBlocker(ctx, doubleSignRequest(validators[0], 50), slk)
+
+	// evidence of unknown validator
+	unknown := GenRandomVal(1, 0, 10, 0, false, 10)[0]
+	slashing.BeginBlocker(ctx, doubleSignRequest(unknown, ctx.BlockHeight()-1), slk)
+
+	require.Empty(t, slk.GetAllValidatorSlashes(ctx))
+	require.Empty(t, sk.GetAllDividendAccounts(ctx))
+
+	validator, err := sk.GetValidatorInfo(ctx, validators[0].Signer.Bytes())
+	require.NoError(t, err)
+	require.False(t, validator.Jailed)
+}
+
+func TestLivenessJailing(t *testing.T) {
+	validators := GenRandomVal(4, 0, 10, 0, false, 1)
+	ctx, sk, slk := createSlashingTestInput(t, 1)
+	loadValidators(t, sk, ctx, validators)
+
+	params := slk.GetParams(ctx)
+	offender := validators[0]
+	blockTime := time.Unix(1000, 0).UTC()
+
+	// validator misses every block of first window while others sign
+	height := int64(1)
+	for ; height <= params.SignedBlocksWindow; height++ {
+		ctx = ctx.WithBlockHeight(height).WithBlockTime(blockTime)
+		slashing.BeginBlocker(ctx, livenessRequest(validators[1:], true), slk)
+		slashing.BeginBlocker(ctx, livenessRequest(validators[:1], false), slk)
+	}
+
+	// validator is not jailed before full window is tracked
+	validator, err := sk.GetValidatorInfo(ctx, offender.Signer.Bytes())
+	require.NoError(t, err)
+	require.False(t, validator.Jailed)
+
+	signInfo, found := slk.GetValidatorSigningInfo(ctx, offender.ID)
+	require.True(t, found)
+	require.Equal(t, params.SignedBlocksWindow, signInfo.MissedBlocksCounter)
+
+	ctx = ctx.WithBlockHeight(height + 1).WithBlockTime(blockTime)
+	slashing.BeginBlocker(ctx, livenessRequest(validators[:1], false), slk)
+
+	validator, err = sk.GetValidatorInfo(ctx, offender.Signer.Bytes())
+	require.NoError(t, err)
+	require.True(t, validator.Jailed)
+
+	signInfo, _ = slk.GetValidatorSigningInfo(ctx, offender.ID)
+	require.Equal(t, blockTime.Add(params.DowntimeJailDuration), signInfo.JailedUntil)
+	require.Equal(t, int64(0), signInfo.MissedBlocksCounter)
+	require.Empty(t, slk.GetValidatorMissedBlocks(ctx, offender.ID))
+
+	// validators signing blocks are not jailed
+	for _, v := range validators[1:] {
+		info, found := slk.GetValidatorSigningInfo(ctx, v.ID)
+		require.True(t, found)
+		require.Equal(t, int64(0), info.MissedBlocksCounter)
+	}
+
+	// jailed validator is removed from validator set on update
+	currentValidatorSet := sk.GetValidatorSet(ctx)
+	updates := helper.GetUpdatedValidators(&currentValidatorSet, sk.GetAllValidators(ctx), 0)
+	require.Len(t, updates, 1)
+	require.Equal(t, offender.ID, updates[0].ID)
+	require.Equal(t, int64(0), updates[0].VotingPower)
+
+	// exported signing infos are valid genesis
+	exported := slashing.ExportGenesis(ctx, slk)
+	require.NoError(t, slashingTypes.ValidateGenesis(exported))
+	require.Len(t, exported.SigningInfos, len(validators))
+
+	handler := slashing.NewHandler(slk)
+
+	// unjail is rejected if not sent by validator signer
+	result := handler(ctx, slashingTypes.NewMsgUnjail(validators[1].Signer, offender.ID.Uint64()))
+	require.False(t, result.IsOK(), "Unjail from other address should fail")
+
+	// unjail is rejected before jail duration is over
+	result = handler(ctx, slashingTypes.NewMsgUnjail(offender.Signer, offender.ID.Uint64()))
+	require.False(t, result.IsOK(), "Unjail before jail duration should fail")
+
+	ctx = ctx.WithBlockTime(blockTime.Add(params.DowntimeJailDuration))
+	result = handler(ctx, slashingTypes.NewMsgUnjail(offender.Signer, offender.ID.Uint64()))
+	require.True(t, result.IsOK(), "Unjail after jail duration should succeed")
+
+	validator, err = sk.GetValidatorInfo(ctx, offender.Signer.Bytes())
+	require.NoError(t, err)
+	require.False(t, validator.Jailed)
+
+	// unjail of validator which is not jailed fails
+	result = handler(ctx, slashingTypes.NewMsgUnjail(offender.Signer, offender.ID.Uint64()))
+	require.False(t, result.IsOK(), "Unjail of active validator should fail")
+}
+
+func TestTombstonedValidatorUnjail(t *testing.T) {
+	validators := GenRandomVal(4, 0, 10, 0, false, 1)
+	ctx, sk, slk := createSlashingTestInput(t, 100)
+	loadValidators(t, sk, ctx, validators)
+	for _, validator := range validators {
+		require.NoError(t, sk.AddDividendAccount(ctx, types.NewDividendAccount(types.DividendAccountID(validator.ID), "0", "0")))
+	}
+
+	offender := validators[0]
+	slashing.BeginBlocker(ctx, doubleSignRequest(offender, 90), slk)
+
+	ctx = ctx.WithBlockTime(time.Now().Add(24 * time.Hour))
+	result := slashing.NewHandler(slk)(ctx, slashingTypes.NewMsgUnjail(offender.Signer, offender.ID.Uint64()))
+	require.False(t, result.IsOK(), "Tombstoned validator should not unjail")
+
+	validator, err := sk.GetValidatorInfo(ctx, offender.Signer.Bytes())
+	require.NoError(t, err)
+	require.True(t, validator.Jailed)
+}
@@ -0,0 +1,760 @@
+package test
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/params/subspace"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	ethCommon "github.com/maticnetwork/bor/common"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/contracts/stakinginfo"
+	"github.com/maticnetwork/heimdall/helper"
+	"github.com/maticnetwork/heimdall/staking"
+	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
+	"github.com/maticnetwork/heimdall/types"
+)
+
+// staticAckRetriever returns ack count and confirmations set by test
+type staticAckRetriever struct {
+	ackCount           uint64
+	confirmationBlocks uint64
+}
+
+// GetACKCount returns ack count
+func (r *staticAckRetriever) GetACKCount(ctx sdk.Context) uint64 {
+	return r.ackCount
+}
+
+// GetConfirmationBlocks returns confirmations
+func (r *staticAckRetriever) GetConfirmationBlocks(ctx sdk.Context) uint64 {
+	return r.confirmationBlocks
+}
+
+// create staking keeper without heimdall config
+func createStakingTestInput(t *testing.T) (sdk.Context, staking.Keeper) {
+	return createStakingTestInputWithAck(t, &testAckRetriever{})
+}
+
+// create staking keeper without heimdall config using given ack retriever
+func createStakingTestInputWithAck(t *testing.T, ackRetriever staking.AckRetriever) (sdk.Context, staking.Keeper) {
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+
+	keyStaking := sdk.NewKVStoreKey(stakingTypes.StoreKey)
+	keyParams := sdk.NewKVStoreKey(subspace.StoreKey)
+	tKeyParams := sdk.NewTransientStoreKey(subspace.TStoreKey)
+
+	ms.MountStoreWithDB(keyStaking, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tKeyParams, sdk.StoreTypeTransient, db)
+
+	err := ms.LoadLatestVersion()
+	require.Nil(t, err)
+
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "foochainid"}, false, log.NewNopLogger())
+
+	cdc := codec.New()
+	codec.RegisterCrypto(cdc)
+	sdk.RegisterCodec(cdc)
+	stakingTypes.RegisterCodec(cdc)
+	cdc.Seal()
+
+	paramsKeeper := params.NewKeeper(cdc, keyParams, tKeyParams, common.DefaultCodespace)
+
+	stakingKeeper := staking.NewKeeper(
+		cdc,
+		keyStaking,
+		paramsKeeper.Subspace(stakingTypes.DefaultParamspace),
+		common.DefaultCodespace,
+		ackRetriever,
+	)
+	stakingKeeper.SetParams(ctx, stakingTypes.DefaultParams())
+
+	return ctx, stakingKeeper
+}
+
+func TestValidatorRestake(t *testing.T) {
+	ctx, sk := createStakingTestInput(t)
+	validators := GenRandomVal(4, 0, 10, 0, false, 1)
+	loadValidators(t, sk, ctx, validators)
+
+	validator := validators[0]
+	msg := stakingTypes.NewMsgValidatorRestake(
+		validator.Signer,
+		validator.ID.Uint64(),
+		types.NewIntFromBigInt(helper.GetAmountFromPower(25)),
+		types.HexToHeimdallHash("0x01"),
+		1,
+		100,
+	)
+
+	result := staking.PostHandleMsgValidatorRestake(ctx, msg, sk)
+	require.True(t, result.IsOK(), "Restake should update validator")
+
+	updated, ok := sk.GetValidatorFromValID(ctx, validator.ID)
+	require.True(t, ok)
+	require.Equal(t, int64(25), updated.VotingPower)
+
+	// restaked power is part of next validator set update
+	currentValidatorSet := sk.GetValidatorSet(ctx)
+	updates := helper.GetUpdatedValidators(&currentValidatorSet, sk.GetAllValidators(ctx), 0)
+	require.Len(t, updates, 1)
+	require.Equal(t, int64(25), updates[0].VotingPower)
+
+	// same root chain log is processed only once
+	result = staking.HandleMsgValidatorRestake(ctx, msg, sk)
+	require.False(t, result.IsOK(), "Restake replay should fail")
+}
+
+// restakeContractCaller serves confirmed receipt and restaked event from memory
+type restakeContractCaller struct {
+	helper.IContractCaller
+
+	receipt               *ethTypes.Receipt
+	event                 *stakinginfo.StakinginfoReStaked
+	requiredConfirmations uint64
+}
+
+func (c *restakeContractCaller) GetConfirmedTxReceipt(tx ethCommon.Hash, requiredConfirmations uint64) (*ethTypes.Receipt, error) {
+	c.requiredConfirmations = requiredConfirmations
+	return c.receipt, nil
+}
+
+func (c *restakeContractCaller) DecodeValidatorReStakedEvent(*ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoReStaked, error) {
+	return c.event, nil
+}
+
+func TestSideHandleValidatorRestake(t *testing.T) {
+	ctx, sk := createStakingTestInput(t)
+	validators := GenRandomVal(4, 0, 10, 0, false, 1)
+	loadValidators(t, sk, ctx, validators)
+
+	// total in event is stake of whole network after restake
+	validator := validators[0]
+	amount := helper.GetAmountFromPower(25)
+	caller := &restakeContractCaller{
+		receipt: &ethTypes.Receipt{BlockNumber: big.NewInt(100)},
+		event: &stakinginfo.StakinginfoReStaked{
+			ValidatorId: new(big.Int).SetUint64(validator.ID.Uint64()),
+			Amount:      amount,
+			Total:       helper.GetAmountFromPower(55),
+		},
+	}
+
+	newRestakeMsg := func(newAmount *big.Int) stakingTypes.MsgValidatorRestake {
+		return stakingTypes.NewMsgValidatorRestake(
+			validator.Signer,
+			validator.ID.Uint64(),
+			types.NewIntFromBigInt(newAmount),
+			types.HexToHeimdallHash("0x01"),
+			1,
+			100,
+		)
+	}
+
+	// validator amount is approved, network total is not
+	require.Equal(t, types.SideTxResultYes, staking.SideHandleMsgValidatorRestake(ctx, newRestakeMsg(amount), sk, caller))
+	require.Equal(t, types.SideTxResultNo, staking.SideHandleMsgValidatorRestake(ctx, newRestakeMsg(caller.event.Total), sk, caller))
+
+	// receipt needs confirmations from checkpoint params
+	require.Equal(t, checkpointTypes.DefaultConfirmationBlocks, caller.requiredConfirmations)
+
+	result := staking.PostHandleMsgValidatorRestake(ctx, newRestakeMsg(amount), sk)
+	require.True(t, result.IsOK(), result.Log)
+
+	updated, ok := sk.GetValidatorFromValID(ctx, validator.ID)
+	require.True(t, ok)
+	require.Equal(t, int64(25), updated.VotingPower)
+}
+
+func TestValidatorJailed(t *testing.T) {
+	ctx, sk := createStakingTestInput(t)
+	validators := GenRandomVal(4, 0, 10, 0, false, 1)
+	loadValidators(t, sk, ctx, validators)
+
+	validator := validators[0]
+	msg := stakingTypes.NewMsgValidatorJailed(
+		validator.Signer,
+		validator.ID.Uint64(),
+		2,
+		types.HexToHeimdallHash("0x01"),
+		1,
+		100,
+	)
+
+	result := staking.PostHandleMsgValidatorJailed(ctx, msg, sk)
+	require.True(t, result.IsOK(), "Jailed should update validator")
+
+	updated, ok := sk.GetValidatorFromValID(ctx, validator.ID)
+	require.True(t, ok)
+	require.Equal(t, uint64(2), updated.EndEpoch)
+
+	// validator stays in set until exit epoch
+	currentValidatorSet := sk.GetValidatorSet(ctx)
+	require.Empty(t, helper.GetUpdatedValidators(&currentValidatorSet, sk.GetAllValidators(ctx), 0))
+
+	// validator is removed once ack count passes exit epoch
+	updates := helper.GetUpdatedValidators(&currentValidatorSet, sk.GetAllValidators(ctx), 2)
+	require.Len(t, updates, 1)
+	require.Equal(t, validator.ID, updates[0].ID)
+	require.Equal(t, int64(0), updates[0].VotingPower)
+
+	// same root chain log is processed only once
+	result = staking.HandleMsgValidatorJailed(ctx, msg, sk)
+	require.False(t, result.IsOK(), "Jailed replay should fail")
+}
+
+func TestDelegation(t *testing.T) {
+	ctx, sk := createStakingTestInput(t)
+	validators := GenRandomVal(4, 0, 10, 0, false, 1)
+	loadValidators(t, sk, ctx, validators)
+
+	validator := validators[0]
+	delegator := types.HexToHeimdallAddress("0x0000000000000000000000000000000000000abc")
+
+	delegate := stakingTypes.NewMsgDelegate(
+		validator.Signer,
+		validator.ID.Uint64(),
+		delegator,
+		types.NewIntFromBigInt(helper.GetAmountFromPower(5)),
+		types.NewIntFromBigInt(helper.GetAmountFromPower(5)),
+		types.HexToHeimdallHash("0x01"),
+		1,
+		100,
+	)
+	result := staking.PostHandleMsgDelegate(ctx, delegate, sk)
+	require.True(t, result.IsOK(), "Delegate should add delegation")
+
+	// voting power includes delegated stake
+	updated, ok := sk.GetValidatorFromValID(ctx, validator.ID)
+	require.True(t, ok)
+	require.Equal(t, int64(15), updated.VotingPower)
+
+	delegations := sk.GetValidatorDelegations(ctx, validator.ID)
+	require.Len(t, delegations, 1)
+	require.Equal(t, delegator, delegations[0].Delegator)
+	require.Equal(t, helper.GetAmountFromPower(5).String(), delegations[0].Amount.String())
+	require.Len(t, sk.GetDelegatorDelegations(ctx, delegator), 1)
+
+	// stake update keeps delegated power
+	stakeUpdate := stakingTypes.NewMsgStakeUpdate(
+		validator.Signer,
+		validator.ID.Uint64(),
+		types.NewIntFromBigInt(helper.GetAmountFromPower(20)),
+		types.HexToHeimdallHash("0x02"),
+		2,
+		100,
+	)
+	result = staking.PostHandleMsgStakeUpdate(ctx, stakeUpdate, sk)
+	require.True(t, result.IsOK(), "Stake update should succeed")
+	updated, _ = sk.GetValidatorFromValID(ctx, validator.ID)
+	require.Equal(t, int64(25), updated.VotingPower)
+
+	// burning more shares than delegated fails
+	undelegate := stakingTypes.NewMsgUndelegate(
+		validator.Signer,
+		validator.ID.Uint64(),
+		delegator,
+		types.NewIntFromBigInt(helper.GetAmountFromPower(6)),
+		types.NewIntFromBigInt(helper.GetAmountFromPower(6)),
+		types.HexToHeimdallHash("0x03"),
+		3,
+		100,
+	)
+	result = staking.HandleMsgUndelegate(ctx, undelegate, sk)
+	require.False(t, result.IsOK(), "Undelegate above delegation should fail")
+
+	// undelegating everything removes delegation and delegated power
+	undelegate.Shares = types.NewIntFromBigInt(helper.GetAmountFromPower(5))
+	undelegate.Amount = types.NewIntFromBigInt(helper.GetAmountFromPower(5))
+	result = staking.PostHandleMsgUndelegate(ctx, undelegate, sk)
+	require.True(t, result.IsOK(), "Undelegate should remove delegation")
+
+	updated, _ = sk.GetValidatorFromValID(ctx, validator.ID)
+	require.Equal(t, int64(20), updated.VotingPower)
+	require.Empty(t, sk.GetValidatorDelegations(ctx, validator.ID))
+	require.Empty(t, sk.GetDelegatorDelegations(ctx, delegator))
+
+	// same root chain log is processed only once
+	result = staking.HandleMsgDelegate(ctx, delegate, sk)
+	require.False(t, result.IsOK(), "Delegate replay should fail")
+}
+
+func TestStartAuction(t *testing.T) {
+	ctx, sk := createStakingTestInput(t)
+	validators := GenRandomVal(4, 0, 10, 0, false, 1)
+	loadValidators(t, sk, ctx, validators)
+
+	validator := validators[0]
+	msg := stakingTypes.NewMsgStartAuction(
+		validator.Signer,
+		validator.ID.Uint64(),
+		types.NewIntFromBigInt(helper.GetAmountFromPower(10)),
+		types.NewIntFromBigInt(helper.GetAmountFromPower(15)),
+		types.HexToHeimdallHash("0x01"),
+		1,
+		100,
+	)
+
+	result := staking.PostHandleMsgStartAuction(ctx, msg, sk)
+	require.True(t, result.IsOK(), "Start auction should store auction")
+
+	auction, ok := sk.GetAuction(ctx, validator.ID)
+	require.True(t, ok)
+	require.Equal(t, msg.AuctionAmount, auction.AuctionAmount)
+	require.Equal(t, uint64(100*types.DefaultLogIndexUnit+1), auction.LastUpdated)
+
+	// higher bid replaces auction
+	msg = stakingTypes.NewMsgStartAuction(
+		validator.Signer,
+		validator.ID.Uint64(),
+		types.NewIntFromBigInt(helper.GetAmountFromPower(10)),
+		types.NewIntFromBigInt(helper.GetAmountFromPower(20)),
+		types.HexToHeimdallHash("0x02"),
+		1,
+		101,
+	)
+	result = staking.PostHandleMsgStartAuction(ctx, msg, sk)
+	require.True(t, result.IsOK())
+
+	auction, ok = sk.GetAuction(ctx, validator.ID)
+	require.True(t, ok)
+	require.Equal(t, msg.AuctionAmount, auction.AuctionAmount)
+	require.Len(t, sk.GetAllAuctions(ctx), 1)
+
+	// same root chain log is processed only once
+	result = staking.HandleMsgStartAuction(ctx, msg, sk)
+	require.False(t, result.IsOK(), "Start auction replay should fail")
+}
+
+func TestConfirmAuction(t *testing.T) {
+	ctx, sk := createStakingTestInput(t)
+	validators := GenRandomVal(4, 0, 10, 0, false, 1)
+	loadValidators(t, sk, ctx, validators)
+
+	oldValidator := validators[0]
+	newValidator := GenRandomVal(1, 0, 20, 0, false, 5)[0]
+
+	require.True(t, staking.PostHandleMsgStartAuction(ctx, stakingTypes.NewMsgStartAuction(
+		newValidator.Signer,
+		oldValidator.ID.Uint64(),
+		types.NewIntFromBigInt(helper.GetAmountFromPower(10)),
+		types.NewIntFromBigInt(helper.GetAmountFromPower(20)),
+		types.HexToHeimdallHash("0x01"),
+		1,
+		100,
+	), sk).IsOK())
+
+	msg := stakingTypes.NewMsgConfirmAuction(
+		newValidator.Signer,
+		oldValidator.ID.Uint64(),
+		newValidator.ID.Uint64(),
+		3,
+		types.NewIntFromBigInt(helper.GetAmountFromPower(20)),
+		newValidator.PubKey,
+		types.HexToHeimdallHash("0x02"),
+		2,
+		1,
+		101,
+	)
+
+	result := staking.HandleMsgConfirmAuction(ctx, msg, sk)
+	require.True(t, result.IsOK(), "Confirm auction should be valid")
+
+	result = staking.PostHandleMsgConfirmAuction(ctx, msg, sk)
+	require.True(t, result.IsOK(), "Confirm auction should swap validators")
+
+	updatedOld, ok := sk.GetValidatorFromValID(ctx, oldValidator.ID)
+	require.True(t, ok)
+	require.Equal(t, uint64(2), updatedOld.EndEpoch)
+
+	added, ok := sk.GetValidatorFromValID(ctx, newValidator.ID)
+	require.True(t, ok)
+	require.Equal(t, uint64(3), added.StartEpoch)
+	require.Equal(t, int64(20), added.VotingPower)
+	require.Equal(t, newValidator.Signer, added.Signer)
+
+	_, ok = sk.GetAuction(ctx, oldValidator.ID)
+	require.False(t, ok, "Auction should be removed after confirmation")
+
+	// old validator stays in set until activation epoch
+	currentValidatorSet := sk.GetValidatorSet(ctx)
+	require.Empty(t, helper.GetUpdatedValidators(&currentValidatorSet, sk.GetAllValidators(ctx), 0))
+
+	// slot changes hands once ack count reaches activation epoch
+	updates := helper.GetUpdatedValidators(&currentValidatorSet, sk.GetAllValidators(ctx), 2)
+	require.Len(t, updates, 2)
+	for _, update := range updates {
+		switch update.ID {
+		case oldValidator.ID:
+			require.Equal(t, int64(0), update.VotingPower)
+		case newValidator.ID:
+			require.Equal(t, int64(20), update.VotingPower)
+		default:
+			t.Fatalf("unexpected validator update %v", update.ID)
+		}
+	}
+
+	// same root chain log is processed only once
+	result = staking.HandleMsgConfirmAuction(ctx, msg, sk)
+	require.False(t, result.IsOK(), "Confirm auction replay should fail")
+}
+
+func TestHistoricalValidatorSet(t *testing.T) {
+	ctx, sk := createStakingTestInput(t)
+	validators := GenRandomVal(4, 0, 10, 0, false, 1)
+	loadValidators(t, sk, ctx, validators)
+
+	params := sk.GetParams(ctx)
+	params.HistoricalEpochs = 2
+	sk.SetParams(ctx, params)
+
+	// first snapshot of epoch is kept
+	require.Nil(t, sk.TrackHistoricalValidatorSet(ctx.WithBlockHeight(10), 0))
+	require.Nil(t, sk.TrackHistoricalValidatorSet(ctx.WithBlockHeight(11), 0))
+
+	historical, ok := sk.GetHistoricalValidatorSet(ctx, 0)
+	require.True(t, ok)
+	require.Equal(t, int64(10), historical.Height)
+	require.Len(t, historical.ValidatorSet.Validators, len(validators))
+
+	// proposer rotates in next epoch
+	proposer := sk.GetCurrentProposer(ctx)
+	sk.IncrementAccum(ctx, 1)
+	require.Nil(t, sk.TrackHistoricalValidatorSet(ctx.WithBlockHeight(20), 1))
+
+	historical, ok = sk.GetHistoricalValidatorSet(ctx, 0)
+	require.True(t, ok)
+	require.Equal(t, proposer.ID, historical.ValidatorSet.GetProposer().ID)
+
+	historical, ok = sk.GetHistoricalValidatorSet(ctx, 1)
+	require.True(t, ok)
+	require.Equal(t, sk.GetCurrentProposer(ctx).ID, historical.ValidatorSet.GetProposer().ID)
+
+	// lookup by height returns snapshot active at height
+	historical, ok = sk.GetHistoricalValidatorSetByHeight(ctx, 15)
+	require.True(t, ok)
+	require.Equal(t, uint64(0), historical.Epoch)
+
+	historical, ok = sk.GetHistoricalValidatorSetByHeight(ctx, 20)
+	require.True(t, ok)
+	require.Equal(t, uint64(1), historical.Epoch)
+
+	_, ok = sk.GetHistoricalValidatorSetByHeight(ctx, 5)
+	require.False(t, ok, "No snapshot before first epoch")
+
+	// only last `HistoricalEpochs` epochs are kept
+	require.Nil(t, sk.TrackHistoricalValidatorSet(ctx.WithBlockHeight(30), 2))
+
+	_, ok = sk.GetHistoricalValidatorSet(ctx, 0)
+	require.False(t, ok, "Snapshot of epoch 0 should be pruned")
+
+	historicals := sk.GetAllHistoricalValidatorSets(ctx)
+	require.Len(t, historicals, 2)
+	require.Equal(t, uint64(1), historicals[0].Epoch)
+	require.Equal(t, uint64(2), historicals[1].Epoch)
+
+	// snapshots survive genesis export
+	exported := staking.ExportGenesis(ctx, sk)
+	require.Equal(t, params, exported.Params)
+	require.Equal(t, historicals, exported.HistoricalValidatorSets)
+}
+
+func TestValidatorUpdatesOnEmptyBlocks(t *testing.T) {
+	ackRetriever := &staticAckRetriever{}
+	ctx, sk := createStakingTestInputWithAck(t, ackRetriever)
+
+	validators := GenRandomVal(4, 0, 10, 0, false, 1)
+	validators[0].EndEpoch = 2
+	loadValidators(t, sk, ctx, validators)
+
+	joining := GenRandomVal(1, 3, 20, 0, false, 5)[0]
+	require.Nil(t, sk.AddValidator(ctx, joining))
+
+	// every block below has no txs
+	require.Equal(t, int64(0), ctx.BlockHeader().NumTxs)
+	endBlock := func(height int64) []abci.ValidatorUpdate {
+		return staking.EndBlocker(ctx.WithBlockHeight(height), sk)
+	}
+
+	require.Empty(t, endBlock(1), "Joining validator is not active yet")
+	require.Len(t, sk.GetValidatorSet(ctx).Validators, 4)
+
+	ackRetriever.ackCount = 1
+	require.Empty(t, endBlock(2), "Validators don't change before their epochs")
+
+	// ack count reaches exit epoch of old and start epoch of joining validator
+	ackRetriever.ackCount = 2
+	updates := endBlock(3)
+	require.Len(t, updates, 2)
+
+	powers := make(map[string]int64)
+	for _, update := range updates {
+		powers[string(update.PubKey.Data)] = update.Power
+	}
+	require.Equal(t, int64(0), powers[string(validators[0].PubKey.ABCIPubKey().Data)])
+	require.Equal(t, int64(20), powers[string(joining.PubKey.ABCIPubKey().Data)])
+
+	currentValidatorSet := sk.GetValidatorSet(ctx)
+	require.Len(t, currentValidatorSet.Validators, 4)
+	require.True(t, currentValidatorSet.HasAddress(joining.Signer.Bytes()))
+	require.False(t, currentValidatorSet.HasAddress(validators[0].Signer.Bytes()))
+
+	// nothing changed since last update
+	require.Empty(t, endBlock(4))
+	require.Empty(t, endBlock(5))
+}
+
+func TestValidatorJailedInEmptyBlock(t *testing.T) {
+	ctx, sk := createStakingTestInputWithAck(t, &staticAckRetriever{})
+	validators := GenRandomVal(4, 0, 10, 0, false, 1)
+	loadValidators(t, sk, ctx, validators)
+
+	require.Empty(t, staking.EndBlocker(ctx.WithBlockHeight(1), sk))
+
+	// validator is jailed by begin blocker of block without txs
+	require.Nil(t, sk.Jail(ctx, validators[1].Signer.Bytes()))
+
+	updates := staking.EndBlocker(ctx.WithBlockHeight(2), sk)
+	require.Len(t, updates, 1)
+	require.Equal(t, validators[1].PubKey.ABCIPubKey(), updates[0].PubKey)
+	require.Equal(t, int64(0), updates[0].Power)
+
+	currentValidatorSet := sk.GetValidatorSet(ctx)
+	require.False(t, currentValidatorSet.HasAddress(validators[1].Signer.Bytes()))
+	require.Empty(t, staking.EndBlocker(ctx.WithBlockHeight(3), sk))
+}
+
+func TestStakeAmountAndPowerDivisor(t *testing.T) {
+	ctx, sk := createStakingTestInput(t)
+	validators := GenRandomVal(4, 0, 10, 0, false, 1)
+	loadValidators(t, sk, ctx, validators)
+
+	// one voting power per 0.1 token
+	params := sk.GetParams(ctx)
+	params.PowerDivisor = types.NewIntWithDecimal(1, 17)
+	sk.SetParams(ctx, params)
+
+	// 2.55 tokens
+	amount, ok := types.NewIntFromString("2550000000000000000")
+	require.True(t, ok)
+
+	validator := validators[0]
+	msg := stakingTypes.NewMsgStakeUpdate(
+		validator.Signer,
+		validator.ID.Uint64(),
+		amount,
+		types.HexToHeimdallHash("0x01"),
+		1,
+		100,
+	)
+
+	result := staking.PostHandleMsgStakeUpdate(ctx, msg, sk)
+	require.True(t, result.IsOK(), "Stake update should update validator")
+
+	updated, ok := sk.GetValidatorFromValID(ctx, validator.ID)
+	require.True(t, ok)
+	require.Equal(t, amount.String(), updated.Amount.String(), "Exact amount should be stored")
+	require.Equal(t, int64(26), updated.VotingPower, "Power should be rounded half up")
+
+	// priority math keeps working with updated power
+	currentValidatorSet := sk.GetValidatorSet(ctx)
+	updates := helper.GetUpdatedValidators(&currentValidatorSet, sk.GetAllValidators(ctx), 0)
+	require.Len(t, updates, 1)
+	require.Nil(t, currentValidatorSet.UpdateWithChangeSet(updates))
+	require.Equal(t, int64(56), currentValidatorSet.TotalVotingPower())
+	currentValidatorSet.IncrementProposerPriority(1)
+	require.Equal(t, validator.ID, currentValidatorSet.GetProposer().ID)
+
+	// power which can't be represented is rejected
+	overflow := stakingTypes.NewMsgStakeUpdate(
+		validator.Signer,
+		validator.ID.Uint64(),
+		types.NewIntFromBigInt(new(big.Int).Lsh(big.NewInt(1), 200)),
+		types.HexToHeimdallHash("0x02"),
+		1,
+		101,
+	)
+	result = staking.PostHandleMsgStakeUpdate(ctx, overflow, sk)
+	require.False(t, result.IsOK(), "Stake update beyond max voting power should fail")
+
+	// power within max voting power is rejected once total of validators exceeds it
+	othersPower := int64(30)
+	require.NoError(t, sk.ValidateTotalPower(ctx, validator.ID, types.MaxTotalVotingPower-othersPower))
+	require.Error(t, sk.ValidateTotalPower(ctx, validator.ID, types.MaxTotalVotingPower-othersPower+1))
+
+	totalOverflow := stakingTypes.NewMsgStakeUpdate(
+		validator.Signer,
+		validator.ID.Uint64(),
+		types.NewIntFromBigInt(new(big.Int).Mul(big.NewInt(types.MaxTotalVotingPower-othersPower+1), params.PowerDivisor.BigInt())),
+		types.HexToHeimdallHash("0x03"),
+		1,
+		102,
+	)
+	result = staking.PostHandleMsgStakeUpdate(ctx, totalOverflow, sk)
+	require.False(t, result.IsOK(), "Stake update beyond max total voting power should fail")
+
+	// amount and power are exported in genesis
+	exported := staking.ExportGenesis(ctx, sk)
+	for _, exportedValidator := range exported.Validators {
+		if exportedValidator.ID == validator.ID {
+			require.Equal(t, amount.String(), exportedValidator.Amount.String())
+			require.Equal(t, int64(26), exportedValidator.VotingPower)
+		}
+	}
+}
+
+func TestStakeDecreaseAboveMaxTotalPower(t *testing.T) {
+	ctx, sk := createStakingTestInput(t)
+	validators := GenRandomVal(4, 0, 10, 0, false, 1)
+	loadValidators(t, sk, ctx, validators)
+
+	// total of validators is above max total voting power
+	validator, ok := sk.GetValidatorFromValID(ctx, validators[0].ID)
+	require.True(t, ok)
+	validator.VotingPower = types.MaxTotalVotingPower
+	require.NoError(t, sk.AddValidator(ctx, validator))
+
+	// decreasing power is allowed
+	params := sk.GetParams(ctx)
+	decrease := stakingTypes.NewMsgStakeUpdate(
+		validator.Signer,
+		validator.ID.Uint64(),
+		types.NewIntFromBigInt(new(big.Int).Mul(big.NewInt(types.MaxTotalVotingPower-1), params.PowerDivisor.BigInt())),
+		types.HexToHeimdallHash("0x01"),
+		1,
+		100,
+	)
+	result := staking.PostHandleMsgStakeUpdate(ctx, decrease, sk)
+	require.True(t, result.IsOK(), "Stake decrease should be allowed, log: %v", result.Log)
+
+	updated, ok := sk.GetValidatorFromValID(ctx, validator.ID)
+	require.True(t, ok)
+	require.Equal(t, types.MaxTotalVotingPower-1, updated.VotingPower)
+
+	// increasing power is still bounded
+	increase := stakingTypes.NewMsgStakeUpdate(
+		validator.Signer,
+		validator.ID.Uint64(),
+		types.NewIntFromBigInt(new(big.Int).Mul(big.NewInt(types.MaxTotalVotingPower), params.PowerDivisor.BigInt())),
+		types.HexToHeimdallHash("0x02"),
+		1,
+		101,
+	)
+	result = staking.PostHandleMsgStakeUpdate(ctx, increase, sk)
+	require.False(t, result.IsOK(), "Stake increase beyond max total voting power should fail")
+}
+
+func TestEditValidatorMetadata(t *testing.T) {
+	ctx, sk := createStakingTestInput(t)
+	validators := GenRandomVal(4, 0, 10, 0, false, 1)
+	loadValidators(t, sk, ctx, validators)
+
+	validator := validators[0]
+
+	// length limits are checked before execution
+	tooLong := stakingTypes.NewMsgEditValidatorMetadata(
+		validator.Signer,
+		validator.ID.Uint64(),
+		strings.Repeat("m", stakingTypes.MaxMonikerLength+1),
+		"",
+		"",
+		"",
+	)
+	require.NotNil(t, tooLong.ValidateBasic(), "Moniker over limit should be rejected")
+
+	msg := stakingTypes.NewMsgEditValidatorMetadata(
+		validator.Signer,
+		validator.ID.Uint64(),
+		"moniker",
+		"https://example.com",
+		"ops@example.com",
+		"0xabcd",
+	)
+	require.Nil(t, msg.ValidateBasic())
+
+	// only current signer can edit metadata
+	mismatch := msg
+	mismatch.From = validators[1].Signer
+	result := staking.HandleMsgEditValidatorMetadata(ctx, mismatch, sk)
+	require.False(t, result.IsOK(), "Metadata signed by other signer should fail")
+	_, ok := sk.GetValidatorMetadata(ctx, validator.ID)
+	require.False(t, ok)
+
+	result = staking.HandleMsgEditValidatorMetadata(ctx, msg, sk)
+	require.True(t, result.IsOK(), "Metadata signed by current signer should be stored")
+
+	metadata, ok := sk.GetValidatorMetadata(ctx, validator.ID)
+	require.True(t, ok)
+	require.Equal(t, "moniker", metadata.Moniker)
+	require.Equal(t, "0xabcd", metadata.LogoHash)
+
+	// validator query returns metadata
+	querier := staking.NewQuerier(sk)
+	bz, err := codec.Cdc.MarshalJSON(stakingTypes.NewQueryValidatorParams(validator.ID))
+	require.Nil(t, err)
+	res, sdkErr := querier(ctx, []string{stakingTypes.QueryValidator}, abci.RequestQuery{Data: bz})
+	require.Nil(t, sdkErr)
+
+	var info stakingTypes.ValidatorInfo
+	require.Nil(t, json.Unmarshal(res, &info))
+	require.Equal(t, validator.ID, info.ID)
+	require.NotNil(t, info.Metadata)
+	require.Equal(t, "https://example.com", info.Metadata.Website)
+
+	// metadata is exported in genesis
+	exported := staking.ExportGenesis(ctx, sk)
+	require.Len(t, exported.ValidatorMetadata, 1)
+	require.Equal(t, metadata, exported.ValidatorMetadata[0])
+}
+
+func TestProposerSchedule(t *testing.T) {
+	ctx, sk := createStakingTestInput(t)
+	validators := GenRandomVal(4, 0, 10, 0, false, 1)
+	loadValidators(t, sk, ctx, validators)
+
+	schedule := sk.GetProposerSchedule(ctx, 8)
+	require.Len(t, schedule, 9)
+	require.Equal(t, sk.GetCurrentProposer(ctx).ID, schedule[0].Proposer.ID)
+
+	// each validator's turn matches first appearance in schedule
+	for _, validator := range validators {
+		step, ok := sk.GetProposerTurn(ctx, validator.ID, 8)
+		require.True(t, ok, "Validator with equal power should propose within set size")
+		for _, scheduled := range schedule[:step] {
+			require.NotEqual(t, validator.ID, scheduled.Proposer.ID)
+		}
+		require.Equal(t, validator.ID, schedule[step].Proposer.ID)
+	}
+
+	// every ack/no-ack rotates proposer as projected
+	for _, scheduled := range schedule[1:] {
+		sk.IncrementAccum(ctx, 1)
+		require.Equal(t, scheduled.Proposer.ID, sk.GetCurrentProposer(ctx).ID, "Step %v", scheduled.Step)
+	}
+
+	// validator outside current set never proposes
+	_, ok := sk.GetProposerTurn(ctx, types.NewValidatorID(100), 8)
+	require.False(t, ok)
+
+	// schedule beyond max steps is rejected by querier
+	querier := staking.NewQuerier(sk)
+	bz, err := codec.Cdc.MarshalJSON(stakingTypes.NewQueryProposerScheduleParams(stakingTypes.MaxProposerScheduleSteps + 1))
+	require.Nil(t, err)
+	_, sdkErr := querier(ctx, []string{stakingTypes.QueryProposerSchedule}, abci.RequestQuery{Data: bz})
+	require.NotNil(t, sdkErr)
+}
@@ -12,17 +12,19 @@ type CheckpointBlockHeader struct {
 	EndBlock        uint64          `json:"endBlock"`
 	RootHash        HeimdallHash    `json:"rootHash"`
 	AccountRootHash HeimdallHash    `json:"accountRootHash"`
+	BorChainID      string          `json:"borChainId"`
 	TimeStamp       uint64          `json:"timestamp"`
 }
 
 // CreateBlock generate new block
-func CreateBlock(start uint64, end uint64, rootHash HeimdallHash, accountRootHash HeimdallHash, proposer HeimdallAddress, timestamp uint64) CheckpointBlockHeader {
+func CreateBlock(start uint64, end uint64, rootHash HeimdallHash, accountRootHash HeimdallHash, proposer HeimdallAddress, borChainID string, timestamp uint64) CheckpointBlockHeader {
 	return CheckpointBlockHeader{
 		StartBlock:      start,
 		EndBlock:        end,
 		RootHash:        rootHash,
 		AccountRootHash: accountRootHash,
 		Proposer:        proposer,
+		BorChainID:      borChainID,
 		TimeStamp:       timestamp,
 	}
 }
@@ -38,7 +40,8 @@ func SortHeaders(headers []CheckpointBlockHeader) []CheckpointBlockHeader {
 // String returns human redable string
 func (m CheckpointBlockHeader) String() string {
 	return fmt.Sprintf(
-		"CheckpointBlockHeader {%v (%d:%d) %v %v %v}",
+		"CheckpointBlockHeader {%v %v (%d:%d) %v %v %v}",
+		m.BorChainID,
 		m.Proposer.String(),
 		m.StartBlock,
 		m.EndBlock,
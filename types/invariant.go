@@ -0,0 +1,64 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InvarRoute is invariant registered by module under route
+type InvarRoute struct {
+	ModuleName string
+	Route      string
+	Invar      sdk.Invariant
+}
+
+// FullRoute returns module and route of invariant
+func (i InvarRoute) FullRoute() string {
+	return i.ModuleName + "/" + i.Route
+}
+
+// InvariantRegistry collects invariants registered by modules (crisis-style)
+type InvariantRegistry struct {
+	routes []InvarRoute
+}
+
+var _ sdk.InvariantRegistry = &InvariantRegistry{}
+
+// NewInvariantRegistry creates empty invariant registry
+func NewInvariantRegistry() *InvariantRegistry {
+	return &InvariantRegistry{}
+}
+
+// RegisterRoute registers invariant of module under route
+func (r *InvariantRegistry) RegisterRoute(moduleName, route string, invar sdk.Invariant) {
+	r.routes = append(r.routes, InvarRoute{
+		ModuleName: moduleName,
+		Route:      route,
+		Invar:      invar,
+	})
+}
+
+// Routes returns all registered invariant routes
+func (r *InvariantRegistry) Routes() []InvarRoute {
+	return r.routes
+}
+
+// CheckInvariants runs all registered invariants and returns messages of broken ones
+func (r *InvariantRegistry) CheckInvariants(ctx sdk.Context) (broken []string) {
+	for _, route := range r.routes {
+		if msg, stop := route.Invar(ctx); stop {
+			broken = append(broken, msg)
+		}
+	}
+	return broken
+}
+
+// AssertInvariants panics if any registered invariant is broken
+func (r *InvariantRegistry) AssertInvariants(ctx sdk.Context) {
+	for _, route := range r.routes {
+		if msg, stop := route.Invar(ctx); stop {
+			panic(fmt.Errorf("invariant broken: %s (route %s, height %d)", msg, route.FullRoute(), ctx.BlockHeight()))
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SideTxResult represents vote of a validator on side tx
+type SideTxResult byte
+
+// Side tx vote results
+const (
+	SideTxResultSkip SideTxResult = iota // validator couldn't verify side tx (abstain)
+	SideTxResultYes                      // side tx matches external chain
+	SideTxResultNo                       // side tx doesn't match external chain
+)
+
+// String returns human readable side tx result
+func (r SideTxResult) String() string {
+	switch r {
+	case SideTxResultYes:
+		return "yes"
+	case SideTxResultNo:
+		return "no"
+	default:
+		return "skip"
+	}
+}
+
+// ParseSideTxResult parses side tx result from string
+func ParseSideTxResult(result string) (SideTxResult, error) {
+	switch strings.ToLower(result) {
+	case "yes":
+		return SideTxResultYes, nil
+	case "no":
+		return SideTxResultNo, nil
+	case "skip":
+		return SideTxResultSkip, nil
+	default:
+		return SideTxResultSkip, fmt.Errorf("invalid side tx result %s", result)
+	}
+}
+
+// IsValid checks if side tx result is a valid vote
+func (r SideTxResult) IsValid() bool {
+	return r == SideTxResultYes || r == SideTxResultNo || r == SideTxResultSkip
+}
+
+// SideTxMsg represents a message which depends on external chain (Ethereum/Bor) state.
+// It is stored as pending side tx on delivery and applied to state once validators with
+// more than 2/3 voting power have verified it against their own RPC view.
+type SideTxMsg interface {
+	sdk.Msg
+
+	// IsSideTxMsg is a marker method for side tx messages
+	IsSideTxMsg() bool
+}
+
+// SideTxHandler validates side tx message against external chain and returns vote.
+// It runs on each validator's node (outside of consensus) and must not change state.
+type SideTxHandler func(ctx sdk.Context, msg sdk.Msg) SideTxResult
+
+// PostTxHandler applies side tx message to state once it has been approved by validators
+type PostTxHandler func(ctx sdk.Context, msg sdk.Msg) sdk.Result
+
+// SideHandlers represents side tx and post tx handlers of a module
+type SideHandlers struct {
+	SideTxHandler SideTxHandler
+	PostTxHandler PostTxHandler
+}
+
+// SideModule is implemented by modules which handle side tx messages
+type SideModule interface {
+	// Route returns the message routing key for the module
+	Route() string
+
+	// NewSideTxHandler returns side tx handler for the module
+	NewSideTxHandler() SideTxHandler
+
+	// NewPostTxHandler returns post tx handler for the module
+	NewPostTxHandler() PostTxHandler
+}
+
+//
+// Side router
+//
+
+var isAlphaNumeric = regexp.MustCompile(`^[a-zA-Z0-9]+$`).MatchString
+
+// SideRouter provides side handlers for each msg route
+type SideRouter interface {
+	AddRoute(r string, h *SideHandlers) SideRouter
+	HasRoute(r string) bool
+	GetRoute(path string) *SideHandlers
+	Seal()
+}
+
+type sideRouter struct {
+	routes map[string]*SideHandlers
+	sealed bool
+}
+
+// NewSideRouter returns a reference to a new side router
+func NewSideRouter() SideRouter {
+	return &sideRouter{
+		routes: make(map[string]*SideHandlers),
+	}
+}
+
+// Seal seals the router which prohibits any subsequent route handlers to be
+// added. Seal will panic if called more than once.
+func (rtr *sideRouter) Seal() {
+	if rtr.sealed {
+		panic("side router already sealed")
+	}
+	rtr.sealed = true
+}
+
+// AddRoute adds a side handlers path to the router with a given path. It will panic if
+// the router is sealed or if the path is not alphanumeric or already exists.
+func (rtr *sideRouter) AddRoute(path string, h *SideHandlers) SideRouter {
+	if rtr.sealed {
+		panic("side router sealed; cannot add route handler")
+	}
+
+	if !isAlphaNumeric(path) {
+		panic("route expressions can only contain alphanumeric characters")
+	}
+
+	if rtr.HasRoute(path) {
+		panic(fmt.Sprintf("route %s has already been initialized", path))
+	}
+
+	rtr.routes[path] = h
+	return rtr
+}
+
+// HasRoute returns true if the router has a path registered or false otherwise.
+func (rtr *sideRouter) HasRoute(path string) bool {
+	return rtr.routes[path] != nil
+}
+
+// GetRoute returns side handlers for a given path.
+func (rtr *sideRouter) GetRoute(path string) *SideHandlers {
+	if !rtr.HasRoute(path) {
+		return nil
+	}
+	return rtr.routes[path]
+}
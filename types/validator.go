@@ -16,12 +16,16 @@ type Validator struct {
 	ID          ValidatorID     `json:"ID"`
 	StartEpoch  uint64          `json:"startEpoch"`
 	EndEpoch    uint64          `json:"endEpoch"`
-	VotingPower int64           `json:"power"` // TODO add 10^-18 here so that we dont overflow easily
+	VotingPower int64           `json:"power"` // derived from staked and delegated amount using power divisor
 	PubKey      PubKey          `json:"pubKey"`
 	Signer      HeimdallAddress `json:"signer"`
 	LastUpdated uint64          `json:"last_updated"`
 
 	ProposerPriority int64 `json:"accum"`
+
+	Jailed bool `json:"jailed"` // jailed validator is removed from validator set
+
+	Amount Int `json:"amount"` // exact amount staked by validator
 }
 
 func NewValidator(id ValidatorID, startEpoch uint64, endEpoch uint64, power int64, pubKey PubKey, signer HeimdallAddress) *Validator {
@@ -48,6 +52,11 @@ func (v *Validator) IsCurrentValidator(ackCount uint64) bool {
 	// current epoch will be ack count + 1
 	currentEpoch := ackCount + 1
 
+	// jailed validator is not part of validator set
+	if v.Jailed {
+		return false
+	}
+
 	// validator hasnt initialised unstake
 	if v.StartEpoch <= currentEpoch && (v.EndEpoch == 0 || v.EndEpoch >= currentEpoch) && v.VotingPower > 0 {
 		return true